@@ -5,21 +5,26 @@ package artifact
 
 import (
 	"archive/tar"
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime"
 	"slices"
 	"strings"
+	"time"
 
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/klauspost/compress/zstd"
 	"github.com/mholt/archives"
+	godigest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	zarfarchive "github.com/zarf-dev/zarf/src/pkg/archive"
 )
@@ -27,6 +32,11 @@ import (
 const archiveFilePerm os.FileMode = 0o644
 
 // WriteTarZst writes the regular files beneath srcDir to a tar.zst archive.
+//
+// The archive is reproducible: entries are in archiveEntries order, headers carry only
+// the path, size, a fixed mode and owner, and the SOURCE_DATE_EPOCH (or Unix
+// epoch) modification time, and the zstd encoder settings are pinned, so the
+// same files always produce the same bytes.
 func WriteTarZst(ctx context.Context, streams iostreams.IOStreams, dst, srcDir string) (retErr error) {
 	streams.Debug("writing tar.zst archive", "dst", dst)
 	archivePerm := archiveFilePerm
@@ -38,30 +48,14 @@ func WriteTarZst(ctx context.Context, streams iostreams.IOStreams, dst, srcDir s
 	} else if !os.IsNotExist(err) {
 		return err
 	}
-
-	// Trailing separator tells FilesFromDisk to enumerate the directory contents
-	// without adding the directory entry itself to the archive.
-	files, err := archives.FilesFromDisk(ctx, &archives.FromDiskOptions{ClearAttributes: true}, map[string]string{
-		srcDir + string(filepath.Separator): "",
-	})
+	modTime, err := bundleinternal.ArchiveTime()
 	if err != nil {
 		return err
 	}
-
-	// Filter to regular files; error on unexpected types (e.g. symlinks).
-	regular := files[:0:0]
-	for _, f := range files {
-		if f.IsDir() {
-			continue
-		}
-		if !f.Mode().IsRegular() {
-			return UnsupportedFileTypeError{Path: f.NameInArchive}
-		}
-		regular = append(regular, f)
+	entries, err := archiveEntries(srcDir)
+	if err != nil {
+		return err
 	}
-	slices.SortFunc(regular, func(a, b archives.FileInfo) int {
-		return strings.Compare(a.NameInArchive, b.NameInArchive)
-	})
 
 	f, err := os.CreateTemp(filepath.Dir(dst), filepath.Base(dst)+".tmp-*")
 	if err != nil {
@@ -80,11 +74,7 @@ func WriteTarZst(ctx context.Context, streams iostreams.IOStreams, dst, srcDir s
 		}
 	}()
 
-	ca := archives.CompressedArchive{
-		Archival:    archives.Tar{},
-		Compression: archives.Zstd{},
-	}
-	if err := ca.Archive(ctx, f, regular); err != nil {
+	if err := writeTarZstEntries(ctx, f, srcDir, entries, modTime); err != nil {
 		return err
 	}
 	if err := f.Close(); err != nil {
@@ -105,11 +95,159 @@ func WriteTarZst(ctx context.Context, streams iostreams.IOStreams, dst, srcDir s
 	return nil
 }
 
+// archiveEntries returns the slash-separated paths of the regular files
+// beneath srcDir in archive order: OCI blobs follow the other entries, and
+// blobs larger than oci.MaxFetchBytesSize, which cannot be manifests, come
+// last, each group sorted by path. A reader then has the index and every
+// manifest before the layers stream past, as ExtractArtifactPackages needs.
+func archiveEntries(srcDir string) ([]string, error) {
+	type entry struct {
+		name string
+		rank int
+	}
+	var entries []entry
+	err := filepath.WalkDir(srcDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(srcDir, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if !d.Type().IsRegular() {
+			return UnsupportedFileTypeError{Path: name}
+		}
+		rank := 0
+		if strings.HasPrefix(name, archiveBlobsPrefix) {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			rank = 1
+			if info.Size() > oci.MaxFetchBytesSize {
+				rank = 2
+			}
+		}
+		entries = append(entries, entry{name: name, rank: rank})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(entries, func(a, b entry) int {
+		return cmp.Or(cmp.Compare(a.rank, b.rank), strings.Compare(a.name, b.name))
+	})
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.name
+	}
+	return names, nil
+}
+
+// writeTarZstEntries writes entries from srcDir to w as a zstd-compressed tar
+// stream with normalized headers.
+func writeTarZstEntries(ctx context.Context, w io.Writer, srcDir string, entries []string, modTime time.Time) error {
+	zw, err := zstd.NewWriter(w,
+		zstd.WithEncoderLevel(zstd.SpeedDefault),
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithEncoderCRC(true),
+	)
+	if err != nil {
+		return fmt.Errorf("creating zstd encoder: %w", err)
+	}
+	tw := tar.NewWriter(zw)
+	for _, name := range entries {
+		if err := ctx.Err(); err != nil {
+			_ = zw.Close()
+			return err
+		}
+		if err := writeTarEntry(tw, srcDir, name, modTime); err != nil {
+			_ = zw.Close()
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		_ = zw.Close()
+		return fmt.Errorf("closing tar archive: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("closing zstd archive: %w", err)
+	}
+	return nil
+}
+
+func writeTarEntry(tw *tar.Writer, srcDir, name string, modTime time.Time) (retErr error) {
+	f, err := os.Open(filepath.Join(srcDir, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	st, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !st.Mode().IsRegular() {
+		return UnsupportedFileTypeError{Path: name}
+	}
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     st.Size(),
+		Mode:     int64(archiveFilePerm),
+		ModTime:  modTime,
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("writing tar header for %s: %w", name, err)
+	}
+	n, err := io.Copy(tw, f)
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if n != st.Size() {
+		return fmt.Errorf("%s changed size while archiving", name)
+	}
+	return nil
+}
+
 // CountTarZstEntries returns the number of archive entries that extract to name.
-func CountTarZstEntries(ctx context.Context, src, name string) (count int, retErr error) {
+func CountTarZstEntries(ctx context.Context, src, name string) (int, error) {
+	counts, err := CountTarZstEntriesFunc(ctx, src, func(entry string) bool { return entry == name })
+	if err != nil {
+		return 0, err
+	}
+	return counts[name], nil
+}
+
+// CountTarZstEntriesFunc returns the number of archive entries that extract
+// to each name accepted by match.
+func CountTarZstEntriesFunc(ctx context.Context, src string, match func(name string) bool) (map[string]int, error) {
+	counts := map[string]int{}
+	err := walkTarZst(ctx, src, func(hdr *tar.Header, _ io.Reader) error {
+		if name := path.Clean(hdr.Name); match(name) {
+			counts[name]++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// walkTarZst calls fn for each entry of a tar.zst archive in stream order. The
+// reader passed to fn yields the entry body and is only valid during the call.
+func walkTarZst(ctx context.Context, src string, fn func(hdr *tar.Header, r io.Reader) error) (retErr error) {
 	f, err := os.Open(src)
 	if err != nil {
-		return 0, fmt.Errorf("opening archive: %w", err)
+		return fmt.Errorf("opening archive: %w", err)
 	}
 	defer func() {
 		if err := f.Close(); err != nil && retErr == nil {
@@ -119,7 +257,7 @@ func CountTarZstEntries(ctx context.Context, src, name string) (count int, retEr
 
 	zr, err := (archives.Zstd{}).OpenReader(f)
 	if err != nil {
-		return 0, fmt.Errorf("opening zstd archive: %w", err)
+		return fmt.Errorf("opening zstd archive: %w", err)
 	}
 	defer func() {
 		if err := zr.Close(); err != nil && retErr == nil {
@@ -130,17 +268,17 @@ func CountTarZstEntries(ctx context.Context, src, name string) (count int, retEr
 	tr := tar.NewReader(zr)
 	for {
 		if err := ctx.Err(); err != nil {
-			return 0, err
+			return err
 		}
 		hdr, err := tr.Next()
 		if err == io.EOF {
-			return count, nil
+			return nil
 		}
 		if err != nil {
-			return 0, fmt.Errorf("reading tar archive: %w", err)
+			return fmt.Errorf("reading tar archive: %w", err)
 		}
-		if path.Clean(hdr.Name) == name {
-			count++
+		if err := fn(hdr, tr); err != nil {
+			return err
 		}
 	}
 }
@@ -225,3 +363,50 @@ func bundleNameFromDefinitionLayer(ctx context.Context, streams iostreams.IOStre
 	}
 	return bundleOutputName(b, arch), nil
 }
+
+// DiffTarZstEntries returns the sorted names of the entries whose headers or
+// contents differ between two tar.zst archives, including entries present in
+// only one of them.
+func DiffTarZstEntries(ctx context.Context, a, b string) ([]string, error) {
+	left, err := tarZstEntryDigests(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	right, err := tarZstEntryDigests(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name, d := range left {
+		if right[name] != d {
+			names = append(names, name)
+		}
+	}
+	for name := range right {
+		if _, ok := left[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+// tarZstEntryDigests digests the normalized header fields and body of every
+// entry in a tar.zst archive.
+func tarZstEntryDigests(ctx context.Context, src string) (map[string]godigest.Digest, error) {
+	digests := map[string]godigest.Digest{}
+	err := walkTarZst(ctx, src, func(hdr *tar.Header, r io.Reader) error {
+		digester := godigest.Canonical.Digester()
+		h := digester.Hash()
+		fmt.Fprintf(h, "%c %o %d %d %s %s %d %d\n", hdr.Typeflag, hdr.Mode, hdr.Uid, hdr.Gid, hdr.Uname, hdr.Gname, hdr.ModTime.Unix(), hdr.Size)
+		if _, err := io.Copy(h, r); err != nil {
+			return fmt.Errorf("reading %s: %w", hdr.Name, err)
+		}
+		digests[hdr.Name] = digester.Digest()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return digests, nil
+}
@@ -6,13 +6,17 @@ package artifact
 import (
 	"archive/tar"
 	"bytes"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
+	"time"
 
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/mholt/archives"
 	"github.com/stretchr/testify/assert"
@@ -195,6 +199,84 @@ func TestExtractTarZst_RoundTrip(t *testing.T) {
 	assert.Equal(t, "B", string(b))
 }
 
+func TestWriteTarZst_IsReproducible(t *testing.T) {
+	srcDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(srcDir, "oci", "blobs"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "b.txt"), []byte("B"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "oci", "blobs", "a.txt"), []byte("A"), 0o755))
+
+	first := filepath.Join(t.TempDir(), "first.tar.zst")
+	require.NoError(t, WriteTarZst(t.Context(), iostreams.IOStreams{}, first, srcDir))
+	later := time.Now().Add(time.Hour)
+	require.NoError(t, os.Chtimes(filepath.Join(srcDir, "b.txt"), later, later))
+	second := filepath.Join(t.TempDir(), "second.tar.zst")
+	require.NoError(t, WriteTarZst(t.Context(), iostreams.IOStreams{}, second, srcDir))
+
+	firstBytes, err := os.ReadFile(first)
+	require.NoError(t, err)
+	secondBytes, err := os.ReadFile(second)
+	require.NoError(t, err)
+	assert.Equal(t, firstBytes, secondBytes, "identical files must produce byte-identical archives")
+	diff, err := DiffTarZstEntries(t.Context(), first, second)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	var names []string
+	require.NoError(t, walkTarZst(t.Context(), first, func(hdr *tar.Header, _ io.Reader) error {
+		names = append(names, hdr.Name)
+		assert.Equal(t, byte(tar.TypeReg), hdr.Typeflag)
+		assert.Equal(t, int64(0o644), hdr.Mode)
+		assert.Zero(t, hdr.Uid)
+		assert.Zero(t, hdr.Gid)
+		assert.Empty(t, hdr.Uname)
+		assert.Empty(t, hdr.Gname)
+		assert.Equal(t, int64(0), hdr.ModTime.Unix())
+		return nil
+	}))
+	assert.Equal(t, []string{"b.txt", "oci/blobs/a.txt"}, names)
+}
+
+func TestArchiveEntries_PutsLayersLast(t *testing.T) {
+	srcDir := t.TempDir()
+	blobDir := filepath.Join(srcDir, "oci", "blobs", "sha256")
+	require.NoError(t, os.MkdirAll(blobDir, 0o755))
+	for _, name := range []string{"oci/index.json", "oci/oci-layout", "oci/blobs/sha256/bb", "uds.bundle.sig"} {
+		require.NoError(t, os.WriteFile(filepath.Join(srcDir, filepath.FromSlash(name)), []byte("{}"), 0o600))
+	}
+	layer, err := os.Create(filepath.Join(blobDir, "aa"))
+	require.NoError(t, err)
+	require.NoError(t, layer.Truncate(oci.MaxFetchBytesSize+1))
+	require.NoError(t, layer.Close())
+
+	entries, err := archiveEntries(srcDir)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oci/index.json", "oci/oci-layout", "uds.bundle.sig", "oci/blobs/sha256/bb", "oci/blobs/sha256/aa"}, entries)
+}
+
+func TestWriteTarZst_UsesSourceDateEpoch(t *testing.T) {
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "a.txt"), []byte("A"), 0o600))
+
+	t.Setenv(bundleinternal.SourceDateEpochEnv, "1700000000")
+	pinned := filepath.Join(t.TempDir(), "pinned.tar.zst")
+	require.NoError(t, WriteTarZst(t.Context(), iostreams.IOStreams{}, pinned, srcDir))
+	require.NoError(t, walkTarZst(t.Context(), pinned, func(hdr *tar.Header, _ io.Reader) error {
+		assert.Equal(t, int64(1700000000), hdr.ModTime.Unix())
+		return nil
+	}))
+
+	unpinned := filepath.Join(t.TempDir(), "unpinned.tar.zst")
+	t.Setenv(bundleinternal.SourceDateEpochEnv, "")
+	require.NoError(t, WriteTarZst(t.Context(), iostreams.IOStreams{}, unpinned, srcDir))
+	diff, err := DiffTarZstEntries(t.Context(), pinned, unpinned)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a.txt"}, diff)
+
+	t.Setenv(bundleinternal.SourceDateEpochEnv, "yesterday")
+	err = WriteTarZst(t.Context(), iostreams.IOStreams{}, filepath.Join(t.TempDir(), "invalid.tar.zst"), srcDir)
+	require.ErrorIs(t, err, bundleinternal.ErrInvalidSourceDateEpoch)
+}
+
 // TestExtractTarZst_RejectsMaliciousEntries covers malicious entries that
 // zarfarchive.Decompress must reject outright. If zarf's validation changes,
 // these assertions fail and force a conscious re-evaluation.
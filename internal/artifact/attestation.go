@@ -0,0 +1,519 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/internal/version"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+const (
+	// InTotoStatementType is the in-toto Statement v1 type.
+	InTotoStatementType = "https://in-toto.io/Statement/v1"
+	// SLSAProvenancePredicateType is the SLSA provenance v1 predicate type.
+	SLSAProvenancePredicateType = "https://slsa.dev/provenance/v1"
+	// BundleBuildType identifies the uds bundle create build process in provenance.
+	BundleBuildType = "https://github.com/defenseunicorns/uds-cli/bundle-create/v1"
+	// BundleBuilderID identifies uds-cli as the provenance builder.
+	BundleBuilderID = "https://github.com/defenseunicorns/uds-cli"
+	// CycloneDXSpecVersion is the CycloneDX specification version of bundle SBOMs.
+	CycloneDXSpecVersion = "1.5"
+)
+
+// AttestationSummary describes a bundle attestation without its content.
+type AttestationSummary struct {
+	MediaType string
+	// Format is the in-toto predicate type or the SBOM format and version.
+	Format string
+	// SubjectDigest is the bundle index digest the attestation describes.
+	SubjectDigest string
+	Signed        bool
+}
+
+type inTotoStatement struct {
+	Type          string          `json:"_type"`
+	Subject       []inTotoSubject `json:"subject"`
+	PredicateType string          `json:"predicateType"`
+	Predicate     slsaProvenance  `json:"predicate"`
+}
+
+type inTotoSubject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+type slsaProvenance struct {
+	BuildDefinition slsaBuildDefinition `json:"buildDefinition"`
+	RunDetails      slsaRunDetails      `json:"runDetails"`
+}
+
+type slsaBuildDefinition struct {
+	BuildType            string                   `json:"buildType"`
+	ExternalParameters   map[string]any           `json:"externalParameters"`
+	ResolvedDependencies []slsaResourceDescriptor `json:"resolvedDependencies,omitempty"`
+}
+
+type slsaResourceDescriptor struct {
+	Name        string            `json:"name,omitempty"`
+	URI         string            `json:"uri,omitempty"`
+	Digest      map[string]string `json:"digest"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type slsaRunDetails struct {
+	Builder  slsaBuilder       `json:"builder"`
+	Metadata slsaBuildMetadata `json:"metadata"`
+}
+
+type slsaBuilder struct {
+	ID      string            `json:"id"`
+	Version map[string]string `json:"version,omitempty"`
+}
+
+type slsaBuildMetadata struct {
+	StartedOn  string `json:"startedOn,omitempty"`
+	FinishedOn string `json:"finishedOn,omitempty"`
+}
+
+type cycloneDXBOM struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components,omitempty"`
+	Dependencies []cycloneDXDependency `json:"dependencies,omitempty"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string              `json:"timestamp,omitempty"`
+	Tools     cycloneDXTools      `json:"tools"`
+	Component *cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXTools struct {
+	Components []cycloneDXComponent `json:"components"`
+}
+
+type cycloneDXComponent struct {
+	BOMRef             string                       `json:"bom-ref,omitempty"`
+	Type               string                       `json:"type"`
+	Name               string                       `json:"name"`
+	Version            string                       `json:"version,omitempty"`
+	Hashes             []cycloneDXHash              `json:"hashes,omitempty"`
+	ExternalReferences []cycloneDXExternalReference `json:"externalReferences,omitempty"`
+	Properties         []cycloneDXProperty          `json:"properties,omitempty"`
+	Components         []cycloneDXComponent         `json:"components,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDXExternalReference struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// attestationInputs holds the facts recorded in bundle provenance and SBOM.
+type attestationInputs struct {
+	bundle      *spec.UDSBundle
+	arch        string
+	indexDigest digest.Digest
+	bundleHCL   []byte
+	defaultsHCL []byte
+	packages    map[string]PackageContents
+	gitCommit   string
+	// buildTime is recorded as the build start, finish, and SBOM timestamp.
+	// It is zero, and so omitted, unless SOURCE_DATE_EPOCH is set, in which
+	// case that time is recorded; either way the attestations are
+	// reproducible.
+	buildTime time.Time
+}
+
+// writeCreateAttestations generates provenance and an aggregated SBOM for the
+// bundle index in ociDir and writes them to the archive root.
+func writeCreateAttestations(ctx context.Context, opts CreateOptions, root, ociDir string, buildTime time.Time) error {
+	indexBytes, err := os.ReadFile(filepath.Join(ociDir, "index.json"))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrReadingBundleIndex, err)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(indexBytes, &idx); err != nil {
+		return fmt.Errorf("%w: %w", ErrParsingBundleIndex, err)
+	}
+	store, err := udsoci.OpenReadOnlyStore(ociDir)
+	if err != nil {
+		return err
+	}
+	fetch := func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error) {
+		return udsoci.FetchBytes(ctx, store, desc)
+	}
+	inputs := attestationInputs{
+		bundle:      opts.Bundle,
+		arch:        opts.Config.Options.Architecture,
+		indexDigest: digest.FromBytes(indexBytes),
+		bundleHCL:   opts.BundleHCL,
+		defaultsHCL: opts.DefaultsHCL,
+		packages:    make(map[string]PackageContents, len(opts.Bundle.Packages)),
+		gitCommit:   gitCommit(opts.BundleDir),
+		buildTime:   buildTime,
+	}
+	for _, pkg := range opts.Bundle.Packages {
+		contents, err := readPackageContents(ctx, idx, pkg, fetch)
+		if err != nil {
+			return InspectingPackageContentsError{Package: pkg.Name, Err: err}
+		}
+		inputs.packages[pkg.Name] = *contents
+	}
+
+	provenance, err := bundleProvenance(inputs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrGeneratingAttestation, err)
+	}
+	sbom, err := bundleSBOM(inputs)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrGeneratingAttestation, err)
+	}
+	opts.Streams.Debug("writing bundle attestations", "digest", inputs.indexDigest.String(), "gitCommit", inputs.gitCommit)
+	return udsoci.WriteBundleAttestations(root, []udsoci.BundleAttestation{
+		{MediaType: udsoci.MediaTypeInTotoStatement, Data: provenance},
+		{MediaType: udsoci.MediaTypeCycloneDX, Data: sbom},
+	})
+}
+
+func bundleProvenance(in attestationInputs) ([]byte, error) {
+	hclDigest := digest.FromBytes(in.bundleHCL)
+	external := map[string]any{
+		"bundle": map[string]string{
+			"name":    in.bundle.Metadata.Name,
+			"version": in.bundle.Metadata.Version,
+		},
+		"architecture": in.arch,
+		"source": slsaResourceDescriptor{
+			Name:   bundleinternal.BundleFileName,
+			Digest: map[string]string{hclDigest.Algorithm().String(): hclDigest.Encoded()},
+		},
+	}
+
+	var dependencies []slsaResourceDescriptor
+	if in.gitCommit != "" {
+		dependencies = append(dependencies, slsaResourceDescriptor{
+			Name:   "git",
+			Digest: map[string]string{"gitCommit": in.gitCommit},
+		})
+	}
+	if in.defaultsHCL != nil {
+		defaultsDigest := digest.FromBytes(in.defaultsHCL)
+		dependencies = append(dependencies, slsaResourceDescriptor{
+			Name:   bundleinternal.BundleDefaultsFileName,
+			Digest: map[string]string{defaultsDigest.Algorithm().String(): defaultsDigest.Encoded()},
+		})
+	}
+	for _, pkg := range in.bundle.Packages {
+		contents := in.packages[pkg.Name]
+		dependency := slsaResourceDescriptor{
+			Name:   pkg.Name,
+			URI:    pkg.Source,
+			Digest: digestMap(contents.ManifestDigest),
+		}
+		if contents.Version != "" {
+			dependency.Annotations = map[string]string{"version": contents.Version}
+		}
+		dependencies = append(dependencies, dependency)
+	}
+
+	statement := inTotoStatement{
+		Type: InTotoStatementType,
+		Subject: []inTotoSubject{{
+			Name:   in.bundle.Metadata.Name,
+			Digest: map[string]string{in.indexDigest.Algorithm().String(): in.indexDigest.Encoded()},
+		}},
+		PredicateType: SLSAProvenancePredicateType,
+		Predicate: slsaProvenance{
+			BuildDefinition: slsaBuildDefinition{
+				BuildType:            BundleBuildType,
+				ExternalParameters:   external,
+				ResolvedDependencies: dependencies,
+			},
+			RunDetails: slsaRunDetails{
+				Builder: slsaBuilder{
+					ID:      BundleBuilderID,
+					Version: map[string]string{"uds-cli": version.Version},
+				},
+				Metadata: slsaBuildMetadata{
+					StartedOn:  attestationTime(in.buildTime),
+					FinishedOn: attestationTime(in.buildTime),
+				},
+			},
+		},
+	}
+	return marshalAttestation(statement)
+}
+
+func bundleSBOM(in attestationInputs) ([]byte, error) {
+	bundleRef := "bundle:" + in.bundle.Metadata.Name
+	bom := cycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  CycloneDXSpecVersion,
+		SerialNumber: serialNumber(in.indexDigest),
+		Version:      1,
+		Metadata: cycloneDXMetadata{
+			Timestamp: attestationTime(in.buildTime),
+			Tools: cycloneDXTools{Components: []cycloneDXComponent{{
+				Type:    "application",
+				Name:    "uds-cli",
+				Version: version.Version,
+			}}},
+			Component: &cycloneDXComponent{
+				BOMRef:  bundleRef,
+				Type:    "application",
+				Name:    in.bundle.Metadata.Name,
+				Version: in.bundle.Metadata.Version,
+				Hashes:  []cycloneDXHash{{Alg: "SHA-256", Content: in.indexDigest.Encoded()}},
+				Properties: []cycloneDXProperty{
+					{Name: "uds:bundle:architecture", Value: in.arch},
+				},
+			},
+		},
+	}
+
+	bundleDependency := cycloneDXDependency{Ref: bundleRef}
+	for _, pkg := range in.bundle.Packages {
+		contents := in.packages[pkg.Name]
+		pkgRef := "package:" + pkg.Name
+		component := cycloneDXComponent{
+			BOMRef:             pkgRef,
+			Type:               "application",
+			Name:               pkg.Name,
+			Version:            contents.Version,
+			ExternalReferences: []cycloneDXExternalReference{{Type: "distribution", URL: pkg.Source}},
+		}
+		if d, err := digest.Parse(contents.ManifestDigest); err == nil {
+			component.Hashes = []cycloneDXHash{{Alg: "SHA-256", Content: d.Encoded()}}
+		}
+		if contents.SBOMDigest != "" {
+			component.Properties = append(component.Properties, cycloneDXProperty{Name: "uds:package:sboms", Value: contents.SBOMDigest})
+		}
+		for _, image := range contents.Images {
+			component.Components = append(component.Components, cycloneDXComponent{
+				BOMRef: pkgRef + ":image:" + image,
+				Type:   "container",
+				Name:   image,
+			})
+		}
+		bom.Components = append(bom.Components, component)
+		bundleDependency.DependsOn = append(bundleDependency.DependsOn, pkgRef)
+
+		pkgDependency := cycloneDXDependency{Ref: pkgRef}
+		for _, dependency := range contents.DependsOn {
+			pkgDependency.DependsOn = append(pkgDependency.DependsOn, "package:"+dependency)
+		}
+		bom.Dependencies = append(bom.Dependencies, pkgDependency)
+	}
+	bom.Dependencies = append([]cycloneDXDependency{bundleDependency}, bom.Dependencies...)
+	return marshalAttestation(bom)
+}
+
+// SummarizeAttestation parses a bundle attestation and reports its format and
+// the bundle index digest it describes.
+func SummarizeAttestation(attestation udsoci.BundleAttestation) (AttestationSummary, error) {
+	summary := AttestationSummary{MediaType: attestation.MediaType, Signed: len(attestation.Signature) != 0}
+	switch attestation.MediaType {
+	case udsoci.MediaTypeInTotoStatement:
+		var statement struct {
+			Type          string          `json:"_type"`
+			Subject       []inTotoSubject `json:"subject"`
+			PredicateType string          `json:"predicateType"`
+		}
+		if err := json.Unmarshal(attestation.Data, &statement); err != nil {
+			return summary, fmt.Errorf("%w %s: %w", ErrParsingAttestation, attestation.MediaType, err)
+		}
+		if statement.Type != InTotoStatementType || len(statement.Subject) != 1 {
+			return summary, fmt.Errorf("%w %s: expected an %s with one subject", ErrParsingAttestation, attestation.MediaType, InTotoStatementType)
+		}
+		summary.Format = statement.PredicateType
+		if encoded := statement.Subject[0].Digest[digest.SHA256.String()]; encoded != "" {
+			summary.SubjectDigest = digest.NewDigestFromEncoded(digest.SHA256, encoded).String()
+		}
+	case udsoci.MediaTypeCycloneDX:
+		var bom cycloneDXBOM
+		if err := json.Unmarshal(attestation.Data, &bom); err != nil {
+			return summary, fmt.Errorf("%w %s: %w", ErrParsingAttestation, attestation.MediaType, err)
+		}
+		summary.Format = bom.BOMFormat + " " + bom.SpecVersion
+		if bom.Metadata.Component != nil {
+			for _, hash := range bom.Metadata.Component.Hashes {
+				if hash.Alg == "SHA-256" {
+					summary.SubjectDigest = digest.NewDigestFromEncoded(digest.SHA256, hash.Content).String()
+				}
+			}
+		}
+	default:
+		return summary, fmt.Errorf("%w: unsupported media type %q", ErrParsingAttestation, attestation.MediaType)
+	}
+	return summary, nil
+}
+
+func marshalAttestation(v any) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func digestMap(s string) map[string]string {
+	d, err := digest.Parse(s)
+	if err != nil {
+		return map[string]string{}
+	}
+	return map[string]string{d.Algorithm().String(): d.Encoded()}
+}
+
+// serialNumber derives a stable RFC 4122 URN for a bundle index digest so
+// repeated builds of the same bundle share an SBOM serial number.
+func serialNumber(d digest.Digest) string {
+	sum := sha256.Sum256([]byte(d.String()))
+	sum[6] = (sum[6] & 0x0f) | 0x50
+	sum[8] = (sum[8] & 0x3f) | 0x80
+	h := hex.EncodeToString(sum[:16])
+	return fmt.Sprintf("urn:uuid:%s-%s-%s-%s-%s", h[0:8], h[8:12], h[12:16], h[16:20], h[20:32])
+}
+
+// gitCommit returns the commit checked out in the git work tree containing
+// dir, or "" when dir is not inside a git work tree.
+func gitCommit(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		gitDir := filepath.Join(abs, ".git")
+		if info, err := os.Stat(gitDir); err == nil {
+			if !info.IsDir() {
+				gitDir = resolveGitDirFile(abs, gitDir)
+			}
+			if gitDir == "" {
+				return ""
+			}
+			return readGitHead(gitDir)
+		}
+		parent := filepath.Dir(abs)
+		if parent == abs {
+			return ""
+		}
+		abs = parent
+	}
+}
+
+// resolveGitDirFile follows a ".git" file (worktrees and submodules) to the
+// git directory it names.
+func resolveGitDirFile(workTree, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	gitDir, ok := strings.CutPrefix(strings.TrimSpace(string(data)), "gitdir:")
+	if !ok {
+		return ""
+	}
+	gitDir = strings.TrimSpace(gitDir)
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(workTree, gitDir)
+	}
+	return gitDir
+}
+
+func readGitHead(gitDir string) string {
+	data, err := os.ReadFile(filepath.Join(gitDir, "HEAD"))
+	if err != nil {
+		return ""
+	}
+	head := strings.TrimSpace(string(data))
+	ref, ok := strings.CutPrefix(head, "ref:")
+	if !ok {
+		return validGitCommit(head)
+	}
+	ref = strings.TrimSpace(ref)
+	// Linked worktrees keep shared refs in the common directory.
+	dirs := []string{gitDir}
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+		dirs = append(dirs, commonDir)
+	}
+	for _, d := range dirs {
+		if data, err := os.ReadFile(filepath.Join(d, filepath.FromSlash(ref))); err == nil {
+			return validGitCommit(strings.TrimSpace(string(data)))
+		}
+		if commit := packedGitRef(filepath.Join(d, "packed-refs"), ref); commit != "" {
+			return commit
+		}
+	}
+	return ""
+}
+
+func packedGitRef(path, ref string) string {
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = f.Close() }()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		commit, name, ok := strings.Cut(scanner.Text(), " ")
+		if ok && name == ref {
+			return validGitCommit(commit)
+		}
+	}
+	return ""
+}
+
+func validGitCommit(s string) string {
+	if len(s) != 40 && len(s) != 64 {
+		return ""
+	}
+	if _, err := hex.DecodeString(s); err != nil {
+		return ""
+	}
+	return s
+}
+
+// attestationTime formats t for an attestation, or returns "" when t is zero
+// so the field is omitted.
+func attestationTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
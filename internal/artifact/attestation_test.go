@@ -0,0 +1,135 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAttestationInputs() attestationInputs {
+	return attestationInputs{
+		bundle: &spec.UDSBundle{
+			Metadata: spec.Metadata{Name: "core", Version: "1.0.0"},
+			Packages: []spec.Package{{Name: "init", Source: "oci://example.com/init:1.0.0"}, {Name: "istio", Source: "istio.tar.zst"}},
+		},
+		arch:        "amd64",
+		indexDigest: digest.FromString("index"),
+		bundleHCL:   []byte("bundle"),
+		defaultsHCL: []byte("defaults"),
+		packages: map[string]PackageContents{
+			"init":  {ManifestDigest: digest.FromString("init").String(), Version: "1.0.0"},
+			"istio": {ManifestDigest: digest.FromString("istio").String(), DependsOn: []string{"init"}, Images: []string{"istio/pilot:1.0"}, SBOMDigest: digest.FromString("sboms").String()},
+		},
+		gitCommit: "0123456789abcdef0123456789abcdef01234567",
+		buildTime: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestBundleProvenance(t *testing.T) {
+	in := testAttestationInputs()
+	data, err := bundleProvenance(in)
+	require.NoError(t, err)
+
+	var statement inTotoStatement
+	require.NoError(t, json.Unmarshal(data, &statement))
+	assert.Equal(t, InTotoStatementType, statement.Type)
+	assert.Equal(t, SLSAProvenancePredicateType, statement.PredicateType)
+	require.Len(t, statement.Subject, 1)
+	assert.Equal(t, map[string]string{"sha256": in.indexDigest.Encoded()}, statement.Subject[0].Digest)
+	assert.Equal(t, BundleBuilderID, statement.Predicate.RunDetails.Builder.ID)
+	assert.Equal(t, "2026-01-02T03:04:05Z", statement.Predicate.RunDetails.Metadata.StartedOn)
+
+	deps := statement.Predicate.BuildDefinition.ResolvedDependencies
+	require.Len(t, deps, 4)
+	assert.Equal(t, map[string]string{"gitCommit": in.gitCommit}, deps[0].Digest)
+	assert.Equal(t, "defaults.uds.hcl", deps[1].Name)
+	assert.Equal(t, "init", deps[2].Name)
+	assert.Equal(t, "oci://example.com/init:1.0.0", deps[2].URI)
+	assert.Equal(t, map[string]string{"sha256": digest.FromString("init").Encoded()}, deps[2].Digest)
+	assert.Equal(t, map[string]string{"version": "1.0.0"}, deps[2].Annotations)
+
+	summary, err := SummarizeAttestation(udsoci.BundleAttestation{MediaType: udsoci.MediaTypeInTotoStatement, Data: data})
+	require.NoError(t, err)
+	assert.Equal(t, AttestationSummary{MediaType: udsoci.MediaTypeInTotoStatement, Format: SLSAProvenancePredicateType, SubjectDigest: in.indexDigest.String()}, summary)
+}
+
+func TestBundleProvenanceOmitsUnpinnedTimestamps(t *testing.T) {
+	in := testAttestationInputs()
+	in.buildTime = time.Time{}
+	data, err := bundleProvenance(in)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "startedOn")
+	assert.NotContains(t, string(data), "finishedOn")
+}
+
+func TestBundleSBOM(t *testing.T) {
+	in := testAttestationInputs()
+	data, err := bundleSBOM(in)
+	require.NoError(t, err)
+	again, err := bundleSBOM(in)
+	require.NoError(t, err)
+	assert.Equal(t, data, again)
+
+	var bom cycloneDXBOM
+	require.NoError(t, json.Unmarshal(data, &bom))
+	assert.Equal(t, CycloneDXSpecVersion, bom.SpecVersion)
+	require.Len(t, bom.Components, 2)
+	istio := bom.Components[1]
+	assert.Equal(t, "package:istio", istio.BOMRef)
+	assert.Equal(t, []cycloneDXExternalReference{{Type: "distribution", URL: "istio.tar.zst"}}, istio.ExternalReferences)
+	assert.Equal(t, []cycloneDXProperty{{Name: "uds:package:sboms", Value: digest.FromString("sboms").String()}}, istio.Properties)
+	require.Len(t, istio.Components, 1)
+	assert.Equal(t, "container", istio.Components[0].Type)
+	assert.Equal(t, []cycloneDXDependency{
+		{Ref: "bundle:core", DependsOn: []string{"package:init", "package:istio"}},
+		{Ref: "package:init"},
+		{Ref: "package:istio", DependsOn: []string{"package:init"}},
+	}, bom.Dependencies)
+
+	summary, err := SummarizeAttestation(udsoci.BundleAttestation{MediaType: udsoci.MediaTypeCycloneDX, Data: data, Signature: []byte("evidence")})
+	require.NoError(t, err)
+	assert.Equal(t, AttestationSummary{MediaType: udsoci.MediaTypeCycloneDX, Format: "CycloneDX 1.5", SubjectDigest: in.indexDigest.String(), Signed: true}, summary)
+}
+
+func TestSummarizeAttestationRejectsMalformedStatements(t *testing.T) {
+	_, err := SummarizeAttestation(udsoci.BundleAttestation{MediaType: udsoci.MediaTypeInTotoStatement, Data: []byte(`{"_type":"other"}`)})
+	require.ErrorIs(t, err, ErrParsingAttestation)
+	_, err = SummarizeAttestation(udsoci.BundleAttestation{MediaType: "text/plain"})
+	require.ErrorIs(t, err, ErrParsingAttestation)
+}
+
+func TestGitCommit(t *testing.T) {
+	commit := "0123456789abcdef0123456789abcdef01234567"
+	packed := "89abcdef0123456789abcdef0123456789abcdef"
+
+	repo := t.TempDir()
+	gitDir := filepath.Join(repo, ".git")
+	require.NoError(t, os.MkdirAll(filepath.Join(gitDir, "refs", "heads"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("ref: refs/heads/main\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "refs", "heads", "main"), []byte(commit+"\n"), 0o600))
+	bundleDir := filepath.Join(repo, "bundles", "core")
+	require.NoError(t, os.MkdirAll(bundleDir, 0o700))
+	assert.Equal(t, commit, gitCommit(bundleDir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("ref: refs/heads/release\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "packed-refs"), []byte("# pack-refs with: peeled\n"+packed+" refs/heads/release\n"), 0o600))
+	assert.Equal(t, packed, gitCommit(bundleDir))
+
+	worktree := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(worktree, ".git"), []byte("gitdir: "+gitDir+"\n"), 0o600))
+	assert.Equal(t, packed, gitCommit(worktree))
+
+	require.NoError(t, os.WriteFile(filepath.Join(gitDir, "HEAD"), []byte("not a commit\n"), 0o600))
+	assert.Empty(t, gitCommit(bundleDir))
+}
@@ -0,0 +1,175 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// BundleContents describes the comparable contents of a built bundle.
+type BundleContents struct {
+	Bundle         *spec.UDSBundle
+	ArtifactDigest string
+	Defaults       bundleinternal.Variables
+	Packages       map[string]PackageContents
+}
+
+// PackageContents describes one package entry in a built bundle.
+type PackageContents struct {
+	Source         string
+	Version        string
+	ManifestDigest string
+	DependsOn      []string
+	// ValuesFiles holds values file layer digests in declaration order.
+	ValuesFiles []string
+	Images      []string
+	// SBOMDigest is the digest of the package's sboms.tar layer, if any.
+	SBOMDigest string
+}
+
+type packageContentMetadata struct {
+	Metadata struct {
+		Version string `yaml:"version"`
+	} `yaml:"metadata"`
+	Components []struct {
+		Images []string `yaml:"images"`
+	} `yaml:"components"`
+}
+
+// ReadContents reads the bundle definition, defaults, values files, and
+// package metadata from a built local or OCI bundle. Only index, manifest,
+// definition, and zarf.yaml blobs are fetched; package layers are not pulled.
+// It does not verify package content or signatures.
+func ReadContents(ctx context.Context, opts InspectOptions) (*BundleContents, error) {
+	source, err := openInspectSource(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer source.cleanup()
+
+	def, err := readBundleDefinition(ctx, opts.Streams, source.indexBytes, source.artifactDigest, source.fetch)
+	if err != nil {
+		return nil, err
+	}
+	contents := &BundleContents{
+		Bundle:         def.bundle,
+		ArtifactDigest: source.artifactDigest,
+		Packages:       make(map[string]PackageContents, len(def.bundle.Packages)),
+	}
+
+	if defaultsDesc, ok := findLayerByTitleOptional(def.definition, bundleinternal.BundleDefaultsFileName); ok {
+		defaultsBytes, err := source.fetch(ctx, defaultsDesc)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrFetchingDefaultsHCL, defaultsDesc.Digest, err)
+		}
+		contents.Defaults, err = bundleinternal.ParseDefaultsBytes(ctx, defaultsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("%w %s: %w", ErrParsingDefaultsHCL, defaultsDesc.Digest, err)
+		}
+	}
+
+	valuesFiles := valuesLayersByPackage(def.definition)
+	for _, pkg := range def.bundle.Packages {
+		pkgContents, err := readPackageContents(ctx, def.index, pkg, source.fetch)
+		if err != nil {
+			return nil, InspectingPackageContentsError{Package: pkg.Name, Err: err}
+		}
+		pkgContents.ValuesFiles = valuesFiles[pkg.Name]
+		contents.Packages[pkg.Name] = *pkgContents
+	}
+	return contents, nil
+}
+
+func readPackageContents(ctx context.Context, idx ocispec.Index, pkg spec.Package, fetch inspectBlobFetcher) (*PackageContents, error) {
+	entry, err := findPackageManifest(idx, pkg)
+	if err != nil {
+		return nil, err
+	}
+	contents := &PackageContents{
+		Source:         pkg.Source,
+		ManifestDigest: entry.Digest.String(),
+		DependsOn:      make([]string, len(pkg.DependsOn)),
+	}
+	for i, dependency := range pkg.DependsOn {
+		contents.DependsOn[i] = dependency.Name
+	}
+	slices.Sort(contents.DependsOn)
+
+	manifestBytes, err := fetch(ctx, *entry)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s for package %q: %w", ErrFetchingPackageManifest, entry.Digest, pkg.Name, err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("%w %s for package %q: %w", ErrParsingPackageManifest, entry.Digest, pkg.Name, err)
+	}
+	if sbomLayer, ok := findLayerByTitleOptional(manifest, "sboms.tar"); ok {
+		contents.SBOMDigest = sbomLayer.Digest.String()
+	}
+	zarfLayer, ok := findLayerByTitleOptional(manifest, "zarf.yaml")
+	if !ok {
+		return contents, nil
+	}
+	zarfBytes, err := fetch(ctx, zarfLayer)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s for package %q: %w", ErrFetchingZarfYAML, zarfLayer.Digest, pkg.Name, err)
+	}
+	var metadata packageContentMetadata
+	if err := yaml.Unmarshal(zarfBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("%w %s for package %q: %w", ErrParsingZarfYAML, zarfLayer.Digest, pkg.Name, err)
+	}
+	contents.Version = metadata.Metadata.Version
+	for _, component := range metadata.Components {
+		contents.Images = append(contents.Images, component.Images...)
+	}
+	slices.Sort(contents.Images)
+	contents.Images = slices.Compact(contents.Images)
+	return contents, nil
+}
+
+// valuesLayersByPackage maps package names to values layer digests ordered by
+// the index in their values/<pkg>/<i>.yaml title.
+func valuesLayersByPackage(definition ocispec.Manifest) map[string][]string {
+	type indexedDigest struct {
+		idx    int
+		digest string
+	}
+	indexed := make(map[string][]indexedDigest)
+	for _, layer := range definition.Layers {
+		if layer.MediaType != udsoci.MediaTypeBundleValuesYAML {
+			continue
+		}
+		parts := strings.Split(layer.Annotations[ocispec.AnnotationTitle], "/")
+		if len(parts) != 3 || parts[0] != "values" || !strings.HasSuffix(parts[2], ".yaml") {
+			continue
+		}
+		n, err := strconv.Atoi(strings.TrimSuffix(parts[2], ".yaml"))
+		if err != nil {
+			continue
+		}
+		indexed[parts[1]] = append(indexed[parts[1]], indexedDigest{idx: n, digest: layer.Digest.String()})
+	}
+
+	result := make(map[string][]string, len(indexed))
+	for pkg, entries := range indexed {
+		slices.SortFunc(entries, func(a, b indexedDigest) int { return a.idx - b.idx })
+		digests := make([]string, len(entries))
+		for i, entry := range entries {
+			digests[i] = entry.digest
+		}
+		result[pkg] = digests
+	}
+	return result
+}
@@ -5,11 +5,13 @@ package artifact
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/oci"
@@ -27,7 +29,17 @@ type CreateOptions struct {
 	BundleHCL   []byte
 	DefaultsHCL []byte
 	BundleDir   string
-	Streams     iostreams.IOStreams
+	// Lock pins OCI package sources to the manifest digests they resolved
+	// to. Packages without a matching lock entry are fetched by source.
+	Lock *bundleinternal.Lock
+	// OutputDir is the directory the archive is written to. It defaults to
+	// BundleDir.
+	OutputDir string
+	// Included holds the bundles fetched for the bundle's bundle blocks,
+	// keyed by block name. Included packages are copied from them instead
+	// of being fetched from their original sources.
+	Included map[string]*ExtractedBundle
+	Streams  iostreams.IOStreams
 }
 
 // CreateResult contains the path written by Create.
@@ -47,6 +59,15 @@ func Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
 	if opts.BundleDir == "" {
 		return nil, ErrBundleDirRequired
 	}
+	// Archive timestamps come only from SOURCE_DATE_EPOCH so that identical
+	// inputs produce byte-identical archives.
+	buildTime, pinned, err := bundleinternal.SourceDateEpoch()
+	if err != nil {
+		return nil, err
+	}
+	if !pinned {
+		buildTime = time.Time{}
+	}
 
 	root, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-create-*")
 	if err != nil {
@@ -66,7 +87,13 @@ func Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
 
 	var packageManifests []ocispec.Descriptor
 	for i := range opts.Bundle.Packages {
-		manifests, err := ingestSource(ctx, &opts.Bundle.Packages[i], opts.Config, store, opts.BundleDir, opts.Streams)
+		pkg := &opts.Bundle.Packages[i]
+		var manifests []ocispec.Descriptor
+		if pkg.Bundle != "" {
+			manifests, err = ingestIncludedPackage(ctx, pkg, opts.Included[pkg.Bundle], store, opts.Streams)
+		} else {
+			manifests, err = ingestSource(ctx, pkg, opts.Lock, opts.Config, store, opts.BundleDir, opts.Streams)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -74,7 +101,7 @@ func Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
 	}
 
 	opts.Streams.Info("writing bundle definition", "packages", len(opts.Bundle.Packages))
-	definition, err := createBundleDefinitionManifest(ctx, opts.Streams, ociDir, opts.BundleHCL, opts.DefaultsHCL, opts.BundleDir, opts.Bundle.Packages)
+	definition, err := createBundleDefinitionManifest(ctx, opts.Streams, ociDir, opts.BundleHCL, opts.DefaultsHCL, opts.BundleDir, opts.Bundle.Packages, buildTime)
 	if err != nil {
 		return nil, err
 	}
@@ -86,8 +113,15 @@ func Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
 	if err := oci.WriteIndex(filepath.Join(ociDir, "index.json"), idx); err != nil {
 		return nil, err
 	}
+	if err := writeCreateAttestations(ctx, opts, root, ociDir, buildTime); err != nil {
+		return nil, err
+	}
 
-	outPath := filepath.Join(opts.BundleDir, bundleOutputName(opts.Bundle, opts.Config.Options.Architecture))
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = opts.BundleDir
+	}
+	outPath := filepath.Join(outputDir, bundleOutputName(opts.Bundle, opts.Config.Options.Architecture))
 	opts.Streams.Info("writing bundle archive", "output", outPath)
 	if err := WriteTarZst(ctx, opts.Streams, outPath, root); err != nil {
 		return nil, err
@@ -96,8 +130,9 @@ func Create(ctx context.Context, opts CreateOptions) (*CreateResult, error) {
 	return &CreateResult{OutputPath: outPath}, nil
 }
 
-// ingestSource ingests one package source into the OCI blob store.
-func ingestSource(ctx context.Context, pkg *spec.Package, config *bundleinternal.UDSBundleConfig, store *oci.Store, bundleDir string, streams iostreams.IOStreams) ([]ocispec.Descriptor, error) {
+// ingestSource ingests one package source into the OCI blob store. A lock
+// entry matching the package source pins the fetch to the locked digest.
+func ingestSource(ctx context.Context, pkg *spec.Package, lock *bundleinternal.Lock, config *bundleinternal.UDSBundleConfig, store *oci.Store, bundleDir string, streams iostreams.IOStreams) ([]ocispec.Descriptor, error) {
 	if pkg == nil {
 		return nil, ErrPackageNil
 	}
@@ -110,7 +145,14 @@ func ingestSource(ctx context.Context, pkg *spec.Package, config *bundleinternal
 	if err := zarf.ValidatePackageSignatureVerification(pkg.Name, pkg.SignatureVerification); err != nil {
 		return nil, err
 	}
-	streams.Info("ingesting package", "name", pkg.Name, "source", pkg.Source)
+	fetchSource := pkg.Source
+	locked, ok := lock.Package(pkg.Name)
+	if ok && locked.Source == pkg.Source {
+		fetchSource = zarf.PinnedSource(pkg.Source, zarf.ResolvedVersion{Tag: locked.Version, Digest: locked.Digest})
+	} else {
+		locked = bundleinternal.LockedPackage{}
+	}
+	streams.Info("ingesting package", "name", pkg.Name, "source", fetchSource)
 
 	zarfConfig := bundleinternal.ConfigOptions{
 		LogLevel:      config.Options.LogLevel,
@@ -120,7 +162,7 @@ func ingestSource(ctx context.Context, pkg *spec.Package, config *bundleinternal
 		TmpDir:        config.Options.TmpDir,
 		Concurrency:   config.Options.Concurrency,
 	}
-	source := zarf.NewPackageSource(pkg.Source, zarfConfig, bundleDir, streams)
+	source := zarf.NewPackageSource(fetchSource, zarfConfig, bundleDir, streams)
 
 	filter := zarf.BuildComponentFilter(pkg.OptionalComponents)
 	verificationWorkspace, err := os.MkdirTemp(config.Options.TmpDir, "uds-package-verify-*")
@@ -166,14 +208,40 @@ func ingestSource(ctx context.Context, pkg *spec.Package, config *bundleinternal
 
 	manifests := make([]ocispec.Descriptor, len(descriptors))
 	for i, desc := range descriptors {
-		manifests[i] = annotatePackageDescriptor(desc, pkg)
+		manifests[i] = annotatePackageDescriptor(desc, pkg, locked.Digest)
 	}
 	annotatePackageVerification(manifests, loadOptions.VerificationStrategy != layout.VerifyNever)
 
 	return manifests, nil
 }
 
-func annotatePackageDescriptor(desc ocispec.Descriptor, pkg *spec.Package) ocispec.Descriptor {
+// ingestIncludedPackage copies an included package from the bundle it was
+// included from. The package keeps the digest its source bundle pinned and
+// that bundle's record of its signature verification.
+func ingestIncludedPackage(ctx context.Context, pkg *spec.Package, included *ExtractedBundle, store *oci.Store, streams iostreams.IOStreams) ([]ocispec.Descriptor, error) {
+	if included == nil {
+		return nil, IngestingPackageError{Package: pkg.Name, Err: fmt.Errorf("%w: bundle %q was not fetched", ErrIncludedPackageNotFound, pkg.Bundle)}
+	}
+	name := strings.TrimPrefix(pkg.Name, pkg.Bundle+".")
+	desc, ok := included.PackageManifests[name]
+	if !ok {
+		return nil, IngestingPackageError{Package: pkg.Name, Err: fmt.Errorf("%w: bundle %q has no package %q", ErrIncludedPackageNotFound, pkg.Bundle, name)}
+	}
+	streams.Info("ingesting included package", "name", pkg.Name, "bundle", pkg.Bundle, "digest", desc.Digest.String())
+
+	src, err := oci.OpenStore(included.OCIDir)
+	if err != nil {
+		return nil, IngestingPackageError{Package: pkg.Name, Err: err}
+	}
+	if err := oci.CopyGraph(ctx, src, store, desc); err != nil {
+		return nil, IngestingPackageError{Package: pkg.Name, Err: err}
+	}
+	manifests := []ocispec.Descriptor{annotatePackageDescriptor(desc, pkg, desc.Annotations[oci.AnnotationPackageDigest])}
+	annotatePackageVerification(manifests, desc.Annotations[oci.AnnotationPackageVerification] == oci.AnnotationPackageVerificationVerified)
+	return manifests, nil
+}
+
+func annotatePackageDescriptor(desc ocispec.Descriptor, pkg *spec.Package, pinnedDigest string) ocispec.Descriptor {
 	annotations := maps.Clone(desc.Annotations)
 	if annotations == nil {
 		annotations = map[string]string{}
@@ -181,6 +249,10 @@ func annotatePackageDescriptor(desc ocispec.Descriptor, pkg *spec.Package) ocisp
 	annotations[oci.AnnotationPackageName] = pkg.Name
 	annotations[oci.AnnotationPackageSource] = pkg.Source
 	annotations[ocispec.AnnotationRefName] = pkg.Name
+	delete(annotations, oci.AnnotationPackageDigest)
+	if pinnedDigest != "" {
+		annotations[oci.AnnotationPackageDigest] = pinnedDigest
+	}
 	delete(annotations, oci.AnnotationPackageVerification)
 	desc.Annotations = annotations
 	return desc
@@ -236,7 +308,7 @@ func sanitizeFileComponent(s string) string {
 
 // createBundleDefinitionManifest builds an OCI 1.1 artifact manifest that stores the bundle HCL file and all package values files as
 // content-addressed layers. It is identified by artifactType so consumers can better identify it in the index.
-func createBundleDefinitionManifest(ctx context.Context, streams iostreams.IOStreams, ociDir string, hclData, defaultsData []byte, bundleDir string, pkgs []spec.Package) (ocispec.Descriptor, error) {
+func createBundleDefinitionManifest(ctx context.Context, streams iostreams.IOStreams, ociDir string, hclData, defaultsData []byte, bundleDir string, pkgs []spec.Package, created time.Time) (ocispec.Descriptor, error) {
 	store, err := oci.CreateStore(ociDir)
 	if err != nil {
 		return ocispec.Descriptor{}, err
@@ -288,6 +360,14 @@ func createBundleDefinitionManifest(ctx context.Context, streams iostreams.IOStr
 			if err != nil {
 				return ocispec.Descriptor{}, ReadingValueFileError{Package: pkg.Name, Path: vf, Err: err}
 			}
+			// The artifact does not contain the files a template reads, so
+			// they are inlined. Files that do not parse as templates are kept
+			// as written; deploy reports them if it renders them.
+			if inlined, err := zarf.InlineValuesTemplateFiles(vf, data, bundleDir); err == nil {
+				data = inlined
+			} else if !errors.Is(err, zarf.ErrParseValuesTemplate) {
+				return ocispec.Descriptor{}, ReadingValueFileError{Package: pkg.Name, Path: vf, Err: err}
+			}
 			valDesc, err := pushBlob(oci.MediaTypeBundleValuesYAML, data, map[string]string{
 				ocispec.AnnotationTitle: fmt.Sprintf("values/%s/%d.yaml", pkg.Name, i),
 			})
@@ -299,8 +379,11 @@ func createBundleDefinitionManifest(ctx context.Context, streams iostreams.IOStr
 	}
 
 	// PackManifest pushes the empty-JSON config blob, builds the OCI 1.1 artifact manifest with our artifactType,
-	// and pushes the manifest blob with a reproducible created timestamp.
-	desc, err := oci.PackBundleDefinitionManifest(ctx, store, layers)
+	// and pushes the manifest blob with a reproducible created timestamp: SOURCE_DATE_EPOCH when set, otherwise the Unix epoch.
+	if created.IsZero() {
+		created = time.Unix(0, 0)
+	}
+	desc, err := oci.PackBundleDefinitionManifest(ctx, store, layers, created)
 	if err != nil {
 		return ocispec.Descriptor{}, fmt.Errorf("%w with %d layers: %w", ErrPackingBundleDefinitionManifest, len(layers), err)
 	}
@@ -6,6 +6,7 @@ package artifact
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
@@ -49,7 +50,7 @@ func TestIngestSourceVerificationBoundary(t *testing.T) {
 		manifests, err := ingestSource(t.Context(), &spec.Package{
 			Name: "signed", Source: pkgDir,
 			SignatureVerification: &spec.PackageSignatureVerification{PublicKey: "test public key"},
-		}, newConfig(t), store, t.TempDir(), iostreams.IOStreams{})
+		}, nil, newConfig(t), store, t.TempDir(), iostreams.IOStreams{})
 		require.ErrorContains(t, err, "package is not signed")
 		assert.Empty(t, manifests)
 		entries, readErr := os.ReadDir(filepath.Join(storeRoot, "blobs", "sha256"))
@@ -67,7 +68,7 @@ func TestIngestSourceVerificationBoundary(t *testing.T) {
 		manifests, err := ingestSource(t.Context(), &spec.Package{
 			Name: "unsigned", Source: pkgDir,
 			SignatureVerification: &spec.PackageSignatureVerification{Verify: &verify},
-		}, newConfig(t), mustTestCreateStore(t), t.TempDir(), streams)
+		}, nil, newConfig(t), mustTestCreateStore(t), t.TempDir(), streams)
 		require.NoError(t, err)
 		require.Len(t, manifests, 1)
 		assert.Equal(t, "unsigned", manifests[0].Annotations[oci.AnnotationPackageName])
@@ -92,7 +93,7 @@ func TestIngestSourceRejectsNilInputs(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, err := ingestSource(t.Context(), tt.pkg, tt.config, mustTestCreateStore(t), t.TempDir(), iostreams.IOStreams{})
+			_, err := ingestSource(t.Context(), tt.pkg, nil, tt.config, mustTestCreateStore(t), t.TempDir(), iostreams.IOStreams{})
 			require.ErrorContains(t, err, tt.want)
 		})
 	}
@@ -101,12 +102,21 @@ func TestIngestSourceRejectsNilInputs(t *testing.T) {
 func TestAnnotatePackageDescriptorUsesBundlePackageNameForOCISources(t *testing.T) {
 	desc := annotatePackageDescriptor(ocispec.Descriptor{Annotations: map[string]string{
 		oci.AnnotationPackageVerification: oci.AnnotationPackageVerificationVerified,
-	}}, &spec.Package{Name: "mypkg", Source: "oci://example.com/pkg:v1"})
+	}}, &spec.Package{Name: "mypkg", Source: "oci://example.com/pkg:v1"}, "")
 
 	assert.Equal(t, "mypkg", desc.Annotations[oci.AnnotationPackageName])
 	assert.Equal(t, "oci://example.com/pkg:v1", desc.Annotations[oci.AnnotationPackageSource])
 	assert.Equal(t, "mypkg", desc.Annotations[ocispec.AnnotationRefName])
 	assert.NotContains(t, desc.Annotations, oci.AnnotationPackageVerification)
+	assert.NotContains(t, desc.Annotations, oci.AnnotationPackageDigest)
+}
+
+func TestAnnotatePackageDescriptorRecordsPinnedDigest(t *testing.T) {
+	pinned := "sha256:" + strings.Repeat("a", 64)
+	desc := annotatePackageDescriptor(ocispec.Descriptor{}, &spec.Package{Name: "core", Source: "oci://example.com/core:~0.40"}, pinned)
+
+	assert.Equal(t, "oci://example.com/core:~0.40", desc.Annotations[oci.AnnotationPackageSource])
+	assert.Equal(t, pinned, desc.Annotations[oci.AnnotationPackageDigest])
 }
 
 func TestAnnotatePackageVerification(t *testing.T) {
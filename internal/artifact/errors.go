@@ -6,6 +6,7 @@ package artifact
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/opencontainers/go-digest"
 )
@@ -48,10 +49,27 @@ var (
 	ErrParsingPackageManifest            = errors.New("parsing package manifest")
 	ErrFetchingZarfYAML                  = errors.New("fetching zarf.yaml")
 	ErrParsingZarfYAML                   = errors.New("parsing zarf.yaml")
+	ErrFetchingDefaultsHCL               = errors.New("fetching defaults HCL")
+	ErrParsingDefaultsHCL                = errors.New("parsing defaults HCL")
+	ErrGeneratingAttestation             = errors.New("generating bundle attestation")
+	ErrParsingAttestation                = errors.New("parsing bundle attestation")
+	ErrReadingAttestations               = errors.New("reading bundle attestations")
+	ErrReadingSignatures                 = errors.New("reading bundle signatures")
+	ErrBlobDigestMismatch                = errors.New("blob content does not match its digest")
+	ErrBlobMissing                       = errors.New("referenced blob is missing from the archive")
+	ErrWritingExportVolume               = errors.New("writing export volume")
+	ErrImportingExportVolume             = errors.New("importing export volume")
+	ErrReadingExportManifest             = errors.New("reading export manifest")
+	ErrExportVolumeNotFound              = errors.New("no export volume found")
+	ErrInvalidExport                     = errors.New("invalid bundle export")
+	ErrIncludedPackageNotFound           = errors.New("included package not found in its source bundle")
 )
 
 var (
 	_ error = (*LayerTitleEscapesDestinationError)(nil)
+	_ error = (*ExportVolumeTooSmallError)(nil)
+	_ error = (*MissingExportVolumesError)(nil)
+	_ error = (*ExportFileMismatchError)(nil)
 	_ error = (*OutputPathIsDirError)(nil)
 	_ error = (*UnsupportedFileTypeError)(nil)
 	_ error = (*InvalidBundleIndexError)(nil)
@@ -75,6 +93,7 @@ var (
 	_ error = (*UnsupportedMediaTypeError)(nil)
 	_ error = (*MissingBundleArchitectureError)(nil)
 	_ error = (*InspectingPackageSignatureError)(nil)
+	_ error = (*InspectingPackageContentsError)(nil)
 	_ error = (*UnsupportedPackageEntryMediaTypeError)(nil)
 	_ error = (*MultiplePackageManifestEntriesError)(nil)
 	_ error = (*PackageManifestNotFoundError)(nil)
@@ -299,6 +318,16 @@ func (e InspectingPackageSignatureError) Error() string {
 }
 func (e InspectingPackageSignatureError) Unwrap() error { return e.Err }
 
+type InspectingPackageContentsError struct {
+	Package string
+	Err     error
+}
+
+func (e InspectingPackageContentsError) Error() string {
+	return fmt.Sprintf("inspecting package %q contents: %v", e.Package, e.Err)
+}
+func (e InspectingPackageContentsError) Unwrap() error { return e.Err }
+
 type UnsupportedPackageEntryMediaTypeError struct {
 	Package   string
 	MediaType string
@@ -328,3 +357,27 @@ type LayerNotFoundError struct{ Title string }
 func (e LayerNotFoundError) Error() string {
 	return fmt.Sprintf("%s layer not found in manifest", e.Title)
 }
+
+type ExportVolumeTooSmallError struct {
+	Size    int64
+	Minimum int64
+}
+
+func (e ExportVolumeTooSmallError) Error() string {
+	return fmt.Sprintf("volume size %d bytes is too small for the export manifest; use at least %d bytes", e.Size, e.Minimum)
+}
+
+type MissingExportVolumesError struct {
+	Missing []string
+	Total   int
+}
+
+func (e MissingExportVolumesError) Error() string {
+	return fmt.Sprintf("export is missing %d of %d volumes: %s", len(e.Missing), e.Total, strings.Join(e.Missing, ", "))
+}
+
+type ExportFileMismatchError struct{ Path string }
+
+func (e ExportFileMismatchError) Error() string {
+	return fmt.Sprintf("%s does not match the digest recorded in the export manifest", e.Path)
+}
@@ -0,0 +1,486 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ExportManifestFileName is the manifest written to every volume of an
+// exported bundle layout.
+const ExportManifestFileName = "uds-bundle-export.json"
+
+// exportManifestSchemaVersion is the only export manifest schema version
+// ImportLayout accepts.
+const exportManifestSchemaVersion = 1
+
+// ExportManifest describes an exported bundle layout and every volume it
+// spans. An identical copy, differing only in Volume, is written to each
+// volume so any one of them identifies the whole set.
+type ExportManifest struct {
+	SchemaVersion int `json:"schemaVersion"`
+	// Volume names the volume this copy of the manifest was written to.
+	Volume string `json:"volume"`
+	// Bundle is the digest of the exported bundle index.
+	Bundle  digest.Digest  `json:"bundle"`
+	Volumes []ExportVolume `json:"volumes"`
+}
+
+// ExportVolume lists the files stored on one volume.
+type ExportVolume struct {
+	Name string `json:"name"`
+	// Checksum is the digest of the volume's file list, one
+	// "<digest> <size> <path>" line per file.
+	Checksum digest.Digest `json:"checksum"`
+	Size     int64         `json:"size"`
+	Files    []ExportFile  `json:"files"`
+}
+
+// ExportFile is a file stored on a volume. A file too large for one volume
+// is stored as parts: each part holds Size bytes of Target from Offset.
+type ExportFile struct {
+	Path   string        `json:"path"`
+	Digest digest.Digest `json:"digest"`
+	Size   int64         `json:"size"`
+	Target string        `json:"target,omitempty"`
+	Offset int64         `json:"offset,omitempty"`
+}
+
+// target returns the workspace path the file is reassembled into.
+func (f ExportFile) target() string {
+	if f.Target != "" {
+		return f.Target
+	}
+	return f.Path
+}
+
+// ExportLayout writes the bundle workspace at workspace, an extracted bundle
+// archive, to outputDir as an unpacked OCI image layout. The workspace graph
+// is verified first.
+//
+// With a volumeSize of zero, or when everything fits on one volume, the
+// layout is written directly to outputDir. Otherwise it is spread over
+// volume-001, volume-002, ... directories under outputDir, none larger than
+// volumeSize bytes including its manifest; files larger than a volume are
+// split into parts.
+func ExportLayout(ctx context.Context, streams iostreams.IOStreams, workspace, outputDir string, volumeSize int64) (*ExportManifest, error) {
+	ociDir := filepath.Join(workspace, "oci")
+	bundleDigest, err := verifyWorkspaceLayout(ctx, ociDir)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := archiveEntries(workspace)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]exportSource, 0, len(entries))
+	for _, entry := range entries {
+		info, err := os.Stat(filepath.Join(workspace, filepath.FromSlash(entry)))
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, exportSource{path: entry, size: info.Size()})
+	}
+
+	plan, err := planExportVolumes(sources, volumeSize)
+	if err != nil {
+		return nil, err
+	}
+	manifest := &ExportManifest{SchemaVersion: exportManifestSchemaVersion, Bundle: bundleDigest, Volumes: plan}
+	for i := range manifest.Volumes {
+		volume := &manifest.Volumes[i]
+		dir := exportVolumeDir(outputDir, len(manifest.Volumes), volume.Name)
+		streams.Info("writing export volume", "volume", volume.Name, "path", dir, "files", len(volume.Files))
+		for j := range volume.Files {
+			file := &volume.Files[j]
+			file.Digest, err = copyExportFile(ctx, filepath.Join(workspace, filepath.FromSlash(file.target())), file.Offset, file.Size, filepath.Join(dir, filepath.FromSlash(file.Path)))
+			if err != nil {
+				return nil, fmt.Errorf("%w %s to volume %s: %w", ErrWritingExportVolume, file.Path, volume.Name, err)
+			}
+		}
+		volume.Checksum = volumeChecksum(volume.Files)
+	}
+	for _, volume := range manifest.Volumes {
+		if err := writeExportManifest(exportVolumeDir(outputDir, len(manifest.Volumes), volume.Name), *manifest, volume.Name); err != nil {
+			return nil, err
+		}
+	}
+	return manifest, nil
+}
+
+// ImportLayout reassembles the volumes of an exported bundle layout into
+// workspace, which must be empty. Each entry of dirs is a volume or a
+// directory holding volume-* directories. Every file is checked against the
+// digest recorded for it, every volume of the export must be present, and the
+// reassembled OCI graph is verified before it is returned.
+func ImportLayout(ctx context.Context, streams iostreams.IOStreams, dirs []string, workspace string) (*ExportManifest, error) {
+	manifest, volumeDirs, err := discoverExportVolumes(dirs)
+	if err != nil {
+		return nil, err
+	}
+	for _, volume := range manifest.Volumes {
+		dir := volumeDirs[volume.Name]
+		streams.Info("importing export volume", "volume", volume.Name, "path", dir, "files", len(volume.Files))
+		for _, file := range volume.Files {
+			if err := importExportFile(ctx, dir, workspace, file); err != nil {
+				return nil, fmt.Errorf("%w %s from volume %s: %w", ErrImportingExportVolume, file.Path, volume.Name, err)
+			}
+		}
+	}
+	if err := checkExportParts(manifest.Volumes); err != nil {
+		return nil, err
+	}
+	bundleDigest, err := verifyWorkspaceLayout(ctx, filepath.Join(workspace, "oci"))
+	if err != nil {
+		return nil, err
+	}
+	if bundleDigest != manifest.Bundle {
+		return nil, fmt.Errorf("%w: imported bundle index is %s, export manifest records %s", ErrInvalidExport, bundleDigest, manifest.Bundle)
+	}
+	return manifest, nil
+}
+
+// verifyWorkspaceLayout verifies the bundle OCI layout at ociDir and returns
+// the digest of its index.
+func verifyWorkspaceLayout(ctx context.Context, ociDir string) (digest.Digest, error) {
+	indexBytes, err := os.ReadFile(filepath.Join(ociDir, "index.json"))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrReadingBundleIndex, err)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(indexBytes, &idx); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrParsingBundleIndex, err)
+	}
+	if !oci.IsBundleIndex(idx) {
+		return "", fmt.Errorf("%w: index does not declare artifactType %s", ErrInvalidBundle, oci.MediaTypeBundle)
+	}
+	if err := oci.VerifyLocalLayoutGraph(ctx, ociDir, indexBytes); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrVerifyingArtifactDigest, err)
+	}
+	return digest.FromBytes(indexBytes), nil
+}
+
+// exportSource is a workspace file to export.
+type exportSource struct {
+	path string
+	size int64
+}
+
+// planExportVolumes assigns sources to volumes of at most volumeSize bytes,
+// each including its manifest. A file that does not fit in the space left on
+// a volume starts the next one; only files larger than a whole volume are
+// split. Digests and checksums are left for the writer to fill in.
+func planExportVolumes(sources []exportSource, volumeSize int64) ([]ExportVolume, error) {
+	if volumeSize <= 0 {
+		volume := ExportVolume{Name: exportVolumeName(1)}
+		for _, src := range sources {
+			volume.Files = append(volume.Files, ExportFile{Path: src.path, Size: src.size})
+			volume.Size += src.size
+		}
+		return []ExportVolume{volume}, nil
+	}
+
+	// The manifest lists every volume, so its size depends on the plan.
+	// Reserve room for it, then plan again with a larger reserve until the
+	// manifest fits.
+	reserve := int64(4096)
+	for {
+		capacity := volumeSize - reserve
+		if capacity <= 0 {
+			return nil, ExportVolumeTooSmallError{Size: volumeSize, Minimum: reserve + 1}
+		}
+		volumes := packExportVolumes(sources, capacity)
+		size, err := exportManifestSize(volumes)
+		if err != nil {
+			return nil, err
+		}
+		if size <= reserve {
+			return volumes, nil
+		}
+		reserve = size + 4096
+	}
+}
+
+func packExportVolumes(sources []exportSource, capacity int64) []ExportVolume {
+	volumes := []ExportVolume{{Name: exportVolumeName(1)}}
+	for _, src := range sources {
+		current := &volumes[len(volumes)-1]
+		if src.size <= capacity {
+			if current.Size+src.size > capacity {
+				volumes = append(volumes, ExportVolume{Name: exportVolumeName(len(volumes) + 1)})
+				current = &volumes[len(volumes)-1]
+			}
+			current.Files = append(current.Files, ExportFile{Path: src.path, Size: src.size})
+			current.Size += src.size
+			continue
+		}
+		for part, offset := 0, int64(0); offset < src.size; part++ {
+			if current.Size == capacity {
+				volumes = append(volumes, ExportVolume{Name: exportVolumeName(len(volumes) + 1)})
+				current = &volumes[len(volumes)-1]
+			}
+			n := min(src.size-offset, capacity-current.Size)
+			current.Files = append(current.Files, ExportFile{
+				Path:   fmt.Sprintf("%s.part-%04d", src.path, part),
+				Size:   n,
+				Target: src.path,
+				Offset: offset,
+			})
+			current.Size += n
+			offset += n
+		}
+	}
+	return volumes
+}
+
+// exportManifestSize returns the size of the manifest for volumes once every
+// digest is filled in.
+func exportManifestSize(volumes []ExportVolume) (int64, error) {
+	placeholder := digest.FromBytes(nil)
+	sized := make([]ExportVolume, len(volumes))
+	for i, volume := range volumes {
+		sized[i] = volume
+		sized[i].Checksum = placeholder
+		sized[i].Files = slices.Clone(volume.Files)
+		for j := range sized[i].Files {
+			sized[i].Files[j].Digest = placeholder
+		}
+	}
+	data, err := marshalExportManifest(ExportManifest{
+		SchemaVersion: exportManifestSchemaVersion,
+		Volume:        exportVolumeName(len(volumes)),
+		Bundle:        placeholder,
+		Volumes:       sized,
+	})
+	return int64(len(data)), err
+}
+
+func exportVolumeName(n int) string {
+	return fmt.Sprintf("volume-%03d", n)
+}
+
+// exportVolumeDir returns where volume name of count volumes is written.
+func exportVolumeDir(outputDir string, count int, name string) string {
+	if count == 1 {
+		return outputDir
+	}
+	return filepath.Join(outputDir, name)
+}
+
+// volumeChecksum digests the file list of a volume.
+func volumeChecksum(files []ExportFile) digest.Digest {
+	var b strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&b, "%s %d %s\n", file.Digest, file.Size, file.Path)
+	}
+	return digest.FromString(b.String())
+}
+
+// copyExportFile copies size bytes of src from offset to a new file at dst and
+// returns their digest.
+func copyExportFile(ctx context.Context, src string, offset, size int64, dst string) (_ digest.Digest, retErr error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = in.Close() }()
+	if err := os.MkdirAll(filepath.Dir(dst), filesystem.PrivateDirectoryMode); err != nil {
+		return "", err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, filesystem.PrivateFileMode)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := out.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	digester := digest.SHA256.Digester()
+	if _, err := io.Copy(io.MultiWriter(out, digester.Hash()), io.NewSectionReader(in, offset, size)); err != nil {
+		return "", err
+	}
+	return digester.Digest(), nil
+}
+
+func marshalExportManifest(manifest ExportManifest) ([]byte, error) {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func writeExportManifest(dir string, manifest ExportManifest, volume string) error {
+	manifest.Volume = volume
+	data, err := marshalExportManifest(manifest)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrWritingExportVolume, err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ExportManifestFileName), data, filesystem.PrivateFileMode); err != nil {
+		return fmt.Errorf("%w: %w", ErrWritingExportVolume, err)
+	}
+	return nil
+}
+
+// discoverExportVolumes reads the manifest of every volume found in dirs and
+// checks that they describe the same export and that no volume is missing.
+func discoverExportVolumes(dirs []string) (*ExportManifest, map[string]string, error) {
+	var candidates []string
+	for _, dir := range dirs {
+		if _, err := os.Stat(filepath.Join(dir, ExportManifestFileName)); err == nil {
+			candidates = append(candidates, dir)
+			continue
+		}
+		nested, err := filepath.Glob(filepath.Join(dir, "volume-*", ExportManifestFileName))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(nested) == 0 {
+			return nil, nil, fmt.Errorf("%w: %s", ErrExportVolumeNotFound, dir)
+		}
+		for _, manifestPath := range nested {
+			candidates = append(candidates, filepath.Dir(manifestPath))
+		}
+	}
+
+	var reference *ExportManifest
+	volumeDirs := make(map[string]string)
+	for _, dir := range candidates {
+		manifest, err := readExportManifest(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		if reference == nil {
+			reference = manifest
+		} else if !sameExport(*reference, *manifest) {
+			return nil, nil, fmt.Errorf("%w: %s belongs to a different export", ErrInvalidExport, dir)
+		}
+		if _, ok := volumeDirs[manifest.Volume]; !ok {
+			volumeDirs[manifest.Volume] = dir
+		}
+	}
+
+	var missing []string
+	for _, volume := range reference.Volumes {
+		if volumeChecksum(volume.Files) != volume.Checksum {
+			return nil, nil, fmt.Errorf("%w: volume %s file list does not match its checksum", ErrInvalidExport, volume.Name)
+		}
+		if _, ok := volumeDirs[volume.Name]; !ok {
+			missing = append(missing, volume.Name)
+		}
+	}
+	if len(missing) != 0 {
+		return nil, nil, MissingExportVolumesError{Missing: missing, Total: len(reference.Volumes)}
+	}
+	return reference, volumeDirs, nil
+}
+
+func readExportManifest(dir string) (*ExportManifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ExportManifestFileName))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadingExportManifest, err)
+	}
+	var manifest ExportManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrReadingExportManifest, dir, err)
+	}
+	if manifest.SchemaVersion != exportManifestSchemaVersion {
+		return nil, fmt.Errorf("%w %s: unsupported schema version %d", ErrReadingExportManifest, dir, manifest.SchemaVersion)
+	}
+	if !slices.ContainsFunc(manifest.Volumes, func(v ExportVolume) bool { return v.Name == manifest.Volume }) {
+		return nil, fmt.Errorf("%w %s: volume %q is not listed in the manifest", ErrReadingExportManifest, dir, manifest.Volume)
+	}
+	return &manifest, nil
+}
+
+// sameExport reports whether two volume manifests describe the same export.
+func sameExport(a, b ExportManifest) bool {
+	return a.Bundle == b.Bundle && slices.EqualFunc(a.Volumes, b.Volumes, func(x, y ExportVolume) bool {
+		return x.Name == y.Name && x.Checksum == y.Checksum
+	})
+}
+
+// importExportFile copies file from the volume at dir into its place in
+// workspace, checking its size and digest.
+func importExportFile(ctx context.Context, dir, workspace string, file ExportFile) (retErr error) {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !filepath.IsLocal(filepath.FromSlash(file.Path)) || !filepath.IsLocal(filepath.FromSlash(file.target())) || path.Base(file.target()) == ExportManifestFileName {
+		return fmt.Errorf("%w: unsafe path %q", ErrInvalidExport, file.Path)
+	}
+	if err := file.Digest.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidExport, err)
+	}
+	in, err := os.Open(filepath.Join(dir, filepath.FromSlash(file.Path)))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = in.Close() }()
+	dst := filepath.Join(workspace, filepath.FromSlash(file.target()))
+	if err := os.MkdirAll(filepath.Dir(dst), filesystem.PrivateDirectoryMode); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE, filesystem.PrivateFileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	verifier := file.Digest.Verifier()
+	n, err := io.Copy(io.NewOffsetWriter(out, file.Offset), io.TeeReader(io.LimitReader(in, file.Size+1), verifier))
+	if err != nil {
+		return err
+	}
+	if n != file.Size || !verifier.Verified() {
+		return ExportFileMismatchError{Path: file.Path}
+	}
+	return nil
+}
+
+// checkExportParts checks that the parts of every split file cover it from
+// start to end without gaps or overlaps.
+func checkExportParts(volumes []ExportVolume) error {
+	parts := make(map[string][]ExportFile)
+	for _, volume := range volumes {
+		for _, file := range volume.Files {
+			if file.Target != "" {
+				parts[file.Target] = append(parts[file.Target], file)
+			}
+		}
+	}
+	for target, files := range parts {
+		sort.Slice(files, func(i, j int) bool { return files[i].Offset < files[j].Offset })
+		var next int64
+		for _, file := range files {
+			if file.Offset != next {
+				return fmt.Errorf("%w: parts of %s do not cover it contiguously", ErrInvalidExport, target)
+			}
+			next += file.Size
+		}
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackExportVolumes(t *testing.T) {
+	volumes := packExportVolumes([]exportSource{
+		{path: "oci/index.json", size: 40},
+		{path: "oci/blobs/sha256/big", size: 250},
+		{path: "oci/blobs/sha256/small", size: 60},
+		{path: "oci/oci-layout", size: 30},
+	}, 100)
+
+	require.Len(t, volumes, 4)
+	assert.Equal(t, []ExportFile{
+		{Path: "oci/index.json", Size: 40},
+		{Path: "oci/blobs/sha256/big.part-0000", Size: 60, Target: "oci/blobs/sha256/big"},
+	}, volumes[0].Files)
+	assert.Equal(t, []ExportFile{{Path: "oci/blobs/sha256/big.part-0001", Size: 100, Target: "oci/blobs/sha256/big", Offset: 60}}, volumes[1].Files)
+	assert.Equal(t, []ExportFile{{Path: "oci/blobs/sha256/big.part-0002", Size: 90, Target: "oci/blobs/sha256/big", Offset: 160}}, volumes[2].Files)
+	assert.Equal(t, []ExportFile{
+		{Path: "oci/blobs/sha256/small", Size: 60},
+		{Path: "oci/oci-layout", Size: 30},
+	}, volumes[3].Files, "a file that fits on a volume is moved whole to the next one")
+	for i, volume := range volumes {
+		assert.Equal(t, exportVolumeName(i+1), volume.Name)
+		assert.LessOrEqual(t, volume.Size, int64(100))
+	}
+	require.NoError(t, checkExportParts(volumes))
+}
+
+func TestPlanExportVolumes_RejectsVolumesTooSmallForTheManifest(t *testing.T) {
+	_, err := planExportVolumes([]exportSource{{path: "oci/index.json", size: 40}}, 1024)
+	var tooSmall ExportVolumeTooSmallError
+	require.ErrorAs(t, err, &tooSmall)
+	assert.Greater(t, tooSmall.Minimum, int64(1024))
+}
+
+func TestCheckExportParts_RejectsGaps(t *testing.T) {
+	err := checkExportParts([]ExportVolume{
+		{Files: []ExportFile{{Path: "blob.part-0000", Size: 10, Target: "blob"}}},
+		{Files: []ExportFile{{Path: "blob.part-0002", Size: 10, Target: "blob", Offset: 20}}},
+	})
+	require.ErrorIs(t, err, ErrInvalidExport)
+}
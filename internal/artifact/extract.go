@@ -53,6 +53,23 @@ func ExtractArtifact(ctx context.Context, streams iostreams.IOStreams, tarPath,
 		return nil, fmt.Errorf("%w %q to %q: %w", ErrExtractingBundleArtifact, tarPath, dstDir, err)
 	}
 
+	return openBundleLayout(ctx, streams, tarPath, dstDir, nil, true)
+}
+
+// OpenPulledBundle materializes bundle.uds.hcl, defaults.uds.hcl, and values
+// files at the top of dstDir from the OCI layout that oci.PullBundleLayout
+// wrote to dstDir/oci. Only the packages named in packages (every package when
+// empty) are reported in PackageManifests, since the layout holds no other
+// package content. The layout is not re-verified because the pull checked
+// every digest as it copied content.
+func OpenPulledBundle(ctx context.Context, streams iostreams.IOStreams, dstDir string, packages []string) (*ExtractedBundle, error) {
+	return openBundleLayout(ctx, streams, dstDir, dstDir, packages, false)
+}
+
+// openBundleLayout reads the bundle OCI layout at dstDir/oci and materializes
+// its source files into dstDir. When verify is set every manifest graph in the
+// index is digest-verified first.
+func openBundleLayout(ctx context.Context, streams iostreams.IOStreams, source, dstDir string, packages []string, verify bool) (*ExtractedBundle, error) {
 	ociDir := filepath.Join(dstDir, "oci")
 	blobDir := filepath.Join(ociDir, "blobs", "sha256")
 	indexPath := filepath.Join(ociDir, "index.json")
@@ -66,7 +83,7 @@ func ExtractArtifact(ctx context.Context, streams iostreams.IOStreams, tarPath,
 		return nil, fmt.Errorf("%w %q: %w", ErrParsingBundleIndex, indexPath, err)
 	}
 	if !oci.IsBundleIndex(idx) {
-		return nil, InvalidBundleIndexError{Source: tarPath, ArtifactType: oci.MediaTypeBundle}
+		return nil, InvalidBundleIndexError{Source: source, ArtifactType: oci.MediaTypeBundle}
 	}
 	defEntry, defIdx, err := oci.FindBundleDefinition(idx)
 	if err != nil {
@@ -76,13 +93,24 @@ func ExtractArtifact(ctx context.Context, streams iostreams.IOStreams, tarPath,
 	if err != nil {
 		return nil, err
 	}
+	if len(packages) != 0 {
+		selected := make(map[string]ocispec.Descriptor, len(packages))
+		for _, name := range packages {
+			if desc, ok := packageManifests[name]; ok {
+				selected[name] = desc
+			}
+		}
+		packageManifests = selected
+	}
 	store, err := oci.OpenStore(ociDir)
 	if err != nil {
 		return nil, err
 	}
-	streams.Info("verifying bundle artifact")
-	if err := store.VerifyGraph(ctx, idx.Manifests); err != nil {
-		return nil, fmt.Errorf("%w for %q: %w", ErrVerifyingArtifactDigest, tarPath, err)
+	if verify {
+		streams.Info("verifying bundle artifact")
+		if err := store.VerifyGraph(ctx, idx.Manifests); err != nil {
+			return nil, fmt.Errorf("%w for %q: %w", ErrVerifyingArtifactDigest, source, err)
+		}
 	}
 
 	defBytes, err := oci.FetchBytes(ctx, store, defEntry)
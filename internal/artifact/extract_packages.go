@@ -0,0 +1,238 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/errdef"
+)
+
+const (
+	archiveOCILayoutEntry = "oci/" + ocispec.ImageLayoutFile
+	archiveIndexEntry     = "oci/" + ocispec.ImageIndexFile
+	archiveBlobsPrefix    = "oci/" + ocispec.ImageBlobsDir + "/"
+)
+
+// ExtractArtifactPackages extracts a .tar.zst bundle artifact like
+// ExtractArtifact, but writes only the blobs reachable from the bundle
+// definition and the packages named in packages. An empty packages extracts
+// the whole artifact with ExtractArtifact.
+//
+// The archive is read once. WriteTarZst puts the OCI index and every blob
+// small enough to be a manifest before the larger blobs, so the reachable
+// blob set is known by the time the layers stream past: reachable layers are
+// extracted and verified, and every skipped layer is digest-checked, so a
+// corrupted unselected package fails extraction exactly as it would for a
+// full extract. Blobs read before the set is known, which for archives
+// written in another order may be all of them, are extracted and removed
+// afterwards if unreachable.
+//
+// dstDir must already exist; the caller owns its lifecycle.
+func ExtractArtifactPackages(ctx context.Context, streams iostreams.IOStreams, tarPath, dstDir string, packages []string) (*ExtractedBundle, error) {
+	if len(packages) == 0 {
+		return ExtractArtifact(ctx, streams, tarPath, dstDir)
+	}
+	streams.Info("extracting selected bundle packages", "packages", strings.Join(packages, ","))
+	streams.Debug("extracting bundle artifact", "source", tarPath, "output", dstDir)
+
+	root, err := os.OpenRoot(dstDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q to %q: %w", ErrExtractingBundleArtifact, tarPath, dstDir, err)
+	}
+	defer func() { _ = root.Close() }()
+
+	if err := extractReachableEntries(ctx, tarPath, root, packages); err != nil {
+		return nil, fmt.Errorf("%w %q to %q: %w", ErrExtractingBundleArtifact, tarPath, dstDir, err)
+	}
+	return openBundleLayout(ctx, streams, tarPath, dstDir, packages, false)
+}
+
+// extractReachableEntries streams the archive once, writing every non-blob
+// entry and every blob reachable from the bundle definition and packages, and
+// digest-checking every blob it skips.
+func extractReachableEntries(ctx context.Context, tarPath string, root *os.Root, packages []string) error {
+	var (
+		indexed, unordered bool
+		// reachable is nil until the entries extracted so far include the
+		// index and every manifest.
+		reachable map[godigest.Digest]int64
+		extracted = map[godigest.Digest]int64{}
+	)
+	err := walkTarZst(ctx, tarPath, func(hdr *tar.Header, r io.Reader) error {
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag == tar.TypeDir {
+			return root.MkdirAll(name, filesystem.PrivateDirectoryMode)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			return UnsupportedFileTypeError{Path: hdr.Name}
+		}
+		if !strings.HasPrefix(name, archiveBlobsPrefix) {
+			indexed = indexed || name == archiveIndexEntry
+			return writeArchiveEntry(root, name, r, nil)
+		}
+
+		digest, err := archiveBlobDigest(name)
+		if err != nil {
+			return err
+		}
+		if reachable == nil && indexed && !unordered && hdr.Size > oci.MaxFetchBytesSize {
+			// No manifest follows the first blob too large to be one, unless
+			// the archive was written in another order; a manifest then goes
+			// missing and the set is computed once the archive is read.
+			reachable, err = archiveReachableBlobs(ctx, tarPath, root, packages)
+			if errors.Is(err, errdef.ErrNotFound) || errors.Is(err, fs.ErrNotExist) {
+				unordered = true
+			} else if err != nil {
+				return err
+			}
+		}
+		verifier := digest.Verifier()
+		if _, ok := reachable[digest]; reachable != nil && !ok {
+			if _, err := io.Copy(verifier, r); err != nil {
+				return fmt.Errorf("reading blob %s: %w", digest, err)
+			}
+			if !verifier.Verified() {
+				return fmt.Errorf("%w: blob %s: %w", ErrVerifyingArtifactDigest, digest, ErrBlobDigestMismatch)
+			}
+			return nil
+		}
+		if err := writeArchiveEntry(root, name, r, verifier); err != nil {
+			return err
+		}
+		if !verifier.Verified() {
+			return fmt.Errorf("%w: blob %s: %w", ErrVerifyingArtifactDigest, digest, ErrBlobDigestMismatch)
+		}
+		extracted[digest] = hdr.Size
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if reachable == nil {
+		if reachable, err = archiveReachableBlobs(ctx, tarPath, root, packages); err != nil {
+			return err
+		}
+	}
+
+	for digest := range extracted {
+		if _, ok := reachable[digest]; ok {
+			continue
+		}
+		name := path.Join(archiveBlobsPrefix, digest.Algorithm().String(), digest.Encoded())
+		if err := root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing unselected blob %s: %w", digest, err)
+		}
+	}
+	for digest, size := range reachable {
+		actual, ok := extracted[digest]
+		if !ok {
+			return fmt.Errorf("%w: blob %s: %w", ErrVerifyingArtifactDigest, digest, ErrBlobMissing)
+		}
+		if actual != size {
+			return fmt.Errorf("%w: %w", ErrVerifyingArtifactDigest, oci.ConflictingDescriptorSizeError{Digest: digest, RecordedSize: size, ActualSize: actual})
+		}
+	}
+	return nil
+}
+
+// archiveReachableBlobs returns the size of every blob reachable from the
+// bundle definition and the selected packages, read from the index and
+// manifests already extracted beneath root.
+func archiveReachableBlobs(ctx context.Context, tarPath string, root *os.Root, packages []string) (map[godigest.Digest]int64, error) {
+	idxBytes, err := root.ReadFile(archiveIndexEntry)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReadingBundleIndex, err)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(idxBytes, &idx); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParsingBundleIndex, err)
+	}
+	if !oci.IsBundleIndex(idx) {
+		return nil, InvalidBundleIndexError{Source: tarPath, ArtifactType: oci.MediaTypeBundle}
+	}
+	defEntry, defIdx, err := oci.FindBundleDefinition(idx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLocatingBundleDefinition, err)
+	}
+	packageManifests, err := buildPackageManifests(idx, defIdx)
+	if err != nil {
+		return nil, err
+	}
+	roots := []ocispec.Descriptor{defEntry}
+	var missing []string
+	for _, name := range packages {
+		desc, ok := packageManifests[name]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		roots = append(roots, desc)
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: %s", oci.ErrBundlePackageNotFound, strings.Join(missing, ", "))
+	}
+
+	fetcher, err := oci.OpenReadOnlyStore(filepath.Join(root.Name(), "oci"))
+	if err != nil {
+		return nil, err
+	}
+	return oci.ReachableBlobs(ctx, fetcher, roots)
+}
+
+// writeArchiveEntry writes an archive entry beneath root, also copying its
+// bytes to tee when set.
+func writeArchiveEntry(root *os.Root, name string, r io.Reader, tee io.Writer) (retErr error) {
+	if err := root.MkdirAll(path.Dir(name), filesystem.PrivateDirectoryMode); err != nil {
+		return fmt.Errorf("creating directory for %s: %w", name, err)
+	}
+	f, err := root.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, filesystem.PrivateFileMode)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", name, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = fmt.Errorf("closing %s: %w", name, err)
+		}
+	}()
+	var w io.Writer = f
+	if tee != nil {
+		w = io.MultiWriter(f, tee)
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+// archiveBlobDigest returns the digest named by a blob entry path of the form
+// oci/blobs/<algorithm>/<encoded>.
+func archiveBlobDigest(name string) (godigest.Digest, error) {
+	algorithm, encoded, ok := strings.Cut(strings.TrimPrefix(name, archiveBlobsPrefix), "/")
+	digest := godigest.NewDigestFromEncoded(godigest.Algorithm(algorithm), encoded)
+	if !ok {
+		return "", oci.InvalidDigestError{Digest: digest.String(), Err: godigest.ErrDigestInvalidFormat}
+	}
+	if err := digest.Validate(); err != nil {
+		return "", oci.InvalidDigestError{Digest: digest.String(), Err: err}
+	}
+	return digest, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package artifact
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const selectiveBundleHCL = `uds { bundle_api_version = "uds.dev/v1alpha1" }
+metadata { name = "selective" version = "0.1.0" }
+package "small" { source = "oci://example.com/small:v1" }
+package "large" { source = "oci://example.com/large:v1" }
+`
+
+var selectivePackages = []spec.Package{
+	{Name: "small", Source: "oci://example.com/small:v1"},
+	{Name: "large", Source: "oci://example.com/large:v1"},
+}
+
+func TestExtractArtifactPackages_ExtractsOnlySelectedPackages(t *testing.T) {
+	tarPath := buildBundleArtifact(t, selectiveBundleHCL, map[string][]string{"small": {"key: value"}}, selectivePackages)
+	dstDir := t.TempDir()
+
+	extracted, err := ExtractArtifactPackages(t.Context(), iostreams.IOStreams{}, tarPath, dstDir, []string{"small"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small"}, mapKeys(extracted.PackageManifests))
+	assert.FileExists(t, extracted.BundleDefPath)
+	assert.FileExists(t, filepath.Join(dstDir, "values", "small", "0.yaml"))
+	assert.FileExists(t, filepath.Join(extracted.OCIDir, "index.json"))
+
+	blobDir := filepath.Join(extracted.OCIDir, "blobs", "sha256")
+	assert.FileExists(t, filepath.Join(blobDir, extracted.PackageManifests["small"].Digest.Hex()))
+	assert.FileExists(t, filepath.Join(blobDir, godigest.FromString("fake package: oci://example.com/small:v1").Hex()))
+	assert.NoFileExists(t, filepath.Join(blobDir, godigest.FromString("fake package: oci://example.com/large:v1").Hex()))
+
+	full, err := ExtractArtifact(t.Context(), iostreams.IOStreams{}, tarPath, t.TempDir())
+	require.NoError(t, err)
+	assert.NoFileExists(t, filepath.Join(blobDir, full.PackageManifests["large"].Digest.Hex()))
+}
+
+func TestExtractArtifactPackages_ExtractsArchivesSortedByPath(t *testing.T) {
+	tarPath := buildBundleArtifact(t, selectiveBundleHCL, nil, selectivePackages)
+	unpackDir := t.TempDir()
+	require.NoError(t, ExtractTarZst(t.Context(), iostreams.IOStreams{}, tarPath, unpackDir))
+	entries, err := archiveEntries(unpackDir)
+	require.NoError(t, err)
+	// Archives written before layers were ordered last have the index after
+	// every blob.
+	slices.Sort(entries)
+	sortedPath := filepath.Join(t.TempDir(), "sorted.tar.zst")
+	f, err := os.Create(sortedPath)
+	require.NoError(t, err)
+	require.NoError(t, writeTarZstEntries(t.Context(), f, unpackDir, entries, time.Unix(0, 0)))
+	require.NoError(t, f.Close())
+
+	extracted, err := ExtractArtifactPackages(t.Context(), iostreams.IOStreams{}, sortedPath, t.TempDir(), []string{"small"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"small"}, mapKeys(extracted.PackageManifests))
+	blobDir := filepath.Join(extracted.OCIDir, "blobs", "sha256")
+	assert.FileExists(t, filepath.Join(blobDir, godigest.FromString("fake package: oci://example.com/small:v1").Hex()))
+	assert.NoFileExists(t, filepath.Join(blobDir, godigest.FromString("fake package: oci://example.com/large:v1").Hex()))
+}
+
+func TestExtractArtifactPackages_VerifiesSkippedBlobs(t *testing.T) {
+	tarPath := buildBundleArtifact(t, selectiveBundleHCL, nil, selectivePackages)
+	unpackDir := t.TempDir()
+	require.NoError(t, ExtractTarZst(t.Context(), iostreams.IOStreams{}, tarPath, unpackDir))
+	target := filepath.Join(unpackDir, "oci", "blobs", "sha256", godigest.FromString("fake package: oci://example.com/large:v1").Hex())
+	require.NoError(t, os.WriteFile(target, []byte("tampered package: oci://example.com/large:v1"), filesystem.PrivateFileMode))
+	corruptPath := filepath.Join(t.TempDir(), "corrupt.tar.zst")
+	require.NoError(t, WriteTarZst(t.Context(), iostreams.IOStreams{}, corruptPath, unpackDir))
+
+	_, err := ExtractArtifactPackages(t.Context(), iostreams.IOStreams{}, corruptPath, t.TempDir(), []string{"small"})
+	require.ErrorIs(t, err, ErrVerifyingArtifactDigest)
+	require.ErrorIs(t, err, ErrBlobDigestMismatch)
+}
+
+func TestExtractArtifactPackages_RejectsUnknownPackages(t *testing.T) {
+	tarPath := buildBundleArtifact(t, selectiveBundleHCL, nil, selectivePackages)
+
+	_, err := ExtractArtifactPackages(t.Context(), iostreams.IOStreams{}, tarPath, t.TempDir(), []string{"small", "missing"})
+	require.ErrorIs(t, err, oci.ErrBundlePackageNotFound)
+	require.ErrorContains(t, err, "missing")
+}
+
+func mapKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
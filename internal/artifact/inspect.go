@@ -6,6 +6,7 @@ package artifact
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -42,6 +43,8 @@ type InspectResult struct {
 	ArtifactDigest    string
 	ReconfiguredFrom  string
 	PackageSignatures map[string]PackageSignatureSummary
+	Attestations      []AttestationSummary
+	Signatures        [][]byte
 }
 
 // PackageSignatureSummary contains package signing and verification metadata.
@@ -71,20 +74,59 @@ const (
 // Inspect reads a built local or OCI bundle.
 // It reads metadata only and does not verify package content or signatures.
 func Inspect(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
+	source, err := openInspectSource(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer source.cleanup()
+	result, err := inspectBundleIndex(ctx, opts.Streams, source.indexBytes, source.artifactDigest, source.fetch)
+	if err != nil {
+		return nil, err
+	}
+	for _, attestation := range source.attestations {
+		summary, err := SummarizeAttestation(attestation)
+		if err != nil {
+			return nil, err
+		}
+		result.Attestations = append(result.Attestations, summary)
+	}
+	result.Signatures = source.signatures
+	return result, nil
+}
+
+// inspectSource is a readable bundle index and the fetcher for its blobs.
+type inspectSource struct {
+	indexBytes     []byte
+	artifactDigest string
+	fetch          inspectBlobFetcher
+	attestations   []udsoci.BundleAttestation
+	signatures     [][]byte
+	cleanup        func()
+}
+
+// inspectedDefinition is the parsed bundle index and definition manifest.
+type inspectedDefinition struct {
+	index      ocispec.Index
+	definition ocispec.Manifest
+	bundle     *spec.UDSBundle
+}
+
+func openInspectSource(ctx context.Context, opts InspectOptions) (*inspectSource, error) {
 	if udsoci.IsOCIReference(opts.Source) {
-		return inspectOCIReference(ctx, opts)
+		return openOCIInspectSource(ctx, opts)
 	}
-	return inspectLocalArtifact(ctx, opts)
+	return openLocalInspectSource(ctx, opts)
 }
 
-func inspectLocalArtifact(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
+func openLocalInspectSource(ctx context.Context, opts InspectOptions) (*inspectSource, error) {
 	workspace, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-inspect-*")
 	if err != nil {
 		return nil, fmt.Errorf("%w under %q: %w", ErrCreatingInspectionWorkspace, opts.Config.Options.TmpDir, err)
 	}
-	defer func() { _ = os.RemoveAll(workspace) }()
+	cleanup := func() { _ = os.RemoveAll(workspace) }
 
 	if err := ExtractTarZst(ctx, opts.Streams, opts.Source, workspace); err != nil {
+		cleanup()
 		return nil, fmt.Errorf("%w %q to %q: %w", ErrExtractingBundleArtifact, opts.Source, workspace, err)
 	}
 
@@ -92,20 +134,39 @@ func inspectLocalArtifact(ctx context.Context, opts InspectOptions) (*InspectRes
 	indexPath := filepath.Join(ociDir, "index.json")
 	indexBytes, err := os.ReadFile(indexPath)
 	if err != nil {
+		cleanup()
 		return nil, fmt.Errorf("%w %q: %w", ErrReadingBundleIndex, indexPath, err)
 	}
 
 	store, err := udsoci.OpenReadOnlyStore(ociDir)
 	if err != nil {
+		cleanup()
 		return nil, err
 	}
+	attestations, err := udsoci.ReadBundleAttestations(workspace)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("%w from %q: %w", ErrReadingAttestations, opts.Source, err)
+	}
+	signatures, err := udsoci.ReadBundleSignatures(workspace)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("%w from %q: %w", ErrReadingSignatures, opts.Source, err)
+	}
 	fetch := func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error) {
 		return udsoci.FetchBytes(ctx, store, desc)
 	}
-	return inspectBundleIndex(ctx, opts.Streams, indexBytes, digest.FromBytes(indexBytes).String(), fetch)
+	return &inspectSource{
+		indexBytes:     indexBytes,
+		artifactDigest: digest.FromBytes(indexBytes).String(),
+		fetch:          fetch,
+		attestations:   attestations,
+		signatures:     signatures,
+		cleanup:        cleanup,
+	}, nil
 }
 
-func inspectOCIReference(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
+func openOCIInspectSource(ctx context.Context, opts InspectOptions) (*inspectSource, error) {
 	target, err := udsoci.NewRemoteRepository(ctx, udsoci.TrimScheme(opts.Source), *opts.Config.Options)
 	if err != nil {
 		return nil, ResolvingInspectSourceError{Source: opts.Source, Err: err}
@@ -119,13 +180,72 @@ func inspectOCIReference(ctx context.Context, opts InspectOptions) (*InspectResu
 		return nil, ResolvingBundleSourceError{Source: opts.Source, Err: err}
 	}
 
+	attestations, err := udsoci.FetchBundleAttestations(ctx, target, childDesc)
+	if err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrReadingAttestations, opts.Source, err)
+	}
+	signatures, err := udsoci.FetchBundleSignatures(ctx, target, childDesc)
+	if err != nil && !errors.Is(err, udsoci.ErrBundleSignatureNotFound) {
+		return nil, fmt.Errorf("%w from %q: %w", ErrReadingSignatures, opts.Source, err)
+	}
 	fetch := func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error) {
 		return udsoci.FetchBytes(ctx, target, desc)
 	}
-	return inspectBundleIndex(ctx, opts.Streams, indexBytes, childDesc.Digest.String(), fetch)
+	return &inspectSource{
+		indexBytes:     indexBytes,
+		artifactDigest: childDesc.Digest.String(),
+		fetch:          fetch,
+		attestations:   attestations,
+		signatures:     signatures,
+		cleanup:        func() {},
+	}, nil
 }
 
 func inspectBundleIndex(ctx context.Context, streams iostreams.IOStreams, indexBytes []byte, artifactDigest string, fetch inspectBlobFetcher) (*InspectResult, error) {
+	def, err := readBundleDefinition(ctx, streams, indexBytes, artifactDigest, fetch)
+	if err != nil {
+		return nil, err
+	}
+	b := def.bundle
+	dag, err := bundleinternal.BuildDependencyGraph(ctx, streams, b)
+	if err != nil {
+		return nil, fmt.Errorf("building package dependency graph: %w", err)
+	}
+	packages, err := dag.TopologicalSort()
+	if err != nil {
+		return nil, fmt.Errorf("ordering packages: %w", err)
+	}
+	result := &InspectResult{
+		Bundle:            b,
+		Packages:          packages,
+		ArtifactDigest:    artifactDigest,
+		ReconfiguredFrom:  def.definition.Annotations[udsoci.AnnotationReconfiguredFrom],
+		PackageSignatures: make(map[string]PackageSignatureSummary, len(b.Packages)),
+	}
+	for _, pkg := range b.Packages {
+		summary, err := inspectPackageSignature(ctx, def.index, pkg, fetch)
+		if err != nil {
+			return nil, InspectingPackageSignatureError{Package: pkg.Name, Err: err}
+		}
+		result.PackageSignatures[pkg.Name] = *summary
+	}
+
+	return result, nil
+}
+
+// ReadBundleName returns the metadata name of the bundle definition
+// referenced by indexBytes, fetching only the definition manifest and HCL.
+func ReadBundleName(ctx context.Context, streams iostreams.IOStreams, indexBytes []byte, fetch func(context.Context, ocispec.Descriptor) ([]byte, error)) (string, error) {
+	def, err := readBundleDefinition(ctx, streams, indexBytes, digest.FromBytes(indexBytes).String(), fetch)
+	if err != nil {
+		return "", err
+	}
+	return def.bundle.Metadata.Name, nil
+}
+
+// readBundleDefinition validates a bundle index and parses the bundle
+// definition it references. Package layers are never fetched.
+func readBundleDefinition(ctx context.Context, streams iostreams.IOStreams, indexBytes []byte, artifactDigest string, fetch inspectBlobFetcher) (*inspectedDefinition, error) {
 	var idx ocispec.Index
 	if err := json.Unmarshal(indexBytes, &idx); err != nil {
 		return nil, fmt.Errorf("%w %s: %w", ErrParsingBundleIndex, artifactDigest, err)
@@ -190,30 +310,7 @@ func inspectBundleIndex(ctx context.Context, streams iostreams.IOStreams, indexB
 	if err := b.Validate(); err != nil {
 		return nil, fmt.Errorf("%w %s: %w", ErrInvalidBundle, artifactDigest, err)
 	}
-	dag, err := bundleinternal.BuildDependencyGraph(ctx, streams, b)
-	if err != nil {
-		return nil, fmt.Errorf("building package dependency graph: %w", err)
-	}
-	packages, err := dag.TopologicalSort()
-	if err != nil {
-		return nil, fmt.Errorf("ordering packages: %w", err)
-	}
-	result := &InspectResult{
-		Bundle:            b,
-		Packages:          packages,
-		ArtifactDigest:    artifactDigest,
-		ReconfiguredFrom:  definition.Annotations[udsoci.AnnotationReconfiguredFrom],
-		PackageSignatures: make(map[string]PackageSignatureSummary, len(b.Packages)),
-	}
-	for _, pkg := range b.Packages {
-		summary, err := inspectPackageSignature(ctx, idx, pkg, fetch)
-		if err != nil {
-			return nil, InspectingPackageSignatureError{Package: pkg.Name, Err: err}
-		}
-		result.PackageSignatures[pkg.Name] = *summary
-	}
-
-	return result, nil
+	return &inspectedDefinition{index: idx, definition: definition, bundle: b}, nil
 }
 
 func inspectPackageSignature(ctx context.Context, idx ocispec.Index, pkg spec.Package, fetch inspectBlobFetcher) (*PackageSignatureSummary, error) {
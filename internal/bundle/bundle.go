@@ -8,24 +8,34 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 
 	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/function"
 )
 
-// BundleFileName is the name of the bundle definition file.
-const BundleFileName = "bundle.uds.hcl"
+const (
+	// BundleFileName is the name of the bundle definition file.
+	BundleFileName = "bundle.uds.hcl"
+	// BundleJSONFileName is the bundle definition file in HCL's JSON syntax.
+	BundleJSONFileName = "bundle.uds.json"
+)
 
 type decodedBundle struct {
-	UDS      decodedUDSBlock  `hcl:"uds,block"`
-	Metadata decodedMetadata  `hcl:"metadata,block"`
-	Packages []decodedPackage `hcl:"package,block"`
-	Remain   hcl.Body         `hcl:",remain"`
+	UDS      decodedUDSBlock         `hcl:"uds,block"`
+	Metadata decodedMetadata         `hcl:"metadata,block"`
+	Bundles  []decodedIncludedBundle `hcl:"bundle,block"`
+	Blocks   []decodedPackageBlock   `hcl:"package,block"`
+	Remain   hcl.Body                `hcl:",remain"`
+	// Packages are the packages the package blocks declare.
+	Packages []decodedPackage
+	// Instances holds the package names of blocks expanded by for_each or
+	// count, by block label.
+	Instances map[string][]string
 }
 type decodedUDSBlock struct {
 	BundleAPIVersion string `hcl:"bundle_api_version"`
@@ -61,7 +71,13 @@ type decodedKeylessSignatureVerification struct {
 	UseSignedTimestamps         bool   `hcl:"use_signed_timestamps,optional"`
 }
 type decodedPackageRef struct {
-	Name      string
+	Name string
+	// Bundle is set for bundle.<name> references, which depend on every
+	// package the named bundle block includes.
+	Bundle bool
+	// Instance is set for package.<name>[key] references, naming the single
+	// package an expanded block declares for key.
+	Instance  string
 	Traversal hcl.Traversal
 }
 
@@ -69,8 +85,9 @@ type decodedPackageRef struct {
 // exposed to bundle expressions as ${sys.arch}; an empty architecture uses the
 // runtime default. Diagnostics are written to streams.
 type HCLParser struct {
-	arch    string
-	streams iostreams.IOStreams
+	arch     string
+	streams  iostreams.IOStreams
+	resolver BundleResolver
 }
 
 // Parser defines the interface for parsing bundle definitions and
@@ -84,12 +101,26 @@ type Parser interface {
 	ParseBundleConfig(ctx context.Context, filePath string) (*UDSBundleConfig, error)
 }
 
-func (b *decodedBundle) toSpec() *spec.UDSBundle {
+// toSpec converts the decoded bundle, replacing each bundle.<name> dependency
+// with the package references in included[name] and each package.<name>
+// dependency on a block expanded by for_each or count with its instances.
+func (b *decodedBundle) toSpec(included map[string][]spec.PackageRef) *spec.UDSBundle {
 	packages := make([]spec.Package, len(b.Packages))
 	for i, pkg := range b.Packages {
-		dependsOn := make([]spec.PackageRef, len(pkg.DependsOn))
-		for j, ref := range pkg.DependsOn {
-			dependsOn[j] = spec.PackageRef{Name: ref.Name}
+		dependsOn := make([]spec.PackageRef, 0, len(pkg.DependsOn))
+		for _, ref := range pkg.DependsOn {
+			switch instances, expanded := b.Instances[ref.Name]; {
+			case ref.Bundle:
+				dependsOn = append(dependsOn, included[ref.Name]...)
+			case ref.Instance != "":
+				dependsOn = append(dependsOn, spec.PackageRef{Name: ref.Instance})
+			case expanded:
+				for _, name := range instances {
+					dependsOn = append(dependsOn, spec.PackageRef{Name: name})
+				}
+			default:
+				dependsOn = append(dependsOn, spec.PackageRef{Name: ref.Name})
+			}
 		}
 		packages[i] = spec.Package{Name: pkg.Name, Source: pkg.Source, Namespace: pkg.Namespace, DependsOn: dependsOn, ValuesFiles: append([]string(nil), pkg.ValuesFiles...), OptionalComponents: append([]string(nil), pkg.OptionalComponents...), SignatureVerification: toSpecSignatureVerification(pkg.SignatureVerification)}
 	}
@@ -118,7 +149,8 @@ func NewHCLParser(arch string, streams iostreams.IOStreams) *HCLParser {
 // Compile-time check to ensure HCLParser implements Parser.
 var _ Parser = &HCLParser{}
 
-// ParseBundleFile reads and parses an HCL bundle file with locals support.
+// ParseBundleFile reads and parses an HCL bundle file with locals support. A
+// path ending in .json is parsed in HCL's JSON syntax.
 // ctx is accepted for cancellation/propagation; HCL parsing does not use it, and
 // diagnostics are written via p.streams.
 func (p *HCLParser) ParseBundleFile(ctx context.Context, filePath string) (*spec.UDSBundle, error) {
@@ -143,8 +175,21 @@ func (p *HCLParser) ParseBundleBytes(ctx context.Context, src []byte) (*spec.UDS
 	return p.parseBundleContent(ctx, src, "bundle.uds.hcl", "", false)
 }
 
+// ParseBundleSource parses bundle content as if it were read from filePath, so
+// file() reads relative to its directory. Editors use it to check content that
+// has not been saved yet.
+func (p *HCLParser) ParseBundleSource(ctx context.Context, src []byte, filePath string) (*spec.UDSBundle, error) {
+	if filePath == "" {
+		return nil, EmptyParameterError{Name: "filePath"}
+	}
+	return p.parseBundleContent(ctx, src, filePath, filepath.Dir(filePath), true)
+}
+
 // ParseAndMaterializeBundleFile reads a source bundle once, using those bytes
 // both for runtime evaluation and the self-contained artifact representation.
+// Bundle blocks are replaced in the materialized source by the package blocks
+// they expand to, so the artifact can be parsed without fetching them again.
+// A bundle.uds.json is materialized in the native syntax.
 func (p *HCLParser) ParseAndMaterializeBundleFile(ctx context.Context, path string) (*spec.UDSBundle, []byte, error) {
 	src, err := os.ReadFile(path)
 	if err != nil {
@@ -154,10 +199,20 @@ func (p *HCLParser) ParseAndMaterializeBundleFile(ctx context.Context, path stri
 	if err != nil {
 		return nil, nil, err
 	}
+	if isJSONSyntax(path) {
+		if src, err = nativeFromJSON(src, path, bundleJSONSchema); err != nil {
+			return nil, nil, err
+		}
+	}
 	materialized, err := p.materializeBundleFileCalls(src, path, filepath.Dir(path))
 	if err != nil {
 		return nil, nil, err
 	}
+	if slices.ContainsFunc(bundle.Packages, func(pkg spec.Package) bool { return pkg.Bundle != "" }) {
+		if materialized, err = materializeIncludedBundles(materialized, path, bundle); err != nil {
+			return nil, nil, err
+		}
+	}
 	return bundle, materialized, nil
 }
 
@@ -165,7 +220,7 @@ func (p *HCLParser) ParseAndMaterializeBundleFile(ctx context.Context, path stri
 // and evaluating locals, then decoding the full bundle with an EvalContext containing
 // those locals. filename is used only for error message attribution.
 func (p *HCLParser) parseBundleContent(ctx context.Context, src []byte, filename, baseDir string, allowFile bool) (*spec.UDSBundle, error) {
-	hclFile, hclDiagnostics := hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	hclFile, hclDiagnostics := ParseHCL(src, filename)
 	if hclDiagnostics.HasErrors() {
 		return nil, fmt.Errorf("%w %q: %w", ErrParseHCL, filename, hclDiagnostics)
 	}
@@ -186,20 +241,22 @@ func (p *HCLParser) parseBundleContent(ctx context.Context, src []byte, filename
 	}
 	p.streams.Debug("locals extracted", "count", len(locals))
 
-	return p.decodeBundleWithLocals(hclFile, locals, funcs, filename)
+	return p.decodeBundleWithLocals(ctx, hclFile, locals, funcs, filename)
 }
 
 // decodeBundleWithLocals decodes the given HCL file into a UDSBundle struct
 // using an EvalContext populated with the extracted locals.
-// It uses gohcl for standard fields and post-processes the Package.Remain
-// field to extract depends_on expressions into []PackageRef.
-func (p *HCLParser) decodeBundleWithLocals(hclFile *hcl.File, locals map[string]cty.Value, funcs map[string]function.Function, filename string) (*spec.UDSBundle, error) {
+// It uses gohcl for standard fields and expands package blocks with for_each
+// or count, extracting the depends_on expressions of each package from its
+// Remain field into []PackageRef. Bundle blocks are then expanded into the
+// packages they include.
+func (p *HCLParser) decodeBundleWithLocals(ctx context.Context, hclFile *hcl.File, locals map[string]cty.Value, funcs map[string]function.Function, filename string) (*spec.UDSBundle, error) {
 	localVal := cty.EmptyObjectVal
 	if len(locals) > 0 {
 		localVal = cty.ObjectVal(locals)
 	}
 
-	ctx := &hcl.EvalContext{
+	evalCtx := &hcl.EvalContext{
 		Variables: map[string]cty.Value{
 			"local": localVal,
 			"sys":   sysVars(p.arch),
@@ -209,24 +266,19 @@ func (p *HCLParser) decodeBundleWithLocals(hclFile *hcl.File, locals map[string]
 
 	// Decode the entire bundle using gohcl - depends_on is captured in Package.Remain
 	var decoded decodedBundle
-	diags := gohcl.DecodeBody(hclFile.Body, ctx, &decoded)
+	diags := gohcl.DecodeBody(hclFile.Body, evalCtx, &decoded)
 	if diags.HasErrors() {
 		return nil, fmt.Errorf("%w from %q: %w", ErrDecodeBundle, filename, diags)
 	}
-
-	// Post-process each package to extract depends_on from Remain
-	for i := range decoded.Packages {
-		pkg := &decoded.Packages[i]
-		if pkg.Remain == nil {
-			continue
-		}
-
-		refs, err := decodePackageDependsOn(pkg.Remain)
-		if err != nil {
-			return nil, fmt.Errorf("package %q: %w: %w", pkg.Name, ErrDecodePackageDependencies, err)
-		}
-		pkg.DependsOn = refs
+	if err := expandPackageBlocks(&decoded, evalCtx); err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrDecodeBundle, filename, err)
 	}
 
-	return decoded.toSpec(), nil
+	included, packages, err := p.resolveIncludedBundles(ctx, &decoded)
+	if err != nil {
+		return nil, err
+	}
+	b := decoded.toSpec(included)
+	b.Packages = append(b.Packages, packages...)
+	return b, nil
 }
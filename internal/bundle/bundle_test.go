@@ -1145,6 +1145,39 @@ variables = {
 			},
 		},
 
+		// ---- multi-signer verification policy ----
+		{
+			name:   "signature verification with signers and scopes",
+			wantOK: true,
+			hcl: `
+signature_verification {
+  threshold = 2
+  signer "release-a" {
+    public_key = "key-a"
+  }
+  signer "ci" {
+    keyless {
+      certificate_identity    = "https://github.com/org/repo/.github/workflows/release.yaml@refs/heads/main"
+      certificate_oidc_issuer = "https://token.actions.githubusercontent.com"
+    }
+  }
+  scope "core-*" {
+    signers = ["ci"]
+  }
+}`,
+			check: func(t *testing.T, cfg *UDSBundleConfig) {
+				policy := cfg.SignatureVerification
+				require.NotNil(t, policy)
+				assert.Equal(t, 2, policy.Threshold)
+				require.Len(t, policy.Signers, 2)
+				assert.Equal(t, TrustedSigner{Name: "release-a", PublicKey: "key-a"}, policy.Signers[0])
+				assert.Equal(t, "ci", policy.Signers[1].Name)
+				require.NotNil(t, policy.Signers[1].Keyless)
+				assert.Equal(t, "https://token.actions.githubusercontent.com", policy.Signers[1].Keyless.CertificateOIDCIssuer)
+				assert.Equal(t, []VerificationScope{{BundleName: "core-*", Signers: []string{"ci"}}}, policy.Scopes)
+			},
+		},
+
 		// ---- null in collection produces path-aware error ----
 		{
 			name:    "null in list rejected with index",
@@ -1426,3 +1459,33 @@ variables = { value = file("/etc/hostname") }
 `))
 	require.ErrorContains(t, err, "requires a file-backed bundle source")
 }
+
+func TestParseSourceSupportsFileFunction(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "name.txt"), []byte("example"), filesystem.PrivateFileMode))
+	p := NewHCLParser("", iostreams.IOStreams{})
+
+	// The files are never written; only their directory is used for file().
+	b, err := p.ParseBundleSource(t.Context(), []byte(`
+uds { bundle_api_version = "uds.dev/v1alpha1" }
+metadata { name = file("name.txt") }
+package "example" { source = "oci://example.com/package:v1" }
+`), filepath.Join(dir, BundleFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "example", b.Metadata.Name)
+
+	vars, declarations, err := ParseDefaultsSource(t.Context(), []byte(`
+variables = { name = file("name.txt") }
+variable "token" { sensitive = true }
+`), filepath.Join(dir, BundleDefaultsFileName))
+	require.NoError(t, err)
+	assert.Equal(t, Variables{"name": "example"}, vars)
+	assert.Equal(t, []VariableDeclaration{{Name: "token", Type: cty.String, Sensitive: true}}, declarations)
+
+	cfg, err := p.ParseBundleConfigSource(t.Context(), []byte(`variables = { name = file("name.txt") }`), filepath.Join(dir, ConfigFileName))
+	require.NoError(t, err)
+	assert.Equal(t, Variables{"name": "example"}, cfg.Variables)
+
+	_, err = p.ParseBundleSource(t.Context(), nil, "")
+	require.ErrorAs(t, err, &EmptyParameterError{})
+}
@@ -14,13 +14,18 @@ import (
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
 )
 
 const (
 	// BundleDefaultsFileName is the name of the optional bundle-level defaults file.
 	BundleDefaultsFileName = "defaults.uds.hcl"
+	// BundleDefaultsJSONFileName is the defaults file in HCL's JSON syntax.
+	BundleDefaultsJSONFileName = "defaults.uds.json"
+	// ConfigFileName is the conventional name of the deployer's config file.
+	ConfigFileName = "config.uds.hcl"
+	// ConfigJSONFileName is the config file in HCL's JSON syntax.
+	ConfigJSONFileName = "config.uds.json"
 	// MaxConcurrency is the upper bound for parallel package deploys within a level.
 	MaxConcurrency = 25
 )
@@ -39,9 +44,31 @@ type UDSBundleConfig struct {
 }
 
 // SignatureVerification holds consumer-owned bundle signature trust material.
+// public_key or keyless trusts a single signer; signer blocks trust several,
+// combined by rule and threshold and narrowed per bundle name by scope blocks.
 type SignatureVerification struct {
 	PublicKey string               `hcl:"public_key,optional"`
 	Keyless   *KeylessVerification `hcl:"keyless,block"`
+	Rule      string               `hcl:"rule,optional"`
+	Threshold int                  `hcl:"threshold,optional"`
+	Signers   []TrustedSigner      `hcl:"signer,block"`
+	Scopes    []VerificationScope  `hcl:"scope,block"`
+}
+
+// TrustedSigner is a named signer block inside signature_verification.
+type TrustedSigner struct {
+	Name      string               `hcl:"name,label"`
+	PublicKey string               `hcl:"public_key,optional"`
+	Keyless   *KeylessVerification `hcl:"keyless,block"`
+}
+
+// VerificationScope is a scope block that adds a signer requirement for
+// bundles whose name matches its label, for example scope "core-*".
+type VerificationScope struct {
+	BundleName string   `hcl:"bundle_name,label"`
+	Signers    []string `hcl:"signers,optional"`
+	Rule       string   `hcl:"rule,optional"`
+	Threshold  int      `hcl:"threshold,optional"`
 }
 
 // KeylessVerification holds keyless trust constraints from config.uds.hcl.
@@ -62,6 +89,9 @@ type ConfigOptions struct {
 	SkipTLSVerify bool   `hcl:"skip_tls_verify,optional"`
 	TmpDir        string `hcl:"tmp_dir,optional"`
 	Concurrency   int    `hcl:"concurrency,optional"`
+	// ChunkConcurrency is the number of ranged requests used to download a
+	// single large blob in parallel. Zero or one downloads each blob in one stream.
+	ChunkConcurrency int `hcl:"chunk_concurrency,optional"`
 }
 
 // ParseBundleConfig reads and parses a config.uds.hcl file.
@@ -69,7 +99,7 @@ type ConfigOptions struct {
 // UDSBundleConfig, and hcl:",remain" to capture the free-form variables attribute
 // which is then manually extracted and converted from cty.Value to Variables.
 // The context parameter is currently unused as none of the HCL parsing methods supports cancellation.
-func (p *HCLParser) ParseBundleConfig(_ context.Context, filePath string) (*UDSBundleConfig, error) {
+func (p *HCLParser) ParseBundleConfig(ctx context.Context, filePath string) (*UDSBundleConfig, error) {
 	if filePath == "" {
 		return nil, EmptyParameterError{Name: "filePath"}
 	}
@@ -77,8 +107,16 @@ func (p *HCLParser) ParseBundleConfig(_ context.Context, filePath string) (*UDSB
 	if err != nil {
 		return nil, fmt.Errorf("%w %q: %w", ErrReadConfigFile, filePath, err)
 	}
+	return p.ParseBundleConfigSource(ctx, src, filePath)
+}
 
-	hclFile, hclDiagnostics := hclsyntax.ParseConfig(src, filePath, hcl.Pos{Line: 1, Column: 1})
+// ParseBundleConfigSource parses config content as if it were read from
+// filePath, so file() reads relative to its directory.
+func (p *HCLParser) ParseBundleConfigSource(_ context.Context, src []byte, filePath string) (*UDSBundleConfig, error) {
+	if filePath == "" {
+		return nil, EmptyParameterError{Name: "filePath"}
+	}
+	hclFile, hclDiagnostics := ParseHCL(src, filePath)
 	if hclDiagnostics.HasErrors() {
 		return nil, fmt.Errorf("%w %q: %w", ErrParseConfig, filePath, hclDiagnostics)
 	}
@@ -205,7 +243,7 @@ func ctyValueToGo(val cty.Value) (any, error) {
 
 // ParseDefaults reads a defaults file from disk and validates it.
 // A valid defaults file contains at most one top-level attribute named "variables"
-// and no blocks. Returns the parsed Variables, or nil if the file has no variables.
+// and no blocks other than variable declarations. Returns the parsed Variables, or nil if the file has no variables.
 // The context parameter is currently unused as none of the HCL parsing methods supports cancellation.
 func ParseDefaults(_ context.Context, path string) (Variables, error) {
 	if path == "" {
@@ -226,6 +264,15 @@ func ParseDefaultsBytes(_ context.Context, src []byte) (Variables, error) {
 	return parseDefaultsContentWithoutFile(src, BundleDefaultsFileName)
 }
 
+// ParseDefaultsSource parses defaults content as if it were read from path,
+// returning its variables and variable declarations.
+func ParseDefaultsSource(_ context.Context, src []byte, path string) (Variables, []VariableDeclaration, error) {
+	if path == "" {
+		return nil, nil, EmptyParameterError{Name: "path"}
+	}
+	return parseDefaultsDocument(src, path, true)
+}
+
 // parseDefaultsContent decodes variables from defaults HCL content.
 func parseDefaultsContent(src []byte, path string) (Variables, error) {
 	return parseDefaultsContentWithFile(src, path, true)
@@ -236,32 +283,37 @@ func parseDefaultsContentWithoutFile(src []byte, path string) (Variables, error)
 }
 
 func parseDefaultsContentWithFile(src []byte, path string, allowFile bool) (Variables, error) {
-	hclFile, diags := hclsyntax.ParseConfig(src, path, hcl.Pos{Line: 1, Column: 1})
+	vars, _, err := parseDefaultsDocument(src, path, allowFile)
+	return vars, err
+}
+
+// parseDefaultsDocument decodes the variables attribute and variable blocks
+// of defaults HCL content.
+func parseDefaultsDocument(src []byte, path string, allowFile bool) (Variables, []VariableDeclaration, error) {
+	hclFile, diags := ParseHCL(src, path)
 	if diags.HasErrors() {
-		return nil, fmt.Errorf("%w %q: %w", ErrParseDefaults, path, diags)
+		return nil, nil, fmt.Errorf("%w %q: %w", ErrParseDefaults, path, diags)
 	}
 	if !allowFile {
 		if err := rejectFileFunctionWithoutSourcePath(hclFile, "defaults.uds.hcl"); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	// JustAttributes rejects any blocks (e.g. options {}).
-	attrs, diags := hclFile.Body.JustAttributes()
+	// Only the "variables" attribute and variable blocks are allowed at the
+	// top level; anything else (e.g. options {}) is rejected.
+	content, diags := hclFile.Body.Content(defaultsSchema)
 	if diags.HasErrors() {
-		return nil, fmt.Errorf("%w %q: %w", ErrInvalidDefaults, path, diags)
+		return nil, nil, fmt.Errorf("%w %q: %w", ErrInvalidDefaults, path, diags)
 	}
-
-	// Only "variables" is allowed at the top level.
-	for name := range attrs {
-		if name != "variables" {
-			return nil, fmt.Errorf("defaults file contains unexpected attribute %q; only \"variables\" is allowed: %w", name, ErrInvalidDefaults)
-		}
+	declarations, err := decodeVariableDeclarations(content.Blocks, path)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	attr, ok := attrs["variables"]
+	attr, ok := content.Attributes["variables"]
 	if !ok {
-		return nil, nil
+		return nil, declarations, nil
 	}
 
 	var evalContext *hcl.EvalContext
@@ -270,20 +322,20 @@ func parseDefaultsContentWithFile(src []byte, path string, allowFile bool) (Vari
 	}
 	val, diags := attr.Expr.Value(evalContext)
 	if diags.HasErrors() {
-		return nil, fmt.Errorf("%w in %q: %w", ErrEvaluateVariables, path, diags)
+		return nil, nil, fmt.Errorf("%w in %q: %w", ErrEvaluateVariables, path, diags)
 	}
 
 	goVal, err := ctyValueToGo(val)
 	if err != nil {
-		return nil, fmt.Errorf("%w from %q: %w", ErrConvertVariables, path, err)
+		return nil, nil, fmt.Errorf("%w from %q: %w", ErrConvertVariables, path, err)
 	}
 
 	m, ok := goVal.(Variables)
 	if !ok {
-		return nil, fmt.Errorf("variables must be an object, got %T: %w", goVal, ErrInvalidVariables)
+		return nil, nil, fmt.Errorf("variables must be an object, got %T: %w", goVal, ErrInvalidVariables)
 	}
 
-	return m, nil
+	return m, declarations, nil
 }
 
 // MergeVariables deep-merges variables from overrides into base, returning a new Variables map.
@@ -8,16 +8,31 @@ import (
 	"path/filepath"
 )
 
-// AdjacentDefaultsPath returns the optional defaults file next to bundleDir.
-// Missing files are represented by an empty path; other filesystem errors are
-// returned to the caller.
+// AdjacentDefaultsPath returns the optional defaults file next to bundleDir:
+// defaults.uds.hcl, or defaults.uds.json when only that exists. Missing files
+// are represented by an empty path; other filesystem errors are returned to
+// the caller.
 func AdjacentDefaultsPath(bundleDir string) (string, error) {
-	defaultsPath := filepath.Join(bundleDir, BundleDefaultsFileName)
-	if _, err := os.Stat(defaultsPath); err != nil {
-		if os.IsNotExist(err) {
-			return "", nil
+	return adjacentFile(bundleDir, BundleDefaultsFileName, BundleDefaultsJSONFileName)
+}
+
+// AdjacentConfigPath returns the config.uds.hcl or config.uds.json next to
+// bundleDir, like AdjacentDefaultsPath.
+func AdjacentConfigPath(bundleDir string) (string, error) {
+	return adjacentFile(bundleDir, ConfigFileName, ConfigJSONFileName)
+}
+
+// adjacentFile returns the first of names that exists in dir.
+func adjacentFile(dir string, names ...string) (string, error) {
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
 		}
-		return "", err
+		return path, nil
 	}
-	return defaultsPath, nil
+	return "", nil
 }
@@ -220,6 +220,109 @@ func (d *DAG) Level(name string) int {
 	return -1
 }
 
+// Dependencies returns the names of the packages name depends on directly,
+// sorted.
+func (d *DAG) Dependencies(name string) []string {
+	var deps []string
+	for _, trav := range d.edges[name] {
+		deps = append(deps, d.traversalToName(trav))
+	}
+	sort.Strings(deps)
+	return deps
+}
+
+// Dependents returns the names of the packages that depend on name directly,
+// sorted.
+func (d *DAG) Dependents(name string) []string {
+	var dependents []string
+	for pkgName, deps := range d.edges {
+		for _, trav := range deps {
+			if d.traversalToName(trav) == name {
+				dependents = append(dependents, pkgName)
+				break
+			}
+		}
+	}
+	sort.Strings(dependents)
+	return dependents
+}
+
+// TransitiveDependencies returns every package the named packages depend on,
+// directly or through other packages, excluding the named packages themselves.
+// These are the packages a deploy of the selection needs in place.
+func (d *DAG) TransitiveDependencies(names []string) []string {
+	return d.reachable(names, d.Dependencies)
+}
+
+// TransitiveDependents returns every package that depends on the named
+// packages, directly or through other packages, excluding the named packages
+// themselves. These are the packages a removal of the selection would break.
+func (d *DAG) TransitiveDependents(names []string) []string {
+	return d.reachable(names, d.Dependents)
+}
+
+// reachable walks next from each of names and returns the sorted packages
+// reached that are not in names.
+func (d *DAG) reachable(names []string, next func(string) []string) []string {
+	start := make(map[string]bool, len(names))
+	for _, name := range names {
+		start[name] = true
+	}
+	seen := make(map[string]bool)
+	queue := append([]string(nil), names...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, n := range next(name) {
+			if seen[n] || start[n] {
+				continue
+			}
+			seen[n] = true
+			queue = append(queue, n)
+		}
+	}
+	reached := make([]string, 0, len(seen))
+	for name := range seen {
+		reached = append(reached, name)
+	}
+	sort.Strings(reached)
+	return reached
+}
+
+// CriticalPath returns the longest dependency chain in deployment order. Its
+// length is the number of levels, the minimum number of sequential waves a
+// deploy takes. Ties are broken by package name so the result is stable.
+func (d *DAG) CriticalPath() ([]string, error) {
+	levels, err := d.TopologicalLevels()
+	if err != nil {
+		return nil, err
+	}
+	if len(levels) == 0 {
+		return nil, nil
+	}
+	level := make(map[string]int, len(d.packages))
+	for i, pkgs := range levels {
+		for _, pkg := range pkgs {
+			level[pkg.Name] = i
+		}
+	}
+
+	// A package at level i has a dependency at level i-1; follow those back
+	// from the last level to a package without dependencies.
+	path := make([]string, len(levels))
+	name := levels[len(levels)-1][0].Name
+	for i := len(levels) - 1; i >= 0; i-- {
+		path[i] = name
+		for _, dep := range d.Dependencies(name) {
+			if level[dep] == i-1 {
+				name = dep
+				break
+			}
+		}
+	}
+	return path, nil
+}
+
 // Traversal returns the hcl.Traversal for a package by name.
 // This can be used for enhanced error messages with HCL source locations.
 func (d *DAG) Traversal(name string) (hcl.Traversal, bool) {
@@ -532,3 +532,66 @@ func TestDeployOrder_DiamondPattern(t *testing.T) {
 	assert.Len(t, levels[2], 1)
 	assert.Equal(t, "top", levels[2][0].Name)
 }
+
+func TestDAG_DependenciesAndDependents(t *testing.T) {
+	dag, err := BuildDependencyGraph(t.Context(), iostreams.IOStreams{}, bundleWith(
+		pkg("a"),
+		pkg("b", "a"),
+		pkg("c", "a"),
+		pkg("d", "c", "b"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"b", "c"}, dag.Dependencies("d"))
+	assert.Empty(t, dag.Dependencies("a"))
+	assert.Equal(t, []string{"b", "c"}, dag.Dependents("a"))
+	assert.Empty(t, dag.Dependents("d"))
+}
+
+func TestDAG_Transitive(t *testing.T) {
+	// a <- b <- c <- d, and e depends on a alone
+	dag, err := BuildDependencyGraph(t.Context(), iostreams.IOStreams{}, bundleWith(
+		pkg("a"),
+		pkg("b", "a"),
+		pkg("c", "b"),
+		pkg("d", "c"),
+		pkg("e", "a"),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "b"}, dag.TransitiveDependencies([]string{"c"}))
+	assert.Equal(t, []string{"a"}, dag.TransitiveDependencies([]string{"b", "c"}))
+	assert.Equal(t, []string{"c", "d"}, dag.TransitiveDependents([]string{"b"}))
+	assert.Equal(t, []string{"b", "c", "d", "e"}, dag.TransitiveDependents([]string{"a"}))
+	assert.Empty(t, dag.TransitiveDependents([]string{"d", "e"}))
+}
+
+func TestDAG_CriticalPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		packages []bundle.Package
+		expected []string
+	}{
+		{name: "empty", expected: nil},
+		{name: "single", packages: []bundle.Package{pkg("a")}, expected: []string{"a"}},
+		{
+			name:     "longest chain wins",
+			packages: []bundle.Package{pkg("a"), pkg("b", "a"), pkg("c", "b"), pkg("x"), pkg("y", "x")},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "ties broken by name",
+			packages: []bundle.Package{pkg("a"), pkg("b", "a"), pkg("c", "a"), pkg("d", "c", "b"), pkg("e", "b")},
+			expected: []string{"a", "b", "d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dag, err := BuildDependencyGraph(t.Context(), iostreams.IOStreams{}, bundleWith(tt.packages...))
+			require.NoError(t, err)
+			path, err := dag.CriticalPath()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, path)
+		})
+	}
+}
@@ -47,6 +47,18 @@ var (
 	ErrPackageNotInBundle         = errors.New("package is not in the bundle")
 	ErrUnknownPackages            = errors.New("unknown packages")
 	ErrBuildDependencyGraph       = errors.New("failed to build dependency graph")
+	ErrInvalidSourceDateEpoch     = errors.New("invalid SOURCE_DATE_EPOCH")
+	ErrReadLockFile               = errors.New("cannot read lock file")
+	ErrParseLockFile              = errors.New("failed to parse lock file")
+	ErrWriteLockFile              = errors.New("cannot write lock file")
+	ErrInvalidIncludedBundle      = errors.New("invalid bundle block")
+	ErrUnknownIncludedBundle      = errors.New("unknown included bundle")
+	ErrBundleResolverRequired     = errors.New("bundle blocks are only expanded when the bundle is created")
+	ErrResolveIncludedBundle      = errors.New("failed to resolve included bundle")
+	ErrInvalidPackageExpansion    = errors.New("invalid package for_each or count")
+	ErrWriteConfigFile            = errors.New("cannot write config file")
+	ErrMergeConfigVariables       = errors.New("cannot add variables to config file")
+	ErrConvertJSONSyntax          = errors.New("failed to convert JSON syntax to HCL")
 )
 
 var (
@@ -0,0 +1,200 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/gocty"
+)
+
+// decodedPackageBlock is a package block before for_each or count expands it
+// into the packages it declares.
+type decodedPackageBlock struct {
+	Name   string   `hcl:"name,label"`
+	Config hcl.Body `hcl:",remain"`
+}
+
+var packageExpansionSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{Name: "for_each"},
+		{Name: "count"},
+	},
+}
+
+// packageInstance is one package a package block declares, with the
+// variables its attributes are evaluated with.
+type packageInstance struct {
+	name      string
+	variables map[string]cty.Value
+}
+
+// expandPackageBlocks decodes each package block into the packages it
+// declares. A block with for_each declares a package named label["key"] per
+// element, with each.key and each.value set; a block with count declares
+// label[0] to label[count-1], with count.index set. Blocks without either
+// declare the single package label. The instance names of expanded blocks
+// are recorded in decoded.Instances by label. depends_on is decoded per
+// instance, so it may index another expanded block by each.key or
+// count.index.
+func expandPackageBlocks(decoded *decodedBundle, evalCtx *hcl.EvalContext) error {
+	decoded.Instances = map[string][]string{}
+	for _, block := range decoded.Blocks {
+		content, body, diags := block.Config.PartialContent(packageExpansionSchema)
+		if diags.HasErrors() {
+			return fmt.Errorf("package %q: %w: %w", block.Name, ErrInvalidPackageExpansion, diags)
+		}
+		instances, err := packageInstances(block.Name, content, evalCtx)
+		if err != nil {
+			return fmt.Errorf("package %q: %w", block.Name, err)
+		}
+		if instances == nil {
+			instances = []packageInstance{{name: block.Name}}
+		} else {
+			names := make([]string, len(instances))
+			for i, instance := range instances {
+				names[i] = instance.name
+			}
+			decoded.Instances[block.Name] = names
+		}
+
+		for _, instance := range instances {
+			instanceCtx := evalCtx
+			if instance.variables != nil {
+				instanceCtx = evalCtx.NewChild()
+				instanceCtx.Variables = instance.variables
+			}
+			var pkg decodedPackage
+			if diags := gohcl.DecodeBody(body, instanceCtx, &pkg); diags.HasErrors() {
+				return fmt.Errorf("package %q: %w", instance.name, diags)
+			}
+			pkg.Name = instance.name
+			if pkg.Remain != nil {
+				refs, err := decodePackageDependsOn(pkg.Remain, instanceCtx)
+				if err != nil {
+					return fmt.Errorf("package %q: %w: %w", pkg.Name, ErrDecodePackageDependencies, err)
+				}
+				pkg.DependsOn = refs
+			}
+			decoded.Packages = append(decoded.Packages, pkg)
+		}
+	}
+	return nil
+}
+
+// packageInstances evaluates the for_each or count of a package block. It
+// returns nil when the block has neither.
+func packageInstances(label string, content *hcl.BodyContent, evalCtx *hcl.EvalContext) ([]packageInstance, error) {
+	forEach, hasForEach := content.Attributes["for_each"]
+	count, hasCount := content.Attributes["count"]
+	switch {
+	case hasForEach && hasCount:
+		return nil, fmt.Errorf("for_each and count cannot both be set at %s: %w", count.Range, ErrInvalidPackageExpansion)
+	case hasForEach:
+		return forEachInstances(label, forEach, evalCtx)
+	case hasCount:
+		return countInstances(label, count, evalCtx)
+	}
+	return nil, nil
+}
+
+// forEachInstances expands a map or object by its keys, and a set, list or
+// tuple of strings by its elements, which are both each.key and each.value.
+// Instances are ordered by key.
+func forEachInstances(label string, attr *hcl.Attribute, evalCtx *hcl.EvalContext) ([]packageInstance, error) {
+	value, diags := attr.Expr.Value(evalCtx)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w from for_each: %w", ErrEvaluateHCLExpression, diags)
+	}
+	if value.IsNull() || !value.IsWhollyKnown() {
+		return nil, fmt.Errorf("for_each at %s must be a known, non-null value: %w", attr.Range, ErrInvalidPackageExpansion)
+	}
+
+	elems := map[string]cty.Value{}
+	ty := value.Type()
+	switch {
+	case ty.IsMapType() || ty.IsObjectType():
+		for key, elem := range value.AsValueMap() {
+			elems[key] = elem
+		}
+	case ty.IsSetType() || ty.IsListType() || ty.IsTupleType():
+		for _, elem := range value.AsValueSlice() {
+			if elem.IsNull() || elem.Type() != cty.String {
+				return nil, fmt.Errorf("for_each at %s must contain only strings: %w", attr.Range, ErrInvalidPackageExpansion)
+			}
+			key := elem.AsString()
+			if _, ok := elems[key]; ok {
+				return nil, fmt.Errorf("for_each at %s contains %q more than once: %w", attr.Range, key, ErrInvalidPackageExpansion)
+			}
+			elems[key] = elem
+		}
+	default:
+		return nil, fmt.Errorf("for_each at %s must be a map, object or set of strings, not %s: %w", attr.Range, ty.FriendlyName(), ErrInvalidPackageExpansion)
+	}
+
+	instances := make([]packageInstance, 0, len(elems))
+	for _, key := range slices.Sorted(maps.Keys(elems)) {
+		instances = append(instances, packageInstance{
+			name: packageInstanceName(label, cty.StringVal(key)),
+			variables: map[string]cty.Value{
+				"each": cty.ObjectVal(map[string]cty.Value{"key": cty.StringVal(key), "value": elems[key]}),
+			},
+		})
+	}
+	return instances, nil
+}
+
+// countInstances expands a whole, non-negative number.
+func countInstances(label string, attr *hcl.Attribute, evalCtx *hcl.EvalContext) ([]packageInstance, error) {
+	value, diags := attr.Expr.Value(evalCtx)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w from count: %w", ErrEvaluateHCLExpression, diags)
+	}
+	var n int
+	if value.IsNull() || !value.IsKnown() || value.Type() != cty.Number || gocty.FromCtyValue(value, &n) != nil || n < 0 {
+		return nil, fmt.Errorf("count at %s must be a whole, non-negative number: %w", attr.Range, ErrInvalidPackageExpansion)
+	}
+
+	instances := make([]packageInstance, n)
+	for i := range instances {
+		index := cty.NumberIntVal(int64(i))
+		instances[i] = packageInstance{
+			name:      packageInstanceName(label, index),
+			variables: map[string]cty.Value{"count": cty.ObjectVal(map[string]cty.Value{"index": index})},
+		}
+	}
+	return instances, nil
+}
+
+// packageInstanceName returns the name of the package a for_each or count
+// block with the given label declares for key: label["key"] for a string key
+// and label[index] for a number.
+func packageInstanceName(label string, key cty.Value) string {
+	if key.Type() == cty.Number {
+		return label + "[" + key.AsBigFloat().Text('f', -1) + "]"
+	}
+	return label + "[" + string(hclwrite.TokensForValue(key).Bytes()) + "]"
+}
+
+// packageInstanceKey reports whether key can index an expanded package:
+// a string, or a whole number.
+func packageInstanceKey(key cty.Value) bool {
+	if key.IsNull() || !key.IsKnown() {
+		return false
+	}
+	switch key.Type() {
+	case cty.String:
+		return true
+	case cty.Number:
+		var i int
+		return gocty.FromCtyValue(key, &i) == nil && i >= 0
+	}
+	return false
+}
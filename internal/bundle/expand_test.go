@@ -0,0 +1,227 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tenantBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+locals {
+  tenants = {
+    blue = { namespace = "tenant-blue" }
+    red  = { namespace = "tenant-red" }
+  }
+}
+package "core" {
+  source = "oci://ghcr.io/uds/core:1.0.0"
+}
+package "tenant" {
+  for_each     = local.tenants
+  source       = "oci://ghcr.io/uds/tenant:1.0.0"
+  namespace    = each.value.namespace
+  values_files = ["values/${each.key}.yaml"]
+  depends_on   = [package.core]
+}
+package "worker" {
+  count      = 2
+  source     = "oci://ghcr.io/uds/worker:1.0.0"
+  namespace  = "worker-${count.index}"
+  depends_on = [package.tenant["red"]]
+}
+package "gateway" {
+  source     = "oci://ghcr.io/uds/gateway:1.0.0"
+  depends_on = [package.tenant, package.worker[1]]
+}
+`
+
+func TestParseBundleBytes_ExpandsForEachAndCount(t *testing.T) {
+	b, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(tenantBundle))
+	require.NoError(t, err)
+	require.NoError(t, b.Validate())
+
+	names := make([]string, len(b.Packages))
+	for i, pkg := range b.Packages {
+		names[i] = pkg.Name
+	}
+	assert.Equal(t, []string{"core", `tenant["blue"]`, `tenant["red"]`, "worker[0]", "worker[1]", "gateway"}, names)
+
+	assert.Equal(t, "tenant-blue", b.Packages[1].Namespace)
+	assert.Equal(t, []string{"values/blue.yaml"}, b.Packages[1].ValuesFiles)
+	assert.Equal(t, []spec.PackageRef{{Name: "core"}}, b.Packages[1].DependsOn)
+	assert.Equal(t, "tenant-red", b.Packages[2].Namespace)
+	assert.Equal(t, "worker-1", b.Packages[4].Namespace)
+	assert.Equal(t, []spec.PackageRef{{Name: `tenant["red"]`}}, b.Packages[3].DependsOn)
+	assert.Equal(t, []spec.PackageRef{{Name: `tenant["blue"]`}, {Name: `tenant["red"]`}, {Name: "worker[1]"}}, b.Packages[5].DependsOn)
+
+	dag, err := BuildDependencyGraph(t.Context(), iostreams.IOStreams{}, b)
+	require.NoError(t, err)
+	levels, err := dag.TopologicalLevels()
+	require.NoError(t, err)
+	levelNames := make([][]string, len(levels))
+	for i, level := range levels {
+		for _, pkg := range level {
+			levelNames[i] = append(levelNames[i], pkg.Name)
+		}
+	}
+	assert.Equal(t, [][]string{{"core"}, {`tenant["blue"]`, `tenant["red"]`}, {"worker[0]", "worker[1]"}, {"gateway"}}, levelNames)
+}
+
+func TestParseBundleBytes_ForEachSetAndEmptyCount(t *testing.T) {
+	src := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+package "tenant" {
+  for_each  = ["b", "a"]
+  source    = "oci://ghcr.io/uds/tenant:1.0.0"
+  namespace = each.value
+}
+package "unused" {
+  count  = 0
+  source = "oci://ghcr.io/uds/unused:1.0.0"
+}
+`
+	b, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(src))
+	require.NoError(t, err)
+	require.Len(t, b.Packages, 2)
+	assert.Equal(t, `tenant["a"]`, b.Packages[0].Name)
+	assert.Equal(t, "a", b.Packages[0].Namespace)
+	assert.Equal(t, `tenant["b"]`, b.Packages[1].Name)
+}
+
+func TestParseBundleBytes_PackageExpansionErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		block string
+		err   error
+	}{
+		{name: "both", block: "for_each = [\"a\"]\n  count = 1", err: ErrInvalidPackageExpansion},
+		{name: "for_each number", block: "for_each = 3", err: ErrInvalidPackageExpansion},
+		{name: "for_each duplicate", block: "for_each = [\"a\", \"a\"]", err: ErrInvalidPackageExpansion},
+		{name: "for_each non-string element", block: "for_each = [1]", err: ErrInvalidPackageExpansion},
+		{name: "count negative", block: "count = -1", err: ErrInvalidPackageExpansion},
+		{name: "count fractional", block: "count = 1.5", err: ErrInvalidPackageExpansion},
+		{name: "each outside for_each", block: "namespace = each.key", err: ErrDecodeBundle},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+package "tenant" {
+  source = "oci://ghcr.io/uds/tenant:1.0.0"
+  ` + tt.block + `
+}
+`
+			_, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(src))
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseBundleBytes_DependsOnMatchingInstance(t *testing.T) {
+	src := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+package "db" {
+  for_each = ["blue", "red"]
+  source   = "oci://ghcr.io/uds/db:1.0.0"
+}
+package "app" {
+  for_each   = ["blue", "red"]
+  source     = "oci://ghcr.io/uds/app:1.0.0"
+  depends_on = [package.db[each.key]]
+}
+package "shard" {
+  count  = 2
+  source = "oci://ghcr.io/uds/shard:1.0.0"
+}
+package "worker" {
+  count      = 2
+  source     = "oci://ghcr.io/uds/worker:1.0.0"
+  depends_on = [package.shard[count.index], package.db["blue"]]
+}
+`
+	b, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(src))
+	require.NoError(t, err)
+	require.NoError(t, b.Validate())
+
+	dependsOn := map[string][]spec.PackageRef{}
+	for _, pkg := range b.Packages {
+		dependsOn[pkg.Name] = pkg.DependsOn
+	}
+	assert.Equal(t, []spec.PackageRef{{Name: `db["blue"]`}}, dependsOn[`app["blue"]`])
+	assert.Equal(t, []spec.PackageRef{{Name: `db["red"]`}}, dependsOn[`app["red"]`])
+	assert.Equal(t, []spec.PackageRef{{Name: "shard[0]"}, {Name: `db["blue"]`}}, dependsOn["worker[0]"])
+	assert.Equal(t, []spec.PackageRef{{Name: "shard[1]"}, {Name: `db["blue"]`}}, dependsOn["worker[1]"])
+
+	_, err = NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+package "app" {
+  source     = "oci://ghcr.io/uds/app:1.0.0"
+  depends_on = [package.db[each.key]]
+}
+`))
+	require.ErrorIs(t, err, ErrDecodePackageDependencies)
+}
+
+func TestParseBundleBytes_UnknownPackageInstance(t *testing.T) {
+	src := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "tenants"
+}
+package "tenant" {
+  for_each = ["a"]
+  source   = "oci://ghcr.io/uds/tenant:1.0.0"
+}
+package "gateway" {
+  source     = "oci://ghcr.io/uds/gateway:1.0.0"
+  depends_on = [package.tenant["b"]]
+}
+`
+	b, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(src))
+	require.NoError(t, err)
+	var unknown *spec.UnknownDependencyError
+	require.ErrorAs(t, b.Validate(), &unknown)
+	assert.Equal(t, `tenant["b"]`, unknown.Dependency)
+}
+
+func TestParseAndMaterializeBundleFile_KeepsExpandedBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), BundleFileName)
+	require.NoError(t, os.WriteFile(path, []byte(tenantBundle), 0o600))
+
+	b, materialized, err := NewHCLParser("", iostreams.IOStreams{}).ParseAndMaterializeBundleFile(t.Context(), path)
+	require.NoError(t, err)
+
+	reparsed, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	assert.Equal(t, b.Packages, reparsed.Packages)
+}
@@ -114,10 +114,16 @@ func materializeDefaultsFile(path string) ([]byte, error) {
 	if _, err := parseDefaultsContent(src, path); err != nil {
 		return nil, err
 	}
+	if isJSONSyntax(path) {
+		if src, err = nativeFromJSON(src, path, defaultsJSONSchema); err != nil {
+			return nil, err
+		}
+	}
 	return materializeFileCallExpressions(src, path, configEvalContext(path), nil)
 }
 
-// MaterializeDefaultsFile resolves file() calls in a defaults file.
+// MaterializeDefaultsFile resolves file() calls in a defaults file. A
+// defaults.uds.json is materialized in the native syntax.
 func MaterializeDefaultsFile(path string) ([]byte, error) {
 	return materializeDefaultsFile(path)
 }
@@ -414,8 +420,11 @@ func (p *HCLParser) extractLocals(hclFile *hcl.File, funcs map[string]function.F
 
 // decodePackageDependsOn extracts depends_on from a package's Remain body as HCL traversals.
 // The syntax is: depends_on = [package.core_base, package.core_logging]
-// Each element must be a static traversal referencing "package.<name>".
-func decodePackageDependsOn(body hcl.Body) ([]decodedPackageRef, error) {
+// Each element must be a traversal accepted by decodePackageRef. The key of a
+// trailing index is evaluated in evalCtx, so an instance of a for_each or
+// count block can depend on the matching instance of another:
+// depends_on = [package.db[each.key]].
+func decodePackageDependsOn(body hcl.Body, evalCtx *hcl.EvalContext) ([]decodedPackageRef, error) {
 	attrSchema := &hcl.BodySchema{
 		Attributes: []hcl.AttributeSchema{
 			{Name: "depends_on"},
@@ -440,32 +449,75 @@ func decodePackageDependsOn(body hcl.Body) ([]decodedPackageRef, error) {
 
 	var refs []decodedPackageRef
 	for _, expr := range exprs {
-		// Each element must be a static traversal (e.g., package.core_base)
-		traversal, diags := hcl.AbsTraversalForExpr(expr)
+		// Each element must be a traversal (e.g., package.core_base or bundle.core)
+		traversal, diags := packageRefTraversal(expr, evalCtx)
 		if diags.HasErrors() {
 			return nil, fmt.Errorf("depends_on element must be a package reference (e.g., package.core_base): %w: %w", ErrInvalidPackageReference, diags)
 		}
 
-		// Validate the traversal structure: must be "package.<name>"
-		if len(traversal) != 2 {
-			return nil, fmt.Errorf("invalid package reference at %s: expected package.<name>: %w", expr.Range(), ErrInvalidPackageReference)
+		ref, err := decodePackageRef(traversal, expr.Range())
+		if err != nil {
+			return nil, err
 		}
+		refs = append(refs, ref)
+	}
 
-		root, ok := traversal[0].(hcl.TraverseRoot)
-		if !ok || root.Name != "package" {
-			return nil, fmt.Errorf("invalid package reference at %s: must start with 'package': %w", expr.Range(), ErrInvalidPackageReference)
-		}
+	return refs, nil
+}
 
-		attrStep, ok := traversal[1].(hcl.TraverseAttr)
-		if !ok {
-			return nil, fmt.Errorf("invalid package reference at %s: expected package.<name>: %w", expr.Range(), ErrInvalidPackageReference)
-		}
+// packageRefTraversal returns the traversal a depends_on element refers to,
+// evaluating the key of a trailing index such as package.db[each.key].
+func packageRefTraversal(expr hcl.Expression, evalCtx *hcl.EvalContext) (hcl.Traversal, hcl.Diagnostics) {
+	index, ok := expr.(*hclsyntax.IndexExpr)
+	if !ok {
+		return hcl.AbsTraversalForExpr(expr)
+	}
+	traversal, diags := hcl.AbsTraversalForExpr(index.Collection)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	key, keyDiags := index.Key.Value(evalCtx)
+	if diags = append(diags, keyDiags...); diags.HasErrors() {
+		return nil, diags
+	}
+	return append(traversal, hcl.TraverseIndex{Key: key, SrcRange: index.Key.Range()}), diags
+}
 
-		refs = append(refs, decodedPackageRef{
-			Name:      attrStep.Name,
-			Traversal: traversal,
-		})
+// decodePackageRef validates a depends_on traversal. It must be package.<name>,
+// package["<name>"] for names that are not identifiers, such as those of
+// included packages, or bundle.<name> for every package a bundle block includes.
+// A package reference may index a block expanded by for_each or count, as
+// package.<name>["key"] or package.<name>[0], to depend on a single instance.
+func decodePackageRef(traversal hcl.Traversal, rng hcl.Range) (decodedPackageRef, error) {
+	if len(traversal) != 2 && len(traversal) != 3 {
+		return decodedPackageRef{}, fmt.Errorf("invalid package reference at %s: expected package.<name> or bundle.<name>: %w", rng, ErrInvalidPackageReference)
 	}
 
-	return refs, nil
+	root, ok := traversal[0].(hcl.TraverseRoot)
+	if !ok || (root.Name != "package" && root.Name != "bundle") {
+		return decodedPackageRef{}, fmt.Errorf("invalid package reference at %s: must start with 'package' or 'bundle': %w", rng, ErrInvalidPackageReference)
+	}
+
+	ref := decodedPackageRef{Bundle: root.Name == "bundle", Traversal: traversal}
+	switch step := traversal[1].(type) {
+	case hcl.TraverseAttr:
+		ref.Name = step.Name
+	case hcl.TraverseIndex:
+		if root.Name == "package" && step.Key.Type() == cty.String && step.Key.IsKnown() && !step.Key.IsNull() {
+			ref.Name = step.Key.AsString()
+		}
+	}
+	if ref.Name == "" {
+		return decodedPackageRef{}, fmt.Errorf("invalid package reference at %s: expected %s.<name>: %w", rng, root.Name, ErrInvalidPackageReference)
+	}
+	if len(traversal) == 2 {
+		return ref, nil
+	}
+
+	index, ok := traversal[2].(hcl.TraverseIndex)
+	if !ok || ref.Bundle || !packageInstanceKey(index.Key) {
+		return decodedPackageRef{}, fmt.Errorf("invalid package reference at %s: expected package.<name>[\"key\"] or package.<name>[index]: %w", rng, ErrInvalidPackageReference)
+	}
+	ref.Instance = packageInstanceName(ref.Name, index.Key)
+	return ref, nil
 }
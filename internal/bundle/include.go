@@ -0,0 +1,333 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+type decodedIncludedBundle struct {
+	Name   string `hcl:"name,label"`
+	Source string `hcl:"source"`
+}
+
+// IncludedBundle is the bundle a bundle block includes.
+type IncludedBundle struct {
+	// Bundle is the included bundle's definition. Its values files are read
+	// from outside the included bundle, so resolvers return absolute paths.
+	Bundle *spec.UDSBundle
+	// Defaults holds the variables of the included bundle's defaults.uds.hcl.
+	Defaults Variables
+}
+
+// BundleResolver fetches the bundle named by a bundle block's source.
+type BundleResolver interface {
+	ResolveBundle(ctx context.Context, name, source string) (*IncludedBundle, error)
+}
+
+// WithBundleResolver returns a copy of the parser that expands bundle blocks
+// with r. Parsing a bundle with bundle blocks fails without a resolver.
+func (p *HCLParser) WithBundleResolver(r BundleResolver) *HCLParser {
+	parser := *p
+	parser.resolver = r
+	return &parser
+}
+
+// IncludedPackageName returns the name package pkgName of the bundle included
+// by bundle block bundleName has in the including bundle.
+func IncludedPackageName(bundleName, pkgName string) string {
+	return bundleName + "." + pkgName
+}
+
+// resolveIncludedBundles fetches the bundle of each bundle block and returns
+// its packages, named <bundle>.<package> with their dependencies kept, along
+// with the references a bundle.<name> dependency expands to.
+func (p *HCLParser) resolveIncludedBundles(ctx context.Context, decoded *decodedBundle) (map[string][]spec.PackageRef, []spec.Package, error) {
+	included := make(map[string][]spec.PackageRef, len(decoded.Bundles))
+	var packages []spec.Package
+	for i, block := range decoded.Bundles {
+		if block.Name == "" || strings.ContainsAny(block.Name, "./\\") {
+			return nil, nil, fmt.Errorf("bundle[%d]: name %q must not be empty or contain dots or path separators: %w", i, block.Name, ErrInvalidIncludedBundle)
+		}
+		if _, ok := included[block.Name]; ok {
+			return nil, nil, fmt.Errorf("bundle[%d]: duplicate bundle name %q: %w", i, block.Name, ErrInvalidIncludedBundle)
+		}
+		if block.Source == "" {
+			return nil, nil, fmt.Errorf("bundle %q: source is required: %w", block.Name, ErrInvalidIncludedBundle)
+		}
+		if p.resolver == nil {
+			return nil, nil, fmt.Errorf("bundle %q: %w", block.Name, ErrBundleResolverRequired)
+		}
+
+		p.streams.Debug("resolving included bundle", "name", block.Name, "source", block.Source)
+		inc, err := p.resolver.ResolveBundle(ctx, block.Name, block.Source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("bundle %q: %w %q: %w", block.Name, ErrResolveIncludedBundle, block.Source, err)
+		}
+		if inc == nil || inc.Bundle == nil || len(inc.Bundle.Packages) == 0 {
+			return nil, nil, fmt.Errorf("bundle %q: %q has no packages: %w", block.Name, block.Source, ErrInvalidIncludedBundle)
+		}
+
+		refs := make([]spec.PackageRef, 0, len(inc.Bundle.Packages))
+		for _, pkg := range inc.Bundle.Packages {
+			name := IncludedPackageName(block.Name, pkg.Name)
+			dependsOn := make([]spec.PackageRef, len(pkg.DependsOn))
+			for j, dep := range pkg.DependsOn {
+				dependsOn[j] = spec.PackageRef{Name: IncludedPackageName(block.Name, dep.Name)}
+			}
+			packages = append(packages, spec.Package{
+				Name: name, Source: pkg.Source, Namespace: pkg.Namespace, DependsOn: dependsOn,
+				ValuesFiles: append([]string(nil), pkg.ValuesFiles...), OptionalComponents: append([]string(nil), pkg.OptionalComponents...),
+				SignatureVerification: pkg.SignatureVerification, Bundle: block.Name,
+			})
+			refs = append(refs, spec.PackageRef{Name: name})
+		}
+		included[block.Name] = refs
+		p.streams.Debug("included bundle expanded", "name", block.Name, "packages", len(refs))
+	}
+
+	for _, pkg := range decoded.Packages {
+		for _, ref := range pkg.DependsOn {
+			if _, ok := included[ref.Name]; ref.Bundle && !ok {
+				return nil, nil, fmt.Errorf("package %q: %w %q", pkg.Name, ErrUnknownIncludedBundle, ref.Name)
+			}
+		}
+	}
+	return included, packages, nil
+}
+
+// materializeIncludedBundles rewrites bundle source with its bundle blocks
+// replaced by the package blocks of b they expanded to. The depends_on of the
+// bundle's own packages is rewritten from b, so bundle.<name> references name
+// the included packages.
+func materializeIncludedBundles(src []byte, filename string, b *spec.UDSBundle) ([]byte, error) {
+	file, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseHCL, filename, diags)
+	}
+	packages := make(map[string]*spec.Package, len(b.Packages))
+	for i := range b.Packages {
+		packages[b.Packages[i].Name] = &b.Packages[i]
+	}
+
+	body := file.Body()
+	for _, block := range body.Blocks() {
+		switch block.Type() {
+		case "bundle":
+			body.RemoveBlock(block)
+		case "package":
+			labels := block.Labels()
+			if len(labels) != 1 || block.Body().GetAttribute("depends_on") == nil {
+				continue
+			}
+			if pkg := packages[labels[0]]; pkg != nil {
+				block.Body().SetAttributeRaw("depends_on", packageRefTokens(pkg.DependsOn))
+				continue
+			}
+			// The instances of an expanded block may depend on different
+			// packages, so only its bundle.<name> elements are rewritten.
+			tokens, err := expandedDependsOnTokens(block.Body().GetAttribute("depends_on"), b)
+			if err != nil {
+				return nil, fmt.Errorf("%w %q: package %q: %w", ErrParseHCL, filename, labels[0], err)
+			}
+			block.Body().SetAttributeRaw("depends_on", tokens)
+		}
+	}
+	for _, pkg := range b.Packages {
+		if pkg.Bundle == "" {
+			continue
+		}
+		body.AppendNewline()
+		writeIncludedPackage(body.AppendNewBlock("package", []string{pkg.Name}).Body(), &pkg)
+	}
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// writeIncludedPackage writes the attributes of an included package block.
+// Values files are written as the paths the artifact stores them at.
+func writeIncludedPackage(body *hclwrite.Body, pkg *spec.Package) {
+	body.SetAttributeValue("source", cty.StringVal(pkg.Source))
+	if pkg.Namespace != "" {
+		body.SetAttributeValue("namespace", cty.StringVal(pkg.Namespace))
+	}
+	if len(pkg.DependsOn) > 0 {
+		body.SetAttributeRaw("depends_on", packageRefTokens(pkg.DependsOn))
+	}
+	if len(pkg.ValuesFiles) > 0 {
+		values := make([]cty.Value, len(pkg.ValuesFiles))
+		for i := range pkg.ValuesFiles {
+			values[i] = cty.StringVal(path.Join("values", pkg.Name, strconv.Itoa(i)+".yaml"))
+		}
+		body.SetAttributeValue("values_files", cty.ListVal(values))
+	}
+	if len(pkg.OptionalComponents) > 0 {
+		components := make([]cty.Value, len(pkg.OptionalComponents))
+		for i, component := range pkg.OptionalComponents {
+			components[i] = cty.StringVal(component)
+		}
+		body.SetAttributeValue("optional_components", cty.ListVal(components))
+	}
+	verification := pkg.SignatureVerification
+	if verification == nil {
+		return
+	}
+	vbody := body.AppendNewBlock("signature_verification", nil).Body()
+	if verification.Verify != nil {
+		vbody.SetAttributeValue("verify", cty.BoolVal(*verification.Verify))
+	}
+	setOptionalString(vbody, "public_key", verification.PublicKey)
+	if k := verification.Keyless; k != nil {
+		kbody := vbody.AppendNewBlock("keyless", nil).Body()
+		setOptionalString(kbody, "certificate_identity", k.CertificateIdentity)
+		setOptionalString(kbody, "certificate_identity_regexp", k.CertificateIdentityRegexp)
+		setOptionalString(kbody, "certificate_oidc_issuer", k.CertificateOIDCIssuer)
+		setOptionalString(kbody, "certificate_oidc_issuer_regexp", k.CertificateOIDCIssuerRegexp)
+		setOptionalString(kbody, "trusted_root", k.TrustedRoot)
+		setOptionalBool(kbody, "insecure_ignore_tlog", k.InsecureIgnoreTlog)
+		setOptionalBool(kbody, "insecure_ignore_sct", k.InsecureIgnoreSCT)
+		setOptionalBool(kbody, "use_signed_timestamps", k.UseSignedTimestamps)
+	}
+}
+
+func setOptionalString(body *hclwrite.Body, name, value string) {
+	if value != "" {
+		body.SetAttributeValue(name, cty.StringVal(value))
+	}
+}
+
+func setOptionalBool(body *hclwrite.Body, name string, value bool) {
+	if value {
+		body.SetAttributeValue(name, cty.True)
+	}
+}
+
+// packageRefTokens writes refs as a depends_on list, indexing package by name
+// where the name is not an identifier.
+func packageRefTokens(refs []spec.PackageRef) hclwrite.Tokens {
+	elems := make([]hclwrite.Tokens, len(refs))
+	for i, ref := range refs {
+		elems[i] = packageRefElement(ref.Name)
+	}
+	return hclwrite.TokensForTuple(elems)
+}
+
+// packageRefElement returns the package.<name> reference to the package name.
+func packageRefElement(name string) hclwrite.Tokens {
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: "package"}, hcl.TraverseAttr{Name: name}}
+	if !hclsyntax.ValidIdentifier(name) {
+		traversal[1] = hcl.TraverseIndex{Key: cty.StringVal(name)}
+	}
+	return hclwrite.TokensForTraversal(traversal)
+}
+
+// expandedDependsOnTokens returns the depends_on attr of an expanded package
+// block with each bundle.<name> element replaced by the packages of b that
+// the bundle includes. Other elements are kept as written, since they may
+// index another expanded block by each.key or count.index.
+func expandedDependsOnTokens(attr *hclwrite.Attribute, b *spec.UDSBundle) (hclwrite.Tokens, error) {
+	src := attr.Expr().BuildTokens(nil).Bytes()
+	expr, diags := hclsyntax.ParseExpression(src, "depends_on", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	exprs, diags := hcl.ExprList(expr)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var elems []hclwrite.Tokens
+	for _, elem := range exprs {
+		traversal, diags := hcl.AbsTraversalForExpr(elem)
+		if !diags.HasErrors() && len(traversal) == 2 && traversal.RootName() == "bundle" {
+			if step, ok := traversal[1].(hcl.TraverseAttr); ok {
+				for _, pkg := range b.Packages {
+					if pkg.Bundle == step.Name {
+						elems = append(elems, packageRefElement(pkg.Name))
+					}
+				}
+				continue
+			}
+		}
+		rng := elem.Range()
+		elems = append(elems, hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: src[rng.Start.Byte:rng.End.Byte]}})
+	}
+	return hclwrite.TokensForTuple(elems), nil
+}
+
+// MaterializeIncludedDefaults returns the defaults of a bundle whose bundle
+// blocks included bundles with the given defaults. Included defaults are
+// merged in order and the bundle's own materialized defaults, local, take
+// precedence. local is returned unchanged when no included bundle has defaults.
+func MaterializeIncludedDefaults(ctx context.Context, included []Variables, local []byte) ([]byte, error) {
+	var merged Variables
+	for _, defaults := range included {
+		if len(defaults) > 0 {
+			merged = MergeVariables(merged, defaults)
+		}
+	}
+	if len(merged) == 0 {
+		return local, nil
+	}
+	if len(local) > 0 {
+		localVars, err := ParseDefaultsBytes(ctx, local)
+		if err != nil {
+			return nil, err
+		}
+		merged = MergeVariables(merged, localVars)
+	}
+	value, err := variablesToCty(merged)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrConvertVariables, err)
+	}
+	// Writing into the local defaults keeps its variable declarations.
+	file, diags := hclwrite.ParseConfig(local, BundleDefaultsFileName, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseDefaults, BundleDefaultsFileName, diags)
+	}
+	file.Body().SetAttributeValue("variables", value)
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// variablesToCty is the inverse of ctyValueToGo.
+func variablesToCty(value any) (cty.Value, error) {
+	switch v := value.(type) {
+	case Variables:
+		attrs := make(map[string]cty.Value, len(v))
+		for key, child := range v {
+			converted, err := variablesToCty(child)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("%q: %w", key, err)
+			}
+			attrs[key] = converted
+		}
+		return cty.ObjectVal(attrs), nil
+	case []any:
+		elems := make([]cty.Value, len(v))
+		for i, child := range v {
+			converted, err := variablesToCty(child)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elems[i] = converted
+		}
+		return cty.TupleVal(elems), nil
+	case string:
+		return cty.StringVal(v), nil
+	case float64:
+		return cty.NumberFloatVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported variable type %T: %w", value, ErrUnsupportedVariableType)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const composedBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "product"
+  version = "1.0.0"
+}
+bundle "core" {
+  source = "oci://ghcr.io/uds/core-bundle:1.2.3"
+}
+package "app" {
+  source     = "./app"
+  depends_on = [bundle.core]
+  signature_verification { verify = false }
+}
+`
+
+type fakeBundleResolver struct {
+	bundles map[string]*IncludedBundle
+	sources []string
+}
+
+func (r *fakeBundleResolver) ResolveBundle(_ context.Context, _, source string) (*IncludedBundle, error) {
+	r.sources = append(r.sources, source)
+	return r.bundles[source], nil
+}
+
+func coreBundleResolver() *fakeBundleResolver {
+	verify := false
+	return &fakeBundleResolver{bundles: map[string]*IncludedBundle{
+		"oci://ghcr.io/uds/core-bundle:1.2.3": {
+			Bundle: &spec.UDSBundle{Metadata: spec.Metadata{Name: "core"}, Packages: []spec.Package{
+				{Name: "istio", Source: "oci://ghcr.io/uds/istio:1.0.0", Namespace: "istio-system", ValuesFiles: []string{"/tmp/core/values/istio/0.yaml"}, SignatureVerification: &spec.PackageSignatureVerification{Verify: &verify}},
+				{Name: "keycloak", Source: "oci://ghcr.io/uds/keycloak:2.0.0", DependsOn: []spec.PackageRef{{Name: "istio"}}, OptionalComponents: []string{"themes"}, SignatureVerification: &spec.PackageSignatureVerification{PublicKey: "KEY"}},
+			}},
+			Defaults: Variables{"domain": "uds.dev", "log": Variables{"level": "info"}},
+		},
+	}}
+}
+
+func TestParseBundleBytes_ExpandsBundleBlocks(t *testing.T) {
+	resolver := coreBundleResolver()
+	b, err := NewHCLParser("", iostreams.IOStreams{}).WithBundleResolver(resolver).ParseBundleBytes(t.Context(), []byte(composedBundle))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"oci://ghcr.io/uds/core-bundle:1.2.3"}, resolver.sources)
+	require.Len(t, b.Packages, 3)
+	assert.Equal(t, "app", b.Packages[0].Name)
+	assert.Empty(t, b.Packages[0].Bundle)
+	assert.Equal(t, []spec.PackageRef{{Name: "core.istio"}, {Name: "core.keycloak"}}, b.Packages[0].DependsOn)
+	assert.Equal(t, "core.istio", b.Packages[1].Name)
+	assert.Equal(t, "core", b.Packages[1].Bundle)
+	assert.Equal(t, "istio-system", b.Packages[1].Namespace)
+	assert.Equal(t, "core.keycloak", b.Packages[2].Name)
+	assert.Equal(t, []spec.PackageRef{{Name: "core.istio"}}, b.Packages[2].DependsOn)
+	require.NoError(t, b.Validate())
+}
+
+func TestParseBundleBytes_BundleBlockErrors(t *testing.T) {
+	_, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(composedBundle))
+	require.ErrorIs(t, err, ErrBundleResolverRequired)
+
+	unknown := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "product"
+}
+package "app" {
+  source     = "./app"
+  depends_on = [bundle.core]
+}
+`
+	_, err = NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), []byte(unknown))
+	require.ErrorIs(t, err, ErrUnknownIncludedBundle)
+
+	dotted := `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "product"
+}
+bundle "core.v1" {
+  source = "oci://ghcr.io/uds/core-bundle:1.2.3"
+}
+`
+	_, err = NewHCLParser("", iostreams.IOStreams{}).WithBundleResolver(coreBundleResolver()).ParseBundleBytes(t.Context(), []byte(dotted))
+	require.ErrorIs(t, err, ErrInvalidIncludedBundle)
+}
+
+func TestParseAndMaterializeBundleFile_WritesIncludedPackages(t *testing.T) {
+	path := filepath.Join(t.TempDir(), BundleFileName)
+	require.NoError(t, os.WriteFile(path, []byte(composedBundle), 0o600))
+
+	b, materialized, err := NewHCLParser("", iostreams.IOStreams{}).WithBundleResolver(coreBundleResolver()).ParseAndMaterializeBundleFile(t.Context(), path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(materialized), `bundle "core"`)
+	assert.Contains(t, string(materialized), `depends_on = [package["core.istio"], package["core.keycloak"]]`)
+	assert.Contains(t, string(materialized), `values_files = ["values/core.istio/0.yaml"]`)
+
+	// The materialized bundle parses on its own, without fetching the included bundle.
+	reparsed, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	require.Len(t, reparsed.Packages, len(b.Packages))
+	for i, pkg := range reparsed.Packages {
+		want := b.Packages[i]
+		assert.Equal(t, want.Name, pkg.Name)
+		assert.Equal(t, want.Source, pkg.Source)
+		assert.Equal(t, want.Namespace, pkg.Namespace)
+		assert.Equal(t, want.DependsOn, pkg.DependsOn)
+		assert.Equal(t, want.OptionalComponents, pkg.OptionalComponents)
+		assert.Equal(t, want.SignatureVerification, pkg.SignatureVerification)
+		assert.Len(t, pkg.ValuesFiles, len(want.ValuesFiles))
+	}
+}
+
+func TestParseAndMaterializeBundleFile_KeepsExpandedDependsOn(t *testing.T) {
+	path := filepath.Join(t.TempDir(), BundleFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "product"
+}
+bundle "core" {
+  source = "oci://ghcr.io/uds/core-bundle:1.2.3"
+}
+package "db" {
+  for_each = ["blue", "red"]
+  source   = "./db"
+}
+package "app" {
+  for_each   = ["blue", "red"]
+  source     = "./app"
+  depends_on = [package.db[each.key], bundle.core]
+}
+`), 0o600))
+
+	b, materialized, err := NewHCLParser("", iostreams.IOStreams{}).WithBundleResolver(coreBundleResolver()).ParseAndMaterializeBundleFile(t.Context(), path)
+	require.NoError(t, err)
+	assert.Contains(t, string(materialized), `depends_on = [package.db[each.key], package["core.istio"], package["core.keycloak"]]`)
+
+	reparsed, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	require.Len(t, reparsed.Packages, len(b.Packages))
+	for i, pkg := range reparsed.Packages {
+		assert.Equal(t, b.Packages[i].DependsOn, pkg.DependsOn, pkg.Name)
+	}
+	assert.Equal(t, []spec.PackageRef{{Name: `db["red"]`}, {Name: "core.istio"}, {Name: "core.keycloak"}}, b.Packages[3].DependsOn)
+}
+
+func TestMaterializeIncludedDefaults(t *testing.T) {
+	local := []byte("variables = {\n  log = {\n    level = \"debug\"\n  }\n}\n")
+
+	unchanged, err := MaterializeIncludedDefaults(t.Context(), []Variables{nil}, local)
+	require.NoError(t, err)
+	assert.Equal(t, local, unchanged)
+
+	merged, err := MaterializeIncludedDefaults(t.Context(), []Variables{{"domain": "uds.dev", "log": Variables{"level": "info", "format": "json"}}}, local)
+	require.NoError(t, err)
+	vars, err := ParseDefaultsBytes(t.Context(), merged)
+	require.NoError(t, err)
+	assert.Equal(t, Variables{"domain": "uds.dev", "log": Variables{"level": "debug", "format": "json"}}, vars)
+}
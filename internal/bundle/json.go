@@ -0,0 +1,437 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+)
+
+// ParseHCL parses src in HCL's JSON syntax when filename ends in .json, such
+// as bundle.uds.json, and in the native syntax otherwise. Both produce bodies
+// with the same locals, file() and depends_on semantics.
+func ParseHCL(src []byte, filename string) (*hcl.File, hcl.Diagnostics) {
+	if isJSONSyntax(filename) {
+		return hcljson.Parse(src, filename)
+	}
+	return hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+}
+
+func isJSONSyntax(filename string) bool {
+	return strings.EqualFold(filepath.Ext(filename), ".json")
+}
+
+// jsonBlockSchema describes which properties of a JSON-syntax body are
+// blocks. HCL's JSON syntax needs a schema to tell blocks from object-valued
+// attributes, so converting to native syntax uses one per file kind.
+type jsonBlockSchema struct {
+	labels int
+	blocks map[string]jsonBlockSchema
+	// traversals names attributes holding lists of references, such as
+	// depends_on, written as strings in JSON.
+	traversals []string
+	// expressions names attributes holding type expressions, such as a
+	// variable's type, written as strings in JSON.
+	expressions []string
+}
+
+var bundleJSONSchema = jsonBlockSchema{blocks: map[string]jsonBlockSchema{
+	"uds":      {},
+	"metadata": {},
+	"locals":   {},
+	"bundle":   {labels: 1},
+	"package": {
+		labels:     1,
+		traversals: []string{"depends_on"},
+		blocks: map[string]jsonBlockSchema{
+			"signature_verification": {blocks: map[string]jsonBlockSchema{"keyless": {}}},
+		},
+	},
+}}
+
+var defaultsJSONSchema = jsonBlockSchema{blocks: map[string]jsonBlockSchema{
+	"variable": {labels: 1, expressions: []string{"type"}},
+}}
+
+// nativeFromJSON rewrites a JSON-syntax file in the native syntax, keeping
+// property order. Strings are written as quoted templates, so interpolations
+// and file() calls evaluate as they do in JSON. Artifacts only carry native
+// bundle.uds.hcl and defaults.uds.hcl layers.
+func nativeFromJSON(src []byte, filename string, schema jsonBlockSchema) ([]byte, error) {
+	root, err := decodeOrderedJSON(src)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrConvertJSONSyntax, filename, err)
+	}
+	file := hclwrite.NewEmptyFile()
+	if err := writeJSONBody(file.Body(), root, schema); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrConvertJSONSyntax, filename, err)
+	}
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// jsonValue is a JSON value whose objects keep their property order.
+type jsonValue struct {
+	// scalar is a string, json.Number, bool or nil for values that are
+	// neither objects nor arrays.
+	scalar   any
+	object   []jsonProperty
+	array    []jsonValue
+	isObject bool
+	isArray  bool
+}
+
+type jsonProperty struct {
+	name  string
+	value jsonValue
+}
+
+func decodeOrderedJSON(src []byte) (jsonValue, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	dec.UseNumber()
+	value, err := decodeJSONValue(dec)
+	if err != nil {
+		return jsonValue{}, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return jsonValue{}, errors.New("unexpected content after the root object")
+	}
+	return value, nil
+}
+
+func decodeJSONValue(dec *json.Decoder) (jsonValue, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return jsonValue{}, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		value := jsonValue{isObject: true}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return jsonValue{}, err
+			}
+			elem, err := decodeJSONValue(dec)
+			if err != nil {
+				return jsonValue{}, err
+			}
+			value.object = append(value.object, jsonProperty{name: key.(string), value: elem})
+		}
+		_, err := dec.Token()
+		return value, err
+	case json.Delim('['):
+		value := jsonValue{isArray: true}
+		for dec.More() {
+			elem, err := decodeJSONValue(dec)
+			if err != nil {
+				return jsonValue{}, err
+			}
+			value.array = append(value.array, elem)
+		}
+		_, err := dec.Token()
+		return value, err
+	default:
+		return jsonValue{scalar: tok}, nil
+	}
+}
+
+// writeJSONBody writes the properties of a JSON object as the attributes and
+// blocks of body. Properties named "//" are comments in HCL's JSON syntax.
+func writeJSONBody(body *hclwrite.Body, value jsonValue, schema jsonBlockSchema) error {
+	if !value.isObject {
+		return errors.New("body must be a JSON object")
+	}
+	for _, prop := range value.object {
+		if prop.name == "//" {
+			continue
+		}
+		if block, ok := schema.blocks[prop.name]; ok {
+			if err := writeJSONBlocks(body, prop.name, nil, prop.value, block); err != nil {
+				return err
+			}
+			continue
+		}
+		if !hclsyntax.ValidIdentifier(prop.name) {
+			return fmt.Errorf("attribute name %q is not a valid identifier", prop.name)
+		}
+		tokens, err := jsonAttributeTokens(prop.name, prop.value, schema)
+		if err != nil {
+			return err
+		}
+		body.SetAttributeRaw(prop.name, tokens)
+	}
+	return nil
+}
+
+// writeJSONBlocks writes the blocks of type typ a JSON property declares. Each
+// label is a level of object nesting, and an array declares several blocks.
+func writeJSONBlocks(body *hclwrite.Body, typ string, labels []string, value jsonValue, schema jsonBlockSchema) error {
+	switch {
+	case value.isArray:
+		for _, elem := range value.array {
+			if err := writeJSONBlocks(body, typ, labels, elem, schema); err != nil {
+				return err
+			}
+		}
+		return nil
+	case !value.isObject:
+		return fmt.Errorf("%s block must be a JSON object", typ)
+	case len(labels) < schema.labels:
+		for _, prop := range value.object {
+			if err := writeJSONBlocks(body, typ, append(slices.Clone(labels), prop.name), prop.value, schema); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		block := body.AppendNewBlock(typ, labels)
+		if err := writeJSONBody(block.Body(), value, schema); err != nil {
+			return fmt.Errorf("%s block %q: %w", typ, strings.Join(labels, "."), err)
+		}
+		return nil
+	}
+}
+
+func jsonAttributeTokens(name string, value jsonValue, schema jsonBlockSchema) (hclwrite.Tokens, error) {
+	if slices.Contains(schema.traversals, name) && value.isArray {
+		elems := make([]hclwrite.Tokens, len(value.array))
+		for i, elem := range value.array {
+			ref, ok := elem.scalar.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s element %d must be a string", name, i)
+			}
+			traversal, diags := hclsyntax.ParseTraversalAbs([]byte(ref), name, hcl.InitialPos)
+			if diags.HasErrors() {
+				return nil, fmt.Errorf("%s element %d: %w", name, i, diags)
+			}
+			elems[i] = hclwrite.TokensForTraversal(traversal)
+		}
+		return hclwrite.TokensForTuple(elems), nil
+	}
+	if expr, ok := value.scalar.(string); ok && slices.Contains(schema.expressions, name) {
+		if _, diags := hclsyntax.ParseExpression([]byte(expr), name, hcl.InitialPos); diags.HasErrors() {
+			return nil, fmt.Errorf("%s: %w", name, diags)
+		}
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(expr)}}, nil
+	}
+	return jsonExpressionTokens(value), nil
+}
+
+// jsonExpressionTokens writes a JSON expression in the native syntax.
+// Strings, including object keys, are templates in both syntaxes.
+func jsonExpressionTokens(value jsonValue) hclwrite.Tokens {
+	switch {
+	case value.isObject:
+		attrs := make([]hclwrite.ObjectAttrTokens, len(value.object))
+		for i, prop := range value.object {
+			attrs[i] = hclwrite.ObjectAttrTokens{Name: templateTokens(prop.name), Value: jsonExpressionTokens(prop.value)}
+		}
+		return hclwrite.TokensForObject(attrs)
+	case value.isArray:
+		elems := make([]hclwrite.Tokens, len(value.array))
+		for i, elem := range value.array {
+			elems[i] = jsonExpressionTokens(elem)
+		}
+		return hclwrite.TokensForTuple(elems)
+	}
+	switch scalar := value.scalar.(type) {
+	case string:
+		return templateTokens(scalar)
+	case json.Number:
+		return hclwrite.Tokens{{Type: hclsyntax.TokenNumberLit, Bytes: []byte(scalar)}}
+	case bool:
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: fmt.Appendf(nil, "%t", scalar)}}
+	default:
+		return hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte("null")}}
+	}
+}
+
+// templateTokens quotes s as a native template. Literal text is escaped
+// where a quoted template cannot hold it; interpolations and directives, and
+// their $${ and %%{ escapes, are kept as written.
+func templateTokens(s string) hclwrite.Tokens {
+	src := []byte(s)
+	var b strings.Builder
+	offset := 0
+	// The JSON parser has already accepted s as a template.
+	tokens, _ := hclsyntax.LexTemplate(src, "", hcl.InitialPos)
+	for _, tok := range tokens {
+		if tok.Type == hclsyntax.TokenEOF {
+			break
+		}
+		b.Write(src[offset:tok.Range.Start.Byte])
+		if tok.Type == hclsyntax.TokenStringLit {
+			escapeTemplateLiteral(&b, string(tok.Bytes))
+		} else {
+			b.Write(tok.Bytes)
+		}
+		offset = tok.Range.End.Byte
+	}
+	b.Write(src[offset:])
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(b.String())},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+}
+
+func escapeTemplateLiteral(b *strings.Builder, s string) {
+	for _, r := range s {
+		switch r {
+		case '\\':
+			b.WriteString(`\\`)
+		case '"':
+			b.WriteString(`\"`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(b, `\u%04x`, r)
+				continue
+			}
+			b.WriteRune(r)
+		}
+	}
+}
+
+// writeJSONConfigVariables writes vars into the variables property of the
+// config.uds.json src and writes the result to path, keeping the other
+// properties, and the values of other variables, as written.
+func writeJSONConfigVariables(path string, src []byte, vars Variables) error {
+	root := jsonValue{isObject: true}
+	if len(src) > 0 {
+		var err error
+		if root, err = decodeOrderedJSON(src); err != nil {
+			return fmt.Errorf("%w %q: %w", ErrParseConfig, path, err)
+		}
+		if !root.isObject {
+			return fmt.Errorf("%w %q: config must be a JSON object", ErrParseConfig, path)
+		}
+	}
+	i := slices.IndexFunc(root.object, func(prop jsonProperty) bool { return prop.name == "variables" })
+	if i >= 0 {
+		if err := mergeJSONVariables(&root.object[i].value, "variables", vars); err != nil {
+			return fmt.Errorf("%w %q: %w", ErrMergeConfigVariables, path, err)
+		}
+	} else {
+		root.object = append(root.object, jsonProperty{name: "variables", value: jsonValueOf(vars)})
+	}
+
+	compact, err := appendJSON(nil, root)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrConvertVariables, err)
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, compact, "", "  "); err != nil {
+		return fmt.Errorf("%w: %w", ErrConvertVariables, err)
+	}
+	out.WriteByte('\n')
+	if err := os.WriteFile(path, out.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrWriteConfigFile, path, err)
+	}
+	return nil
+}
+
+// mergeJSONVariables writes vars into the object value. Existing scalar
+// properties are replaced, nested objects are merged recursively and missing
+// properties are appended.
+func mergeJSONVariables(value *jsonValue, name string, vars Variables) error {
+	if !value.isObject {
+		return fmt.Errorf("%s is not written as an object", name)
+	}
+	for _, key := range slices.Sorted(maps.Keys(vars)) {
+		i := slices.IndexFunc(value.object, func(prop jsonProperty) bool { return prop.name == key })
+		if i < 0 {
+			value.object = append(value.object, jsonProperty{name: key, value: jsonValueOf(vars[key])})
+			continue
+		}
+		if nested, ok := vars[key].(Variables); ok {
+			if err := mergeJSONVariables(&value.object[i].value, name+"."+key, nested); err != nil {
+				return err
+			}
+			continue
+		}
+		value.object[i].value = jsonValueOf(vars[key])
+	}
+	return nil
+}
+
+// jsonValueOf converts variables to JSON. Strings are escaped so they are
+// not evaluated as templates when the file is parsed again.
+func jsonValueOf(value any) jsonValue {
+	switch v := value.(type) {
+	case Variables:
+		out := jsonValue{isObject: true}
+		for _, k := range slices.Sorted(maps.Keys(v)) {
+			out.object = append(out.object, jsonProperty{name: k, value: jsonValueOf(v[k])})
+		}
+		return out
+	case []any:
+		out := jsonValue{isArray: true}
+		for _, elem := range v {
+			out.array = append(out.array, jsonValueOf(elem))
+		}
+		return out
+	case string:
+		return jsonValue{scalar: strings.NewReplacer("${", "$${", "%{", "%%{").Replace(v)}
+	default:
+		return jsonValue{scalar: v}
+	}
+}
+
+func appendJSON(buf []byte, value jsonValue) ([]byte, error) {
+	switch {
+	case value.isObject:
+		buf = append(buf, '{')
+		for i, prop := range value.object {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			name, err := json.Marshal(prop.name)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(append(buf, name...), ':')
+			if buf, err = appendJSON(buf, prop.value); err != nil {
+				return nil, err
+			}
+		}
+		return append(buf, '}'), nil
+	case value.isArray:
+		buf = append(buf, '[')
+		for i, elem := range value.array {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			var err error
+			if buf, err = appendJSON(buf, elem); err != nil {
+				return nil, err
+			}
+		}
+		return append(buf, ']'), nil
+	default:
+		scalar, err := json.Marshal(value.scalar)
+		if err != nil {
+			return nil, err
+		}
+		return append(buf, scalar...), nil
+	}
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const jsonBundle = `{
+  "//": "generated",
+  "uds": {"bundle_api_version": "uds.dev/v1alpha1"},
+  "metadata": {"name": "tenants", "version": "1.0.0"},
+  "locals": {
+    "tenants": ["blue", "red"],
+    "namespace": "${sys.arch}-tenants"
+  },
+  "package": {
+    "core": {
+      "source": "oci://ghcr.io/uds/core:1.0.0",
+      "values_files": ["${file(\"values.txt\")}.yaml"],
+      "signature_verification": {"keyless": {"certificate_identity": "ci@uds.dev"}}
+    },
+    "tenant": {
+      "for_each": "${local.tenants}",
+      "source": "oci://ghcr.io/uds/tenant:1.0.0",
+      "namespace": "${local.namespace}-${each.key}",
+      "depends_on": ["package.core"]
+    },
+    "app": {
+      "source": "oci://ghcr.io/uds/app:1.0.0",
+      "depends_on": ["package.tenant[\"red\"]"]
+    }
+  }
+}
+`
+
+const nativeBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "tenants"
+  version = "1.0.0"
+}
+locals {
+  tenants   = ["blue", "red"]
+  namespace = "${sys.arch}-tenants"
+}
+package "core" {
+  source       = "oci://ghcr.io/uds/core:1.0.0"
+  values_files = ["${file("values.txt")}.yaml"]
+  signature_verification {
+    keyless {
+      certificate_identity = "ci@uds.dev"
+    }
+  }
+}
+package "tenant" {
+  for_each   = local.tenants
+  source     = "oci://ghcr.io/uds/tenant:1.0.0"
+  namespace  = "${local.namespace}-${each.key}"
+  depends_on = [package.core]
+}
+package "app" {
+  source     = "oci://ghcr.io/uds/app:1.0.0"
+  depends_on = [package.tenant["red"]]
+}
+`
+
+func TestParseBundleFile_JSONSyntax(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values.txt"), []byte("core"), 0o600))
+	jsonPath := filepath.Join(dir, BundleJSONFileName)
+	require.NoError(t, os.WriteFile(jsonPath, []byte(jsonBundle), 0o600))
+	nativePath := filepath.Join(dir, BundleFileName)
+	require.NoError(t, os.WriteFile(nativePath, []byte(nativeBundle), 0o600))
+
+	parser := NewHCLParser("amd64", iostreams.IOStreams{})
+	want, err := parser.ParseBundleFile(t.Context(), nativePath)
+	require.NoError(t, err)
+	got, err := parser.ParseBundleFile(t.Context(), jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	require.Len(t, got.Packages, 4)
+	assert.Equal(t, []string{"core.yaml"}, got.Packages[0].ValuesFiles)
+	assert.Equal(t, "amd64-tenants-red", got.Packages[2].Namespace)
+
+	parsed, materialized, err := parser.ParseAndMaterializeBundleFile(t.Context(), jsonPath)
+	require.NoError(t, err)
+	assert.Equal(t, want, parsed)
+	assert.Contains(t, string(materialized), `values_files = ["core.yaml"]`)
+	assert.Contains(t, string(materialized), `depends_on = [package.tenant["red"]]`)
+	fromArtifact, err := parser.ParseBundleBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	assert.Equal(t, want, fromArtifact)
+}
+
+func TestParseDefaults_JSONSyntax(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "domain.txt"), []byte("uds.dev"), 0o600))
+	path := filepath.Join(dir, BundleDefaultsJSONFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "variables": {"domain": "${file(\"domain.txt\")}", "sso": {"enabled": true}, "literal": "$${keep}"},
+  "variable": {
+    "hosts": {"description": "Ingress hosts", "type": "list(string)"},
+    "sso.client_secret": {"sensitive": true}
+  }
+}`), 0o600))
+
+	vars, err := ParseDefaults(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, Variables{"domain": "uds.dev", "sso": Variables{"enabled": true}, "literal": "${keep}"}, vars)
+	declarations, err := ParseVariableDeclarations(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, []VariableDeclaration{
+		{Name: "hosts", Description: "Ingress hosts", Type: cty.List(cty.String)},
+		{Name: "sso.client_secret", Type: cty.String, Sensitive: true},
+	}, declarations)
+
+	materialized, err := MaterializeDefaultsFile(path)
+	require.NoError(t, err)
+	fromArtifact, err := ParseDefaultsBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	assert.Equal(t, vars, fromArtifact)
+	artifactDeclarations, err := ParseVariableDeclarationsBytes(t.Context(), materialized)
+	require.NoError(t, err)
+	assert.Equal(t, declarations, artifactDeclarations)
+}
+
+func TestParseBundleConfig_JSONSyntax(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigJSONFileName)
+	require.NoError(t, os.WriteFile(path, []byte(`{
+  "options": {"log_level": "debug", "concurrency": 2},
+  "signature_verification": {"signer": {"release": {"public_key": "cosign.pub"}}},
+  "variables": {"domain": "uds.dev"}
+}`), 0o600))
+
+	cfg, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleConfig(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", cfg.Options.LogLevel)
+	assert.Equal(t, 2, cfg.Options.Concurrency)
+	require.Len(t, cfg.SignatureVerification.Signers, 1)
+	assert.Equal(t, "release", cfg.SignatureVerification.Signers[0].Name)
+	assert.Equal(t, Variables{"domain": "uds.dev"}, cfg.Variables)
+
+	require.NoError(t, WriteConfigVariables(t.Context(), path, Variables{"log": Variables{"format": "${json}"}}))
+	cfg, err = NewHCLParser("", iostreams.IOStreams{}).ParseBundleConfig(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", cfg.Options.LogLevel)
+	assert.Equal(t, Variables{"domain": "uds.dev", "log": Variables{"format": "${json}"}}, cfg.Variables)
+	src, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Regexp(t, `(?s)"options".*"signature_verification".*"variables"`, string(src))
+}
+
+func TestNativeFromJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "not an object", src: `[]`},
+		{name: "invalid json", src: `{"uds": `},
+		{name: "trailing content", src: `{} {}`},
+		{name: "block is not an object", src: `{"metadata": "tenants"}`},
+		{name: "invalid attribute name", src: `{"metadata": {"bad name": "x"}}`},
+		{name: "invalid reference", src: `{"package": {"app": {"depends_on": ["package."]}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := nativeFromJSON([]byte(tt.src), BundleJSONFileName, bundleJSONSchema)
+			require.ErrorIs(t, err, ErrConvertJSONSyntax)
+		})
+	}
+}
+
+func TestResolveBundlePath_JSON(t *testing.T) {
+	dir := t.TempDir()
+	assert.Equal(t, filepath.Join(dir, BundleFileName), ResolveBundlePath(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleJSONFileName), []byte("{}"), 0o600))
+	assert.Equal(t, filepath.Join(dir, BundleJSONFileName), ResolveBundlePath(dir))
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleFileName), nil, 0o600))
+	assert.Equal(t, filepath.Join(dir, BundleFileName), ResolveBundlePath(dir))
+}
+
+func TestAdjacentDefaultsPath_JSON(t *testing.T) {
+	dir := t.TempDir()
+	path, err := AdjacentDefaultsPath(dir)
+	require.NoError(t, err)
+	assert.Empty(t, path)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleDefaultsJSONFileName), []byte("{}"), 0o600))
+	path, err = AdjacentDefaultsPath(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, BundleDefaultsJSONFileName), path)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleDefaultsFileName), nil, 0o600))
+	path, err = AdjacentDefaultsPath(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, BundleDefaultsFileName), path)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ConfigJSONFileName), []byte("{}"), 0o600))
+	path, err = AdjacentConfigPath(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, ConfigJSONFileName), path)
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// BundleLockFileName is the name of the lock file written next to the bundle
+// definition.
+const BundleLockFileName = "bundle.lock.hcl"
+
+// lockFilePerm makes the lock file readable by everyone, like the bundle
+// definition it is committed alongside.
+const lockFilePerm os.FileMode = 0o644
+
+const lockFileHeader = "# This file is maintained by \"uds bundle create\" and \"uds bundle update\".\n# Commit it to keep package versions stable between builds.\n\n"
+
+// Lock pins OCI package sources to the tag and manifest digest they resolved
+// to, so that later builds use the same package content.
+type Lock struct {
+	Packages []LockedPackage `hcl:"package,block"`
+}
+
+// LockedPackage is one package block in bundle.lock.hcl. Source is the
+// source as written in the bundle definition; the lock entry only applies
+// while the two match.
+type LockedPackage struct {
+	Name    string `hcl:"name,label"`
+	Source  string `hcl:"source"`
+	Version string `hcl:"version,optional"`
+	Digest  string `hcl:"digest"`
+}
+
+// Package returns the lock entry for the named package.
+func (l *Lock) Package(name string) (LockedPackage, bool) {
+	if l == nil {
+		return LockedPackage{}, false
+	}
+	for _, pkg := range l.Packages {
+		if pkg.Name == name {
+			return pkg, true
+		}
+	}
+	return LockedPackage{}, false
+}
+
+// LockPath returns the lock file path for the bundle definition in bundleDir.
+func LockPath(bundleDir string) string {
+	return filepath.Join(bundleDir, BundleLockFileName)
+}
+
+// ReadLock reads the lock file at path. A missing file is an empty lock.
+func ReadLock(path string) (*Lock, error) {
+	src, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &Lock{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrReadLockFile, path, err)
+	}
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseLockFile, path, diags)
+	}
+	lock := &Lock{}
+	if diags := gohcl.DecodeBody(file.Body, nil, lock); diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseLockFile, path, diags)
+	}
+	seen := make(map[string]bool, len(lock.Packages))
+	for _, pkg := range lock.Packages {
+		if seen[pkg.Name] {
+			return nil, fmt.Errorf("%w %q: package %q is locked more than once", ErrParseLockFile, path, pkg.Name)
+		}
+		seen[pkg.Name] = true
+	}
+	return lock, nil
+}
+
+// WriteLock writes lock to path, replacing any existing file.
+func WriteLock(path string, lock *Lock) error {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	for i, pkg := range lock.Packages {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		block := body.AppendNewBlock("package", []string{pkg.Name}).Body()
+		block.SetAttributeValue("source", cty.StringVal(pkg.Source))
+		if pkg.Version != "" {
+			block.SetAttributeValue("version", cty.StringVal(pkg.Version))
+		}
+		block.SetAttributeValue("digest", cty.StringVal(pkg.Digest))
+	}
+	var buf bytes.Buffer
+	buf.WriteString(lockFileHeader)
+	buf.Write(file.Bytes())
+	if err := os.WriteFile(path, buf.Bytes(), lockFilePerm); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrWriteLockFile, path, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockRoundTrip(t *testing.T) {
+	path := LockPath(t.TempDir())
+	lock := &Lock{Packages: []LockedPackage{
+		{Name: "core", Source: "oci://ghcr.io/uds/core:~0.40", Version: "0.40.2", Digest: "sha256:" + strings.Repeat("a", 64)},
+		{Name: "pinned", Source: "oci://ghcr.io/uds/app@sha256:" + strings.Repeat("b", 64), Digest: "sha256:" + strings.Repeat("b", 64)},
+	}}
+	require.NoError(t, WriteLock(path, lock))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `package "core" {`)
+	assert.Contains(t, string(data), `version = "0.40.2"`)
+	assert.NotContains(t, string(data), `version = ""`)
+
+	read, err := ReadLock(path)
+	require.NoError(t, err)
+	assert.Equal(t, lock, read)
+	core, ok := read.Package("core")
+	require.True(t, ok)
+	assert.Equal(t, "0.40.2", core.Version)
+}
+
+func TestReadLock(t *testing.T) {
+	dir := t.TempDir()
+
+	lock, err := ReadLock(filepath.Join(dir, BundleLockFileName))
+	require.NoError(t, err)
+	assert.Empty(t, lock.Packages)
+
+	duplicate := filepath.Join(dir, "duplicate.hcl")
+	require.NoError(t, os.WriteFile(duplicate, []byte(`
+package "core" {
+  source = "oci://ghcr.io/uds/core:~0.40"
+  digest = "sha256:aa"
+}
+package "core" {
+  source = "oci://ghcr.io/uds/core:~0.41"
+  digest = "sha256:bb"
+}
+`), 0o600))
+	_, err = ReadLock(duplicate)
+	require.ErrorIs(t, err, ErrParseLockFile)
+	assert.ErrorContains(t, err, `package "core" is locked more than once`)
+
+	missingDigest := filepath.Join(dir, "missing.hcl")
+	require.NoError(t, os.WriteFile(missingDigest, []byte("package \"core\" {\n  source = \"oci://ghcr.io/uds/core:~0.40\"\n}\n"), 0o600))
+	_, err = ReadLock(missingDigest)
+	require.ErrorIs(t, err, ErrParseLockFile)
+}
@@ -9,8 +9,9 @@ import (
 )
 
 // ResolveBundlePath resolves a user-provided bundle reference to the path of
-// the bundle.uds.hcl file. If ref is a directory, the bundle file inside it is
-// returned; otherwise ref is returned as-is.
+// the bundle definition file. If ref is a directory, its bundle.uds.hcl is
+// returned, or its bundle.uds.json when only that exists; otherwise ref is
+// returned as-is.
 //
 // Assumes the path has already been validated with ValidateBundlePath.
 func ResolveBundlePath(ref string) string {
@@ -19,7 +20,18 @@ func ResolveBundlePath(ref string) string {
 		return ref
 	}
 	if info.IsDir() {
+		jsonPath := filepath.Join(ref, BundleJSONFileName)
+		if _, err := os.Stat(filepath.Join(ref, BundleFileName)); os.IsNotExist(err) {
+			if _, err := os.Stat(jsonPath); err == nil {
+				return jsonPath
+			}
+		}
 		return filepath.Join(ref, BundleFileName)
 	}
 	return ref
 }
+
+// IsBundleFileName reports whether name is bundle.uds.hcl or bundle.uds.json.
+func IsBundleFileName(name string) bool {
+	return name == BundleFileName || name == BundleJSONFileName
+}
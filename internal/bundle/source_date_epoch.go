@@ -0,0 +1,42 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// SourceDateEpochEnv names the environment variable that pins the timestamps
+// recorded in bundle archives, following reproducible-builds.org.
+const SourceDateEpochEnv = "SOURCE_DATE_EPOCH"
+
+// SourceDateEpoch returns the time set by SOURCE_DATE_EPOCH in seconds since
+// the Unix epoch. ok is false when the variable is unset or empty.
+func SourceDateEpoch() (t time.Time, ok bool, err error) {
+	raw := os.Getenv(SourceDateEpochEnv)
+	if raw == "" {
+		return time.Time{}, false, nil
+	}
+	secs, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || secs < 0 {
+		return time.Time{}, false, fmt.Errorf("%w: %s=%q", ErrInvalidSourceDateEpoch, SourceDateEpochEnv, raw)
+	}
+	return time.Unix(secs, 0).UTC(), true, nil
+}
+
+// ArchiveTime returns the timestamp recorded in bundle archive headers and
+// annotations: SOURCE_DATE_EPOCH when set, otherwise the Unix epoch.
+func ArchiveTime() (time.Time, error) {
+	t, ok, err := SourceDateEpoch()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !ok {
+		return time.Unix(0, 0).UTC(), nil
+	}
+	return t, nil
+}
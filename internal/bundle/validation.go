@@ -55,6 +55,9 @@ func validateOptions(opts *ConfigOptions) error {
 	if err := validateConcurrency(opts.Concurrency); err != nil {
 		return err
 	}
+	if err := validateChunkConcurrency(opts.ChunkConcurrency); err != nil {
+		return err
+	}
 	return validateTmpDir(opts.TmpDir)
 }
 
@@ -69,6 +72,18 @@ func validateConcurrency(concurrency int) error {
 	return nil
 }
 
+// validateChunkConcurrency enforces the [0, MaxConcurrency] range; zero means
+// blobs are not split into ranges.
+func validateChunkConcurrency(concurrency int) error {
+	if concurrency < 0 {
+		return fmt.Errorf("chunk concurrency must be >= 0, got %d: %w", concurrency, ErrInvalidConcurrency)
+	}
+	if concurrency > MaxConcurrency {
+		return fmt.Errorf("chunk concurrency must be <= %d, got %d: %w", MaxConcurrency, concurrency, ErrInvalidConcurrency)
+	}
+	return nil
+}
+
 // validateTmpDir asserts that, when set, TmpDir refers to an existing directory.
 // An empty value is valid and means "use the OS default".
 func validateTmpDir(path string) error {
@@ -114,6 +114,33 @@ func TestValidateConcurrency(t *testing.T) {
 	}
 }
 
+func TestValidateChunkConcurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		wantErr     string
+	}{
+		{name: "negative rejected", concurrency: -1, wantErr: "chunk concurrency must be >= 0"},
+		{name: "above max rejected", concurrency: MaxConcurrency + 1, wantErr: fmt.Sprintf("chunk concurrency must be <= %d", MaxConcurrency)},
+		{name: "zero accepted", concurrency: 0},
+		{name: "upper bound accepted", concurrency: MaxConcurrency},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validBaseConfig()
+			cfg.Options.ChunkConcurrency = tt.concurrency
+			err := ValidateConfig(cfg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidConcurrency)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
 func TestValidateTmpDir(t *testing.T) {
 	t.Run("empty allowed", func(t *testing.T) {
 		cfg := validBaseConfig()
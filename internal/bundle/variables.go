@@ -0,0 +1,310 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+)
+
+// VariableDeclaration describes a variable declared by a variable block in
+// defaults.uds.hcl:
+//
+//	variable "sso.client_secret" {
+//	  description = "Client secret for the SSO provider"
+//	  type        = string
+//	  sensitive   = true
+//	}
+//
+// Declarations carry no value; they describe variables a deployer must
+// provide through config.uds.hcl or --prompt.
+type VariableDeclaration struct {
+	// Name is the dotted path of the variable below vars.
+	Name        string
+	Description string
+	// Type is the type answers are converted to. It defaults to string.
+	Type      cty.Type
+	Sensitive bool
+}
+
+type decodedVariableDeclaration struct {
+	Name        string         `hcl:"name,label"`
+	Description string         `hcl:"description,optional"`
+	Type        hcl.Expression `hcl:"type,optional"`
+	Sensitive   bool           `hcl:"sensitive,optional"`
+}
+
+var defaultsSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{{Name: "variables"}},
+	Blocks:     []hcl.BlockHeaderSchema{{Type: "variable", LabelNames: []string{"name"}}},
+}
+
+// ParseVariableDeclarations reads the variable blocks of a defaults file.
+// The context parameter is currently unused as none of the HCL parsing methods supports cancellation.
+func ParseVariableDeclarations(_ context.Context, path string) ([]VariableDeclaration, error) {
+	if path == "" {
+		return nil, EmptyParameterError{Name: "path"}
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrReadDefaultsFile, path, err)
+	}
+	_, declarations, err := parseDefaultsDocument(src, path, true)
+	return declarations, err
+}
+
+// ParseVariableDeclarationsBytes reads the variable blocks of defaults HCL
+// without enabling file-backed expressions.
+func ParseVariableDeclarationsBytes(_ context.Context, src []byte) ([]VariableDeclaration, error) {
+	if len(src) == 0 {
+		return nil, EmptyParameterError{Name: "src"}
+	}
+	_, declarations, err := parseDefaultsDocument(src, BundleDefaultsFileName, false)
+	return declarations, err
+}
+
+// decodeVariableDeclarations decodes variable blocks in file order. Names
+// must be dotted identifier paths and may only be declared once.
+func decodeVariableDeclarations(blocks hcl.Blocks, path string) ([]VariableDeclaration, error) {
+	var declarations []VariableDeclaration
+	seen := map[string]bool{}
+	for _, block := range blocks {
+		var decoded decodedVariableDeclaration
+		if diags := gohcl.DecodeBody(block.Body, nil, &decoded); diags.HasErrors() {
+			return nil, fmt.Errorf("%w %q: variable %q: %w", ErrInvalidDefaults, path, block.Labels[0], diags)
+		}
+		decoded.Name = block.Labels[0]
+		if !validVariablePath(decoded.Name) {
+			return nil, fmt.Errorf("%w %q: variable %q at %s: name must be a dotted path of letters, digits and underscores", ErrInvalidDefaults, path, decoded.Name, block.DefRange)
+		}
+		if seen[decoded.Name] {
+			return nil, fmt.Errorf("%w %q: variable %q at %s is declared more than once", ErrInvalidDefaults, path, decoded.Name, block.DefRange)
+		}
+		seen[decoded.Name] = true
+
+		ty := cty.String
+		if value, diags := decoded.Type.Value(nil); diags.HasErrors() || !value.IsNull() {
+			var typeDiags hcl.Diagnostics
+			if ty, typeDiags = typeexpr.Type(decoded.Type); typeDiags.HasErrors() {
+				return nil, fmt.Errorf("%w %q: variable %q: %w", ErrInvalidDefaults, path, decoded.Name, typeDiags)
+			}
+		}
+		declarations = append(declarations, VariableDeclaration{Name: decoded.Name, Description: decoded.Description, Type: ty, Sensitive: decoded.Sensitive})
+	}
+	return declarations, nil
+}
+
+// variablePathPattern matches the dotted paths values file templates can
+// read as .vars.<path>.
+var variablePathPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+func validVariablePath(path string) bool {
+	return variablePathPattern.MatchString(path)
+}
+
+// ParseVariableType parses a type written in HCL, such as string or
+// list(string). An empty type is string.
+func ParseVariableType(typ string) (cty.Type, error) {
+	if typ == "" {
+		return cty.String, nil
+	}
+	expr, diags := hclsyntax.ParseExpression([]byte(typ), "type", hcl.InitialPos)
+	if diags.HasErrors() {
+		return cty.NilType, fmt.Errorf("%w: type %q: %w", ErrInvalidVariables, typ, diags)
+	}
+	ty, diags := typeexpr.Type(expr)
+	if diags.HasErrors() {
+		return cty.NilType, fmt.Errorf("%w: type %q: %w", ErrInvalidVariables, typ, diags)
+	}
+	return ty, nil
+}
+
+// TypeString returns the declared type as it is written in HCL.
+func (d VariableDeclaration) TypeString() string {
+	return typeexpr.TypeString(d.Type)
+}
+
+// ParseValue converts an answer to the declared type. String variables take
+// the answer verbatim; other types parse it as an HCL expression, such as
+// true, 3 or ["a", "b"].
+func (d VariableDeclaration) ParseValue(input string) (any, error) {
+	if d.Type == cty.String {
+		return input, nil
+	}
+	expr, diags := hclsyntax.ParseExpression([]byte(input), d.Name, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidVariables, d.Name, diags)
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrInvalidVariables, d.Name, diags)
+	}
+	value, err := convert.Convert(value, d.Type)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: expected %s: %w", ErrInvalidVariables, d.Name, d.TypeString(), err)
+	}
+	if value.Type().IsMapType() {
+		value = cty.ObjectVal(value.AsValueMap())
+	}
+	return ctyValueToGo(value)
+}
+
+// Defined walks path through v. A path that continues past a scalar or list
+// is treated as defined; the template decides how to index it.
+func (v Variables) Defined(path string) bool {
+	current := v
+	for _, part := range strings.Split(path, ".") {
+		value, ok := current[part]
+		if !ok {
+			return false
+		}
+		nested, ok := value.(Variables)
+		if !ok {
+			return true
+		}
+		current = nested
+	}
+	return true
+}
+
+// WriteConfigVariables adds vars to the variables of the config file at path,
+// creating it when it does not exist. Only the values of vars are written:
+// other attributes and blocks, and the expressions of other variables such as
+// file() calls or local references, are kept as written. A path ending in
+// .json is written in HCL's JSON syntax.
+func WriteConfigVariables(_ context.Context, path string, vars Variables) error {
+	src, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("%w %q: %w", ErrReadConfigFile, path, err)
+	}
+	if isJSONSyntax(path) {
+		return writeJSONConfigVariables(path, src, vars)
+	}
+
+	out, err := mergeConfigVariables(src, path, vars)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, hclwrite.Format(out), 0o600); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrWriteConfigFile, path, err)
+	}
+	return nil
+}
+
+// configEdit replaces src[start:end] with text.
+type configEdit struct {
+	start, end int
+	text       []byte
+}
+
+// mergeConfigVariables returns the config src with vars written into its
+// variables attribute. The attribute is edited in place rather than
+// evaluated, so only the object items named by vars change.
+func mergeConfigVariables(src []byte, path string, vars Variables) ([]byte, error) {
+	file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseConfig, path, diags)
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("%w %q: unexpected body type %T", ErrParseConfig, path, file.Body)
+	}
+	attr, ok := body.Attributes["variables"]
+	if !ok {
+		wf, diags := hclwrite.ParseConfig(src, path, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("%w %q: %w", ErrParseConfig, path, diags)
+		}
+		value, err := variablesToCty(vars)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrConvertVariables, err)
+		}
+		wf.Body().SetAttributeValue("variables", value)
+		return wf.Bytes(), nil
+	}
+
+	var edits []configEdit
+	if err := variableEdits(src, attr.Expr, "variables", vars, &edits); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrMergeConfigVariables, path, err)
+	}
+	// Edits never overlap, so applying them from the end keeps the offsets
+	// of the earlier ones valid.
+	slices.SortFunc(edits, func(a, b configEdit) int { return b.start - a.start })
+	out := slices.Clone(src)
+	for _, edit := range edits {
+		out = slices.Concat(out[:edit.start], edit.text, out[edit.end:])
+	}
+	return out, nil
+}
+
+// variableEdits collects the edits writing vars into the object constructor
+// expr. Existing scalar items are replaced, nested objects are edited
+// recursively and missing items are added before the closing brace.
+func variableEdits(src []byte, expr hclsyntax.Expression, name string, vars Variables, edits *[]configEdit) error {
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return fmt.Errorf("%s is not written as an object", name)
+	}
+	items := make(map[string]hclsyntax.ObjectConsItem, len(obj.Items))
+	for _, item := range obj.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || !key.IsKnown() || key.IsNull() || key.Type() != cty.String {
+			continue
+		}
+		items[key.AsString()] = item
+	}
+
+	var added []byte
+	for _, key := range slices.Sorted(maps.Keys(vars)) {
+		value := vars[key]
+		item, exists := items[key]
+		if nested, ok := value.(Variables); ok && exists {
+			if err := variableEdits(src, item.ValueExpr, name+"."+key, nested, edits); err != nil {
+				return err
+			}
+			continue
+		}
+		converted, err := variablesToCty(value)
+		if err != nil {
+			return fmt.Errorf("%w: %s.%s: %w", ErrConvertVariables, name, key, err)
+		}
+		tokens := hclwrite.TokensForValue(converted).Bytes()
+		if exists {
+			rng := item.ValueExpr.Range()
+			*edits = append(*edits, configEdit{start: rng.Start.Byte, end: rng.End.Byte, text: tokens})
+			continue
+		}
+		itemKey := []byte(key)
+		if !hclsyntax.ValidIdentifier(key) {
+			itemKey = hclwrite.TokensForValue(cty.StringVal(key)).Bytes()
+		}
+		added = fmt.Appendf(added, "%s = %s\n", itemKey, tokens)
+	}
+	if len(added) > 0 {
+		// Items go on their own lines before the closing brace.
+		at := obj.SrcRange.End.Byte - 1
+		line := bytes.TrimRight(src[:at], " \t")
+		if bytes.HasSuffix(line, []byte("\n")) {
+			at = len(line)
+		} else {
+			added = append([]byte("\n"), added...)
+		}
+		*edits = append(*edits, configEdit{start: at, end: at, text: added})
+	}
+	return nil
+}
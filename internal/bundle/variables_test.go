@@ -0,0 +1,151 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestParseVariableDeclarationsBytes(t *testing.T) {
+	src := []byte(`variables = {
+  domain = "uds.dev"
+}
+variable "domain" {
+  description = "Base domain"
+}
+variable "sso.client_secret" {
+  description = "SSO client secret"
+  sensitive   = true
+}
+variable "replicas" {
+  type = number
+}
+variable "hosts" {
+  type = list(string)
+}
+`)
+	declarations, err := ParseVariableDeclarationsBytes(t.Context(), src)
+	require.NoError(t, err)
+	assert.Equal(t, []VariableDeclaration{
+		{Name: "domain", Description: "Base domain", Type: cty.String},
+		{Name: "sso.client_secret", Description: "SSO client secret", Type: cty.String, Sensitive: true},
+		{Name: "replicas", Type: cty.Number},
+		{Name: "hosts", Type: cty.List(cty.String)},
+	}, declarations)
+
+	vars, err := ParseDefaultsBytes(t.Context(), src)
+	require.NoError(t, err)
+	assert.Equal(t, Variables{"domain": "uds.dev"}, vars)
+}
+
+func TestParseVariableDeclarationsBytes_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{name: "invalid name", src: `variable "sso-secret" {}`},
+		{name: "duplicate", src: "variable \"domain\" {}\nvariable \"domain\" {}"},
+		{name: "unknown type", src: `variable "domain" { type = text }`},
+		{name: "unknown attribute", src: `variable "domain" { default = "uds.dev" }`},
+		{name: "other block", src: `options { architecture = "amd64" }`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseVariableDeclarationsBytes(t.Context(), []byte(tt.src))
+			require.ErrorIs(t, err, ErrInvalidDefaults)
+		})
+	}
+}
+
+func TestVariableDeclarationParseValue(t *testing.T) {
+	tests := []struct {
+		typ     string
+		input   string
+		want    any
+		wantErr bool
+	}{
+		{typ: "", input: "a b \"c\"", want: "a b \"c\""},
+		{typ: "number", input: "3", want: float64(3)},
+		{typ: "bool", input: "true", want: true},
+		{typ: "list(string)", input: `["a", "b"]`, want: []any{"a", "b"}},
+		{typ: "map(string)", input: `{ a = "b" }`, want: Variables{"a": "b"}},
+		{typ: "number", input: "three", wantErr: true},
+		{typ: "bool", input: "[", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.typ+"/"+tt.input, func(t *testing.T) {
+			ty, err := ParseVariableType(tt.typ)
+			require.NoError(t, err)
+			got, err := VariableDeclaration{Name: "v", Type: ty}.ParseValue(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidVariables)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestVariablesDefined(t *testing.T) {
+	vars := Variables{"domain": "uds.dev", "sso": Variables{"enabled": true}, "hosts": []any{"a"}}
+	assert.True(t, vars.Defined("domain"))
+	assert.True(t, vars.Defined("sso"))
+	assert.True(t, vars.Defined("sso.enabled"))
+	assert.True(t, vars.Defined("hosts.0"))
+	assert.False(t, vars.Defined("sso.client_secret"))
+	assert.False(t, Variables(nil).Defined("domain"))
+}
+
+func TestWriteConfigVariables(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ConfigFileName)
+	require.NoError(t, WriteConfigVariables(t.Context(), path, Variables{"domain": "uds.dev"}))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	require.NoError(t, os.WriteFile(path, []byte("options {\n  log_level = \"debug\"\n}\n\nvariables = {\n  domain = \"example.com\"\n  log    = { level = \"info\" }\n}\n"), 0o600))
+	require.NoError(t, WriteConfigVariables(t.Context(), path, Variables{"domain": "uds.dev", "log": Variables{"format": "json"}}))
+
+	cfg, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleConfig(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", cfg.Options.LogLevel)
+	assert.Equal(t, Variables{"domain": "uds.dev", "log": Variables{"level": "info", "format": "json"}}, cfg.Variables)
+}
+
+func TestWriteConfigVariables_KeepsExpressions(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, ConfigFileName)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "ca.pem"), []byte("CERTIFICATE"), 0o600))
+	require.NoError(t, os.WriteFile(path, []byte("variables = {\n  ca  = file(\"ca.pem\")\n  sso = { issuer = \"https://sso.uds.dev\" }\n}\n"), 0o600))
+
+	require.NoError(t, WriteConfigVariables(t.Context(), path, Variables{
+		"domain": "${uds.dev}",
+		"sso":    Variables{"client_id": "uds"},
+	}))
+
+	src, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(src), `file("ca.pem")`)
+	assert.NotContains(t, string(src), "CERTIFICATE")
+
+	cfg, err := NewHCLParser("", iostreams.IOStreams{}).ParseBundleConfig(t.Context(), path)
+	require.NoError(t, err)
+	assert.Equal(t, Variables{
+		"ca":     "CERTIFICATE",
+		"domain": "${uds.dev}",
+		"sso":    Variables{"issuer": "https://sso.uds.dev", "client_id": "uds"},
+	}, cfg.Variables)
+
+	require.NoError(t, os.WriteFile(path, []byte("variables = {\n  sso = file(\"sso.json\")\n}\n"), 0o600))
+	err = WriteConfigVariables(t.Context(), path, Variables{"sso": Variables{"client_id": "uds"}})
+	require.ErrorIs(t, err, ErrMergeConfigVariables)
+}
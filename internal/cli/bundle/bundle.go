@@ -5,6 +5,7 @@
 package bundle
 
 import (
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
 )
@@ -26,20 +27,43 @@ func NewBundleCommand(streams iostreams.IOStreams) *cobra.Command {
 	bundleCmd.PersistentFlags().Bool("skip-tls-verify", defaults.SkipTLSVerify, "skip TLS certificate verification")
 	bundleCmd.PersistentFlags().String("tmp-dir", defaults.TmpDir, "directory for temporary files")
 	bundleCmd.PersistentFlags().Int("concurrency", defaults.Concurrency, "degree of parallelism for concurrent operations")
-	bundleCmd.PersistentFlags().String("config", "", "path to config.uds.hcl for deploy-time variables and options")
-	bundleCmd.PersistentFlags().StringP("output", "o", "text", "output format (text, json, yaml)")
+	bundleCmd.PersistentFlags().Int("chunk-concurrency", defaults.ChunkConcurrency, "number of parallel ranged requests used to download each large blob")
+	bundleCmd.PersistentFlags().String("config", "", "path to config.uds.hcl or config.uds.json for deploy-time variables and options")
+	bundleCmd.PersistentFlags().StringP("output", "o", "text", "output format (text, json, yaml; lint also accepts sarif, graph dot and mermaid)")
 
-	// Add subcommands
+	// Add subcommands. Commands that change files, registries or clusters are
+	// operations whose logs uds logs replays; read-only commands write no log,
+	// so running them cannot rotate away the log of a failed deploy.
 	bundleCmd.AddCommand(NewInspectCommand(streams))
-	bundleCmd.AddCommand(NewCreateCommand(streams))
-	bundleCmd.AddCommand(NewPushCommand(streams))
-	bundleCmd.AddCommand(NewPullCommand(streams))
-	bundleCmd.AddCommand(NewDeployCommand(streams))
-	bundleCmd.AddCommand(NewDevCommand(streams))
-	bundleCmd.AddCommand(NewRemoveCommand(streams))
-	bundleCmd.AddCommand(NewReconfigureCommand(streams))
-	bundleCmd.AddCommand(NewSignCommand(streams))
+	bundleCmd.AddCommand(NewDiffCommand(streams))
+	bundleCmd.AddCommand(asOperation(NewCreateCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewUpdateCommand(streams)))
+	bundleCmd.AddCommand(NewLintCommand(streams))
+	bundleCmd.AddCommand(NewGraphCommand(streams))
+	bundleCmd.AddCommand(asOperation(NewMigrateCommand(streams)))
+	bundleCmd.AddCommand(NewLSPCommand(streams))
+	bundleCmd.AddCommand(asOperation(NewPushCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewPullCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewCopyCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewExportCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewImportCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewRegistryCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewDeployCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewDevCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewRemoveCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewReconfigureCommand(streams)))
+	bundleCmd.AddCommand(asOperation(NewSignCommand(streams)))
 	bundleCmd.AddCommand(NewVerifyCommand(streams))
 
 	return bundleCmd
 }
+
+// asOperation marks cmd and its subcommands as an operation whose log is
+// written to the operation log directory.
+func asOperation(cmd *cobra.Command) *cobra.Command {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[logger.OperationAnnotation] = "true"
+	return cmd
+}
@@ -6,12 +6,14 @@ package bundle
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/bundle"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 )
@@ -50,6 +52,14 @@ func TestCommandOptions_CompletePropagatesPrompt(t *testing.T) {
 				return o.Prompt, err
 			},
 		},
+		{
+			name: "copy",
+			complete: func(cmd *cobra.Command) (bool, error) {
+				o := NewCopyOptions(streams)
+				err := o.Complete(cmd, []string{"oci://dev.example.com/bundle:v1", "oci://prod.example.com/bundle:v1"})
+				return o.Prompt, err
+			},
+		},
 		{
 			name: "reconfigure",
 			setup: func(cmd *cobra.Command) {
@@ -210,3 +220,161 @@ func TestPushOptions_Complete(t *testing.T) {
 	assert.Equal(t, "bundle.tar.zst", o.Tarball)
 	assert.Equal(t, "ghcr.io/org/bundle:v1", o.OCIReference)
 }
+
+func TestCopyOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		source       string
+		ociReference string
+		publicKeys   []string
+		wantErr      string
+	}{
+		{
+			name:         "empty source",
+			ociReference: "ghcr.io/prod:v1",
+			wantErr:      "source OCI reference is required",
+		},
+		{
+			name:    "empty destination",
+			source:  "ghcr.io/dev:v1",
+			wantErr: "destination OCI reference is required",
+		},
+		{
+			name:         "verification is optional",
+			source:       "ghcr.io/dev:v1",
+			ociReference: "ghcr.io/prod:v1",
+		},
+		{
+			name:         "unreadable public key",
+			source:       "ghcr.io/dev:v1",
+			ociReference: "ghcr.io/prod:v1",
+			publicKeys:   []string{filepath.Join(t.TempDir(), "missing.pub")},
+			wantErr:      "reading public key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			defaults := NewConfigResolver().Defaults()
+			o := &CopyOptions{
+				Source:       tt.source,
+				OCIReference: tt.ociReference,
+				Config:       &bundle.UDSBundleConfig{Options: &defaults},
+				Verification: VerifyOptions{PublicKeys: tt.publicKeys},
+			}
+			err := o.Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestExportOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		volumeSize string
+		wantBytes  int64
+		wantErr    string
+	}{
+		{name: "unlimited volume"},
+		{name: "human readable size", volumeSize: "700MB", wantBytes: 700_000_000},
+		{name: "invalid size", volumeSize: "lots", wantErr: "invalid --volume-size"},
+		{name: "zero size", volumeSize: "0", wantErr: "--volume-size must be positive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			o := &ExportOptions{Source: "bundle.tar.zst", OutputDir: "export", VolumeSize: tt.volumeSize}
+			err := o.Validate()
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantBytes, o.volumeBytes)
+		})
+	}
+}
+
+func TestImportOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorContains(t, (&ImportOptions{}).Validate(), "at least one volume directory is required")
+	o := &ImportOptions{Volumes: []string{"export"}, OutputDir: "out", OCIReference: "localhost:5000/b:1.0.0"}
+	require.ErrorContains(t, o.Validate(), "mutually exclusive")
+	o.OutputDir = ""
+	require.NoError(t, o.Validate())
+}
+
+func TestRegistryGCOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorContains(t, (&RegistryGCOptions{}).Validate(), "repository is required")
+	o := &RegistryGCOptions{Repository: "localhost:5000/bundles/app", KeepLast: -1}
+	require.ErrorContains(t, o.Validate(), "--keep-last must not be negative")
+	o.KeepLast = 3
+	require.NoError(t, o.Validate())
+}
+
+func TestLintOptions_Complete(t *testing.T) {
+	streams, _, _, _ := iostreams.NewTestIOStreams()
+	dir := t.TempDir()
+
+	o := NewLintOptions(streams)
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "text", "")
+	require.NoError(t, cmd.Flags().Set("output", "sarif"))
+	require.NoError(t, o.Complete(cmd, []string{dir}))
+	assert.Equal(t, dir, o.BundlePath)
+	assert.True(t, o.SARIF)
+	assert.Nil(t, o.Printer)
+
+	o = NewLintOptions(streams)
+	require.NoError(t, o.Complete(&cobra.Command{}, nil))
+	assert.Equal(t, ".", o.BundlePath)
+	assert.False(t, o.SARIF)
+	assert.NotNil(t, o.Printer)
+}
+
+func TestGraphOptions_Complete(t *testing.T) {
+	streams, _, _, _ := iostreams.NewTestIOStreams()
+	dir := t.TempDir()
+
+	o := NewGraphOptions(streams)
+	cmd := &cobra.Command{}
+	cmd.Flags().String("output", "text", "")
+	require.NoError(t, cmd.Flags().Set("output", "Mermaid"))
+	require.NoError(t, o.Complete(cmd, []string{dir}))
+	assert.Equal(t, dir, o.Source)
+	assert.Equal(t, outputMermaid, o.Format)
+	assert.Nil(t, o.Printer)
+
+	o = NewGraphOptions(streams)
+	require.NoError(t, o.Complete(&cobra.Command{}, nil))
+	assert.Equal(t, ".", o.Source)
+	assert.Empty(t, o.Format)
+	assert.NotNil(t, o.Printer)
+}
+
+func TestNewBundleCommand_MarksOperations(t *testing.T) {
+	bundleCmd := NewBundleCommand(iostreams.IOStreams{})
+	assert.Empty(t, bundleCmd.Annotations[logger.OperationAnnotation])
+
+	readOnly := []string{"inspect", "diff", "lint", "graph", "lsp", "verify"}
+	for _, cmd := range bundleCmd.Commands() {
+		want := "true"
+		if slices.Contains(readOnly, cmd.Name()) {
+			want = ""
+		}
+		assert.Equal(t, want, cmd.Annotations[logger.OperationAnnotation], cmd.Name())
+	}
+}
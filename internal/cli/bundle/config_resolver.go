@@ -21,20 +21,22 @@ import (
 // It exists so config resolution does not depend on *cobra.Command, keeping
 // command execution independent of Cobra.
 type CLIFlags struct {
-	ConfigPath           string // --config (always read; empty when unset)
-	LogLevel             string
-	LogLevelChanged      bool
-	Architecture         string
-	ArchitectureChanged  bool
-	PlainHTTP            bool
-	PlainHTTPChanged     bool
-	SkipTLSVerify        bool
-	SkipTLSVerifyChanged bool
-	TmpDir               string
-	TmpDirChanged        bool
-	Concurrency          int
-	ConcurrencyChanged   bool
-	Prompt               bool
+	ConfigPath              string // --config (always read; empty when unset)
+	LogLevel                string
+	LogLevelChanged         bool
+	Architecture            string
+	ArchitectureChanged     bool
+	PlainHTTP               bool
+	PlainHTTPChanged        bool
+	SkipTLSVerify           bool
+	SkipTLSVerifyChanged    bool
+	TmpDir                  string
+	TmpDirChanged           bool
+	Concurrency             int
+	ConcurrencyChanged      bool
+	ChunkConcurrency        int
+	ChunkConcurrencyChanged bool
+	Prompt                  bool
 }
 
 // SnapshotFlags reads every CLI flag the resolver needs from cmd, plus its Changed() bit.
@@ -53,6 +55,8 @@ func SnapshotFlags(cmd *cobra.Command) CLIFlags {
 	f.TmpDirChanged = cmd.Flags().Changed("tmp-dir")
 	f.Concurrency, _ = cmd.Flags().GetInt("concurrency")
 	f.ConcurrencyChanged = cmd.Flags().Changed("concurrency")
+	f.ChunkConcurrency, _ = cmd.Flags().GetInt("chunk-concurrency")
+	f.ChunkConcurrencyChanged = cmd.Flags().Changed("chunk-concurrency")
 	f.Prompt, _ = cmd.Flags().GetBool("prompt")
 	return f
 }
@@ -107,6 +111,9 @@ func (r *ConfigResolver) MergeHCL(base bundle.ConfigOptions, hcl *bundle.ConfigO
 	if hcl.Concurrency != 0 {
 		base.Concurrency = hcl.Concurrency
 	}
+	if hcl.ChunkConcurrency != 0 {
+		base.ChunkConcurrency = hcl.ChunkConcurrency
+	}
 	return base
 }
 
@@ -133,6 +140,9 @@ func (r *ConfigResolver) OverlayCLI(flags CLIFlags, base bundle.ConfigOptions) b
 	if flags.ConcurrencyChanged {
 		base.Concurrency = flags.Concurrency
 	}
+	if flags.ChunkConcurrencyChanged {
+		base.ChunkConcurrency = flags.ChunkConcurrency
+	}
 	return base
 }
 
@@ -222,17 +232,33 @@ func fromInternalVerificationPolicy(policy *bundleinternal.SignatureVerification
 	if policy == nil {
 		return nil
 	}
-	result := &bundle.VerificationPolicy{PublicKey: policy.PublicKey}
-	if policy.Keyless != nil {
-		result.Keyless = &bundle.KeylessVerification{
-			CertificateIdentity: policy.Keyless.CertificateIdentity, CertificateIdentityRegexp: policy.Keyless.CertificateIdentityRegexp,
-			CertificateOIDCIssuer: policy.Keyless.CertificateOIDCIssuer, CertificateOIDCIssuerRegexp: policy.Keyless.CertificateOIDCIssuerRegexp,
-			TrustedRoot: policy.Keyless.TrustedRoot,
-		}
+	result := &bundle.VerificationPolicy{
+		PublicKey: policy.PublicKey, Keyless: fromInternalKeyless(policy.Keyless),
+		Rule: bundle.VerificationRule(policy.Rule), Threshold: policy.Threshold,
+	}
+	for _, signer := range policy.Signers {
+		result.Signers = append(result.Signers, bundle.TrustedSigner{Name: signer.Name, PublicKey: signer.PublicKey, Keyless: fromInternalKeyless(signer.Keyless)})
+	}
+	for _, scope := range policy.Scopes {
+		result.Scopes = append(result.Scopes, bundle.BundleVerificationScope{
+			BundleName: scope.BundleName, Signers: append([]string(nil), scope.Signers...),
+			Rule: bundle.VerificationRule(scope.Rule), Threshold: scope.Threshold,
+		})
 	}
 	return result
 }
 
+func fromInternalKeyless(keyless *bundleinternal.KeylessVerification) *bundle.KeylessVerification {
+	if keyless == nil {
+		return nil
+	}
+	return &bundle.KeylessVerification{
+		CertificateIdentity: keyless.CertificateIdentity, CertificateIdentityRegexp: keyless.CertificateIdentityRegexp,
+		CertificateOIDCIssuer: keyless.CertificateOIDCIssuer, CertificateOIDCIssuerRegexp: keyless.CertificateOIDCIssuerRegexp,
+		TrustedRoot: keyless.TrustedRoot,
+	}
+}
+
 // resolveOptions layers config.uds.hcl options and CLI flags onto Defaults().
 func (r *ConfigResolver) resolveOptions(userCfg *bundle.UDSBundleConfig, flags CLIFlags) bundle.ConfigOptions {
 	base := r.Defaults()
@@ -328,6 +354,7 @@ func fromInternalOptions(options *bundleinternal.ConfigOptions) *bundle.ConfigOp
 		LogLevel: options.LogLevel, Architecture: options.Architecture,
 		PlainHTTP: options.PlainHTTP, SkipTLSVerify: options.SkipTLSVerify,
 		TmpDir: options.TmpDir, Concurrency: options.Concurrency,
+		ChunkConcurrency: options.ChunkConcurrency,
 	}
 }
 
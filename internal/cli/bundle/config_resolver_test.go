@@ -633,6 +633,7 @@ signature_verification {
 	assert.Equal(t, bundle.Variables{"overridden": "config", "from_config": "config"}, base.Variables)
 	require.NotNil(t, base.SignatureVerification)
 	assert.Equal(t, "trusted-public-key", base.SignatureVerification.PublicKey)
+	assert.Empty(t, base.SignatureVerification.Signers)
 
 	resolved, err := r.applyBundleDefaults(t.Context(), iostreams.IOStreams{}, base, bundleDir)
 	require.NoError(t, err)
@@ -648,6 +649,44 @@ signature_verification {
 	assert.Equal(t, bundle.Variables{"overridden": "config", "from_config": "config"}, base.Variables, "base config must not be mutated")
 }
 
+func TestResolveBase_MultiSignerVerificationPolicy(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.uds.hcl")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+signature_verification {
+  rule = "threshold"
+  threshold = 1
+  signer "release" {
+    public_key = "key-a"
+  }
+  signer "ci" {
+    keyless {
+      certificate_identity_regexp = "^https://github.com/org/"
+      certificate_oidc_issuer     = "https://token.actions.githubusercontent.com"
+    }
+  }
+  scope "core-*" {
+    signers = ["ci"]
+  }
+}
+`), 0o600))
+
+	base, _, err := NewConfigResolver().resolveBase(t.Context(), iostreams.IOStreams{}, CLIFlags{ConfigPath: configPath})
+	require.NoError(t, err)
+	assert.Equal(t, &bundle.VerificationPolicy{
+		Rule:      bundle.VerificationRuleThreshold,
+		Threshold: 1,
+		Signers: []bundle.TrustedSigner{
+			{Name: "release", PublicKey: "key-a"},
+			{Name: "ci", Keyless: &bundle.KeylessVerification{
+				CertificateIdentityRegexp: "^https://github.com/org/",
+				CertificateOIDCIssuer:     "https://token.actions.githubusercontent.com",
+			}},
+		},
+		Scopes: []bundle.BundleVerificationScope{{BundleName: "core-*", Signers: []string{"ci"}}},
+	}, base.SignatureVerification)
+	require.NoError(t, base.SignatureVerification.Validate())
+}
+
 func TestResolve_CLIOverridesHCL(t *testing.T) {
 	r := NewConfigResolver()
 	configDir := t.TempDir()
@@ -0,0 +1,147 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// CopyOptions holds options for the copy command.
+type CopyOptions struct {
+	Source           string
+	OCIReference     string
+	AllArchitectures bool
+	Prompt           bool
+	Config           *bundle.UDSBundleConfig
+	Verification     VerifyOptions
+	Printer          printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewCopyOptions returns a CopyOptions with default values.
+func NewCopyOptions(streams iostreams.IOStreams) *CopyOptions {
+	return &CopyOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewCopyCommand creates the copy command.
+func NewCopyCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewCopyOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "copy <src-oci-reference> <dst-oci-reference>",
+		Short: "Copy a bundle between OCI registries",
+		Long: `Copy a UDS bundle from one OCI registry to another without writing it to local disk.
+
+Bundle content, signatures and attestations are streamed between the registries.
+Bundles for other architectures already published at the destination tag are
+kept. When trusted signers are given by flag or config, the bundle signature is
+verified before anything is copied.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.AllArchitectures, "all-architectures", false, "copy every architecture published at the source reference")
+	addVerificationFlags(cmd, &o.Verification, false)
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *CopyOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Source = args[0]
+	}
+	if len(args) > 1 {
+		o.OCIReference = args[1]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	flags := SnapshotFlags(cmd)
+	o.Prompt = flags.Prompt
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, flags, "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+	o.Verification.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options.
+// Config validation is performed by the library entry point.
+func (o *CopyOptions) Validate() error {
+	if o.Source == "" {
+		return fmt.Errorf("source OCI reference is required: %w", ErrInvalidArgument)
+	}
+	if o.OCIReference == "" {
+		return fmt.Errorf("destination OCI reference is required: %w", ErrInvalidArgument)
+	}
+	if o.Verification.requested() {
+		if _, err := o.Verification.policy(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Run executes the copy command.
+func (o *CopyOptions) Run(ctx context.Context) error {
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Debug("copying bundle", "source", o.Source, "destination", o.OCIReference, "all-architectures", o.AllArchitectures)
+	policy := bundle.VerificationPolicy{}
+	if o.Verification.requested() {
+		var err error
+		policy, err = o.Verification.policy()
+		if err != nil {
+			return err
+		}
+	}
+	if o.Prompt {
+		confirmed, err := PromptConfirmation(o.IOStreams, "Copy this bundle?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			o.Info("copy cancelled")
+			return nil
+		}
+	}
+	o.Info("copying bundle", "source", o.Source, "destination", o.OCIReference)
+	result, err := bundle.Copy(ctx, o.Source, o.OCIReference, bundle.CopyOptions{
+		Config:           o.Config,
+		Verification:     policy,
+		AllArchitectures: o.AllArchitectures,
+		Streams:          o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
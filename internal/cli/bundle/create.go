@@ -22,6 +22,8 @@ type CreateOptions struct {
 	Config     *bundle.UDSBundleConfig
 	Printer    printer.ResourcePrinter
 	Signing    bundle.SigningOptions
+	// VerifyReproducible builds the bundle twice and fails when the archives differ.
+	VerifyReproducible bool
 
 	iostreams.IOStreams
 }
@@ -40,8 +42,18 @@ func NewCreateCommand(streams iostreams.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [directory]",
 		Short: "Create a new UDS bundle",
-		Long:  "Create a new UDS bundle from an HCL configuration file",
-		Args:  cobra.MaximumNArgs(1),
+		Long: `Create a new UDS bundle from an HCL configuration file.
+
+OCI package sources are pinned in bundle.lock.hcl next to the bundle
+definition. Sources may use a semantic version constraint as their tag, for
+example oci://ghcr.io/uds/core:~0.40; create resolves it once and then builds
+from the locked digest until "uds bundle update" is run.
+
+Bundle archives are reproducible: the same inputs produce byte-identical
+archives. Timestamps are taken from SOURCE_DATE_EPOCH when it is set and are
+otherwise pinned to the Unix epoch or omitted. Use --verify-reproducible to
+build the bundle twice and fail if the archives differ.`,
+		Args: cobra.MaximumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			util.CheckErr(o.Complete(cmd, args))
 			util.CheckErr(o.Validate())
@@ -51,6 +63,7 @@ func NewCreateCommand(streams iostreams.IOStreams) *cobra.Command {
 	}
 	addSigningFlags(cmd, &o.Signing)
 	cmd.Flags().Bool("unsigned", false, "create an unsigned bundle")
+	cmd.Flags().BoolVar(&o.VerifyReproducible, "verify-reproducible", false, "build the bundle twice and fail if the archives are not byte-identical")
 
 	return cmd
 }
@@ -114,9 +127,10 @@ func (o *CreateOptions) Run(ctx context.Context) error {
 	o.Info("creating bundle", "source", bundlePath)
 
 	result, err := bundle.Create(ctx, bundlePath, bundle.CreateOptions{
-		Config:  o.Config,
-		Signing: o.Signing,
-		Streams: o.IOStreams,
+		Config:             o.Config,
+		Signing:            o.Signing,
+		Streams:            o.IOStreams,
+		VerifyReproducible: o.VerifyReproducible,
 	})
 	if err != nil {
 		return err
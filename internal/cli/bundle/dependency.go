@@ -13,6 +13,7 @@ import (
 
 const (
 	bundleFileName         = bundleinternal.BundleFileName
+	bundleJSONFileName     = bundleinternal.BundleJSONFileName
 	bundleDefaultsFileName = bundleinternal.BundleDefaultsFileName
 )
 
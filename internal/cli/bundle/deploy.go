@@ -6,9 +6,6 @@ package bundle
 import (
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
-	"strings"
 
 	"github.com/defenseunicorns/uds-cli/internal/cli/util"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
@@ -23,13 +20,16 @@ type DeployOptions struct {
 	BundlePath   string
 	Packages     []string
 	Force        bool
+	Prune        bool
+	PruneDryRun  bool
 	Config       *bundle.UDSBundleConfig
 	Verification VerifyOptions
 	Printer      printer.ResourcePrinter
 
-	flags      CLIFlags
-	pullBundle func(context.Context, string, string, bundle.PullOptions) (*bundle.PullResult, error)
-	runDeploy  deployRunnerFunc
+	flags            CLIFlags
+	prepareOCISource func(context.Context, string, []string, bundle.PullOptions) (*bundle.DeploySource, error)
+	deployBundle     deployBundleFunc
+	runDeploy        deployRunnerFunc
 
 	iostreams.IOStreams
 }
@@ -37,9 +37,10 @@ type DeployOptions struct {
 // NewDeployOptions returns artifact deploy options with default values.
 func NewDeployOptions(streams iostreams.IOStreams) *DeployOptions {
 	return &DeployOptions{
-		IOStreams:  streams,
-		pullBundle: bundle.Pull,
-		runDeploy:  runDeploy,
+		IOStreams:        streams,
+		prepareOCISource: bundle.PrepareOCIDeploySource,
+		deployBundle:     bundle.Deploy,
+		runDeploy:        runDeploy,
 	}
 }
 
@@ -53,18 +54,27 @@ func NewDeployCommand(streams iostreams.IOStreams) *cobra.Command {
 		Long: `Deploy a created UDS bundle artifact to a Kubernetes cluster.
 
 The required bundle-artifact can be a local .tar.zst file or an OCI reference.
-Local and pulled OCI artifacts are integrity-verified before package deployment.
+Local artifacts are integrity-verified before package deployment. OCI
+references are deployed directly from the registry: only the layers of the
+selected packages are downloaded, and each is digest-verified as it is fetched.
 
-Bundle directories and bundle.uds.hcl files are development
+With --prune, packages the cluster records as deployed by a bundle of the
+same name that this bundle no longer declares are removed after the deploy
+succeeds, in reverse dependency order. --prune-dry-run lists them instead.
+
+Bundle directories and bundle.uds.hcl or bundle.uds.json files are development
 inputs and must use uds bundle dev deploy instead.`,
 		Example: `  # Deploy a local bundle artifact
   uds bundle deploy uds-bundle-example-amd64-0.1.0.tar.zst
 
-  # Pull and deploy an OCI bundle artifact
+  # Deploy an OCI bundle artifact without pulling it first
   uds bundle deploy oci://ghcr.io/example/bundle:1.0.0
 
   # Deploy selected packages with confirmation
-  uds bundle deploy bundle.tar.zst --packages nginx,podinfo --prompt`,
+  uds bundle deploy bundle.tar.zst --packages nginx,podinfo --prompt
+
+  # Deploy and remove packages dropped since the last deploy
+  uds bundle deploy bundle.tar.zst --prune`,
 		Args: cobra.ExactArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			util.CheckErr(o.Complete(cmd, args))
@@ -74,6 +84,7 @@ inputs and must use uds bundle dev deploy instead.`,
 	}
 
 	addDeployFlags(cmd, &o.Packages, &o.Force)
+	addPruneFlags(cmd, &o.Prune, &o.PruneDryRun)
 	addVerificationFlags(cmd, &o.Verification, true)
 
 	return cmd
@@ -84,6 +95,19 @@ func addDeployFlags(cmd *cobra.Command, packages *[]string, force *bool) {
 	cmd.Flags().BoolVarP(force, "force", "f", false, "deploy packages even if their dependencies are not selected")
 }
 
+func addPruneFlags(cmd *cobra.Command, prune, dryRun *bool) {
+	cmd.Flags().BoolVar(prune, "prune", false, "remove deployed packages this bundle no longer declares after a successful deploy")
+	cmd.Flags().BoolVar(dryRun, "prune-dry-run", false, "list deployed packages this bundle no longer declares without removing them")
+}
+
+// validatePruneFlags rejects combining --prune with --prune-dry-run.
+func validatePruneFlags(prune, dryRun bool) error {
+	if prune && dryRun {
+		return fmt.Errorf("--prune cannot be combined with --prune-dry-run: %w", ErrInvalidArgument)
+	}
+	return nil
+}
+
 // Complete fills artifact deploy options from command-line arguments.
 func (o *DeployOptions) Complete(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
@@ -114,6 +138,9 @@ func (o *DeployOptions) Validate() error {
 	if err := ValidateArtifactReference(o.BundlePath); err != nil {
 		return err
 	}
+	if err := validatePruneFlags(o.Prune, o.PruneDryRun); err != nil {
+		return err
+	}
 	if !o.Verification.SkipSignatureVerification {
 		if _, err := o.Verification.policy(); err != nil {
 			return err
@@ -150,10 +177,11 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 		runner = runDeploy
 	}
 	if isOCIReference(o.BundlePath) {
-		result, err = o.runOCIArtifact(ctx, runner, policy)
+		result, err = o.runOCIArtifact(ctx, policy)
 	} else {
+		var verified *bundle.VerifyResult
 		if !o.Verification.SkipSignatureVerification {
-			err = bundle.Verify(ctx, bundle.VerifyOptions{
+			verified, err = bundle.VerifyWithResult(ctx, bundle.VerifyOptions{
 				Source:  o.BundlePath,
 				Policy:  policy,
 				Config:  baseConfig,
@@ -164,7 +192,10 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 				return err
 			}
 		}
-		result, err = runner(ctx, o.IOStreams, baseConfig, o.BundlePath, o.Packages, o.Force, o.flags.Prompt)
+		result, err = runner(ctx, o.IOStreams, baseConfig, o.BundlePath, o.runOptions())
+		if result != nil && verified != nil {
+			result.SignedBy = verified.SignedBy
+		}
 	}
 	if err != nil {
 		return err
@@ -175,67 +206,56 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	return o.Printer.PrintObj(result, o.Out())
 }
 
-func (o *DeployOptions) runOCIArtifact(ctx context.Context, runner deployRunnerFunc, policy bundle.VerificationPolicy) (*bundle.DeployResult, error) {
-	o.Info("pulling bundle for deployment", "ref", o.BundlePath)
-	outputDir, err := os.MkdirTemp(o.Config.Options.TmpDir, "uds-bundle-oci-deploy-*")
-	if err != nil {
-		return nil, fmt.Errorf("%w under %q: %w", ErrCreateWorkspace, o.Config.Options.TmpDir, err)
+// runOCIArtifact deploys an OCI reference from a source that pulls only the
+// selected packages, so the bundle is never written out as an archive.
+func (o *DeployOptions) runOCIArtifact(ctx context.Context, policy bundle.VerificationPolicy) (*bundle.DeployResult, error) {
+	prepareOCISource := o.prepareOCISource
+	if prepareOCISource == nil {
+		prepareOCISource = bundle.PrepareOCIDeploySource
+	}
+	deployBundle := o.deployBundle
+	if deployBundle == nil {
+		deployBundle = bundle.Deploy
 	}
-	defer func() {
-		if cleanupErr := os.RemoveAll(outputDir); cleanupErr != nil {
-			o.Warn("failed to remove OCI deploy workspace", "path", outputDir, "error", cleanupErr)
-		}
-	}()
 
-	pullBundle := o.pullBundle
-	if pullBundle == nil {
-		pullBundle = bundle.Pull
+	var signedBy []string
+	prepare := func(ctx context.Context, streams iostreams.IOStreams, ref, _, _ string, packages []string) (*preparedDeploySource, error) {
+		streams.Info("resolving bundle for deployment", "ref", ref)
+		source, err := prepareOCISource(ctx, ref, packages, bundle.PullOptions{
+			Config:                    o.Config,
+			Verification:              policy,
+			SkipSignatureVerification: o.Verification.SkipSignatureVerification,
+			Streams:                   streams,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if source == nil {
+			return nil, fmt.Errorf("OCI source preparation returned no source: %w", ErrPullBundle)
+		}
+		signedBy = source.SignedBy
+		return &preparedDeploySource{source: source, close: source.Close}, nil
 	}
-	result, err := pullBundle(ctx, o.BundlePath, outputDir, bundle.PullOptions{
-		Config:                    o.Config,
-		Verification:              policy,
-		SkipSignatureVerification: o.Verification.SkipSignatureVerification,
-		Streams:                   o.IOStreams,
+
+	deployed, err := runDeployWith(ctx, o.IOStreams, o.Config, o.BundlePath, o.runOptions(), deployRunnerDependencies{
+		prepare: prepare,
+		deploy:  deployBundle,
+		orphans: bundle.DeployedOrphans,
+		unset:   bundle.UnsetVariables,
 	})
-	if err != nil {
-		return nil, err
-	}
-	if result == nil {
-		return nil, fmt.Errorf("puller returned no result: %w", ErrPullBundle)
-	}
-	if result.OutputPath == "" {
-		return nil, fmt.Errorf("puller returned an empty output path: %w", ErrPullBundle)
+	if deployed != nil {
+		deployed.SignedBy = signedBy
 	}
-	artifactPath, err := validatePulledArtifact(outputDir, result.OutputPath)
-	if err != nil {
-		return nil, fmt.Errorf("%w %q into %q: %w", ErrPullBundle, o.BundlePath, outputDir, err)
-	}
-
-	return runner(ctx, o.IOStreams, o.Config, artifactPath, o.Packages, o.Force, o.flags.Prompt)
+	return deployed, err
 }
 
-func validatePulledArtifact(workspace, outputPath string) (string, error) {
-	resolvedWorkspace, err := filepath.EvalSymlinks(workspace)
-	if err != nil {
-		return "", fmt.Errorf("resolving pull workspace: %w: %w", ErrResolvePath, err)
-	}
-	resolvedArtifact, err := filepath.EvalSymlinks(outputPath)
-	if err != nil {
-		return "", fmt.Errorf("resolving pulled artifact: %w: %w", ErrResolvePath, err)
-	}
-	rel, err := filepath.Rel(resolvedWorkspace, resolvedArtifact)
-	if err != nil {
-		return "", fmt.Errorf("checking pulled artifact location: %w: %w", ErrUnsafePullOutput, err)
-	}
-	if rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
-		return "", fmt.Errorf("puller returned an artifact outside its workspace: %s: %w", outputPath, ErrUnsafePullOutput)
-	}
-	info, err := os.Stat(resolvedArtifact)
-	if err != nil {
-		return "", fmt.Errorf("checking pulled artifact: %w: %w", ErrInvalidPath, err)
-	}
-	if !info.Mode().IsRegular() || !isTarZst(resolvedArtifact) {
-		return "", fmt.Errorf("puller returned a non-artifact output path: %s: %w", outputPath, ErrUnsafePullOutput)
+func (o *DeployOptions) runOptions() deployRunOptions {
+	return deployRunOptions{
+		Packages:    o.Packages,
+		Force:       o.Force,
+		Prompt:      o.flags.Prompt,
+		Prune:       o.Prune,
+		PruneDryRun: o.PruneDryRun,
+		ConfigPath:  o.flags.ConfigPath,
 	}
-	return resolvedArtifact, nil
 }
@@ -15,14 +15,24 @@ import (
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 )
 
+// deployRunOptions carries the deploy command flags shared by deploy and dev deploy.
+type deployRunOptions struct {
+	Packages    []string
+	Force       bool
+	Prompt      bool
+	Prune       bool
+	PruneDryRun bool
+	// ConfigPath is the --config path answers to prompted variables are
+	// offered to be written to.
+	ConfigPath string
+}
+
 type deployRunnerFunc func(
 	ctx context.Context,
 	streams iostreams.IOStreams,
 	config *bundlepkg.UDSBundleConfig,
 	bundlePath string,
-	packages []string,
-	force bool,
-	prompt bool,
+	opts deployRunOptions,
 ) (*bundlepkg.DeployResult, error)
 
 type prepareDeploySourceFunc func(
@@ -31,13 +41,18 @@ type prepareDeploySourceFunc func(
 	path string,
 	tmpDir string,
 	architecture string,
+	packages []string,
 ) (*preparedDeploySource, error)
 
 type deployBundleFunc func(ctx context.Context, source *bundlepkg.DeploySource, opts bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error)
 
+type deployedOrphansFunc func(ctx context.Context, b *spec.UDSBundle, opts bundlepkg.DeployOptions) ([]string, error)
+
 type deployRunnerDependencies struct {
 	prepare prepareDeploySourceFunc
 	deploy  deployBundleFunc
+	orphans deployedOrphansFunc
+	unset   unsetVariablesFunc
 }
 
 func runDeploy(
@@ -45,13 +60,13 @@ func runDeploy(
 	streams iostreams.IOStreams,
 	baseConfig *bundlepkg.UDSBundleConfig,
 	bundlePath string,
-	packages []string,
-	force bool,
-	prompt bool,
+	opts deployRunOptions,
 ) (*bundlepkg.DeployResult, error) {
-	return runDeployWith(ctx, streams, baseConfig, bundlePath, packages, force, prompt, deployRunnerDependencies{
+	return runDeployWith(ctx, streams, baseConfig, bundlePath, opts, deployRunnerDependencies{
 		prepare: prepareDeploySource,
 		deploy:  bundlepkg.Deploy,
+		orphans: bundlepkg.DeployedOrphans,
+		unset:   bundlepkg.UnsetVariables,
 	})
 }
 
@@ -60,12 +75,11 @@ func runDeployWith(
 	streams iostreams.IOStreams,
 	baseConfig *bundlepkg.UDSBundleConfig,
 	bundlePath string,
-	packages []string,
-	force bool,
-	prompt bool,
+	opts deployRunOptions,
 	deps deployRunnerDependencies,
 ) (*bundlepkg.DeployResult, error) {
-	prepared, err := deps.prepare(ctx, streams, bundlePath, baseConfig.Options.TmpDir, baseConfig.Options.Architecture)
+	packages := opts.Packages
+	prepared, err := deps.prepare(ctx, streams, bundlePath, baseConfig.Options.TmpDir, baseConfig.Options.Architecture, packages)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +92,7 @@ func runDeployWith(
 
 	config := baseConfig
 	streams = logger.Bind(streams, config.Options.LogLevel)
-	streams.Debug("prepared bundle deployment source", "path", deploySrc.BundlePath, "prompt", prompt)
+	streams.Debug("prepared bundle deployment source", "path", deploySrc.BundlePath, "prompt", opts.Prompt)
 
 	parsedBundle, err := parseDeployBundle(ctx, streams, config.Options.Architecture, deploySrc)
 	if err != nil {
@@ -94,7 +108,7 @@ func runDeployWith(
 	if err := bundleinternal.ValidatePackageNames(packages, parsedBundle.Packages); err != nil {
 		return nil, err
 	}
-	if !force {
+	if !opts.Force {
 		violations, err := bundleinternal.DeployViolations(ctx, streams, parsedBundle, packages)
 		if err != nil {
 			return nil, err
@@ -104,7 +118,35 @@ func runDeployWith(
 		}
 	}
 
-	if prompt {
+	if opts.Prompt && deps.unset != nil {
+		unset, err := deps.unset(ctx, deploySrc, bundlepkg.DeployOptions{Config: config, Packages: packages, Streams: streams})
+		if err != nil {
+			return nil, err
+		}
+		if config, err = promptVariables(ctx, streams, unset, config, opts.ConfigPath); err != nil {
+			return nil, err
+		}
+	}
+
+	deployOpts := bundlepkg.DeployOptions{
+		Config:      config,
+		Packages:    packages,
+		Force:       opts.Force,
+		Prune:       opts.Prune,
+		PruneDryRun: opts.PruneDryRun,
+		Streams:     streams,
+	}
+	if (opts.Prune || opts.PruneDryRun) && deps.orphans != nil {
+		orphans, err := deps.orphans(ctx, parsedBundle, deployOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range orphans {
+			streams.Info("package to prune after deploy", "name", name, "dry_run", opts.PruneDryRun)
+		}
+	}
+
+	if opts.Prompt {
 		confirmed, err := PromptConfirmation(streams, "Deploy this bundle?")
 		if err != nil {
 			return nil, err
@@ -115,12 +157,7 @@ func runDeployWith(
 		}
 	}
 
-	result, err := deps.deploy(ctx, deploySrc, bundlepkg.DeployOptions{
-		Config:   config,
-		Packages: packages,
-		Force:    force,
-		Streams:  streams,
-	})
+	result, err := deps.deploy(ctx, deploySrc, deployOpts)
 	if err != nil {
 		return result, err
 	}
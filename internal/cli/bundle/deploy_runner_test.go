@@ -5,10 +5,12 @@ package bundle
 
 import (
 	"context"
+	"os"
 	"path/filepath"
 	"testing"
 
 	bundlepkg "github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,9 +27,10 @@ func TestRunDeployWith_PropagatesConfigAndClosesSource(t *testing.T) {
 	}}
 	deployCalls := 0
 
-	result, err := runDeployWith(t.Context(), streams, baseConfig, bundlePath, []string{"init"}, true, false, deployRunnerDependencies{
-		prepare: func(_ context.Context, _ iostreams.IOStreams, gotPath, tmpDir, architecture string) (*preparedDeploySource, error) {
+	result, err := runDeployWith(t.Context(), streams, baseConfig, bundlePath, deployRunOptions{Packages: []string{"init"}, Force: true}, deployRunnerDependencies{
+		prepare: func(_ context.Context, _ iostreams.IOStreams, gotPath, tmpDir, architecture string, packages []string) (*preparedDeploySource, error) {
 			assert.Equal(t, bundlePath, gotPath)
+			assert.Equal(t, []string{"init"}, packages)
 			assert.Equal(t, baseConfig.Options.TmpDir, tmpDir)
 			assert.Equal(t, baseConfig.Options.Architecture, architecture)
 			return prepared, nil
@@ -69,8 +72,8 @@ func TestRunDeployWith_ForceOnlyBypassesDependencySafety(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			closeCalls := 0
 			deployCalls := 0
-			_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(3), bundlePath, tt.packages, tt.force, false, deployRunnerDependencies{
-				prepare: func(context.Context, iostreams.IOStreams, string, string, string) (*preparedDeploySource, error) {
+			_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(3), bundlePath, deployRunOptions{Packages: tt.packages, Force: tt.force}, deployRunnerDependencies{
+				prepare: func(context.Context, iostreams.IOStreams, string, string, string, []string) (*preparedDeploySource, error) {
 					return &preparedDeploySource{source: &bundlepkg.DeploySource{BundlePath: bundlePath}, close: func() error { closeCalls++; return nil }}, nil
 				},
 				deploy: func(context.Context, *bundlepkg.DeploySource, bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error) {
@@ -94,8 +97,8 @@ func TestRunDeployWith_ClosesSourceOnDeployError(t *testing.T) {
 	bundlePath := filepath.Join("..", "..", "..", "tests", "test_data", "bundles", "deploy", "init", bundleFileName)
 	closeCalls := 0
 
-	_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(2), bundlePath, nil, false, false, deployRunnerDependencies{
-		prepare: func(context.Context, iostreams.IOStreams, string, string, string) (*preparedDeploySource, error) {
+	_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(2), bundlePath, deployRunOptions{}, deployRunnerDependencies{
+		prepare: func(context.Context, iostreams.IOStreams, string, string, string, []string) (*preparedDeploySource, error) {
 			return &preparedDeploySource{source: &bundlepkg.DeploySource{BundlePath: bundlePath}, close: func() error { closeCalls++; return nil }}, nil
 		},
 		deploy: func(context.Context, *bundlepkg.DeploySource, bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error) {
@@ -118,3 +121,91 @@ func testDeployBaseConfig(concurrency int) *bundlepkg.UDSBundleConfig {
 		Variables: bundlepkg.Variables{"from_config": "config"},
 	}
 }
+
+func TestRunDeployWith_PruneListsOrphansBeforeDeploy(t *testing.T) {
+	streams, _, _, errOut := iostreams.NewTestIOStreams()
+	bundlePath := filepath.Join("..", "..", "..", "tests", "test_data", "bundles", "deploy", "init", bundleFileName)
+	var calls []string
+
+	_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(2), bundlePath, deployRunOptions{PruneDryRun: true}, deployRunnerDependencies{
+		prepare: func(context.Context, iostreams.IOStreams, string, string, string, []string) (*preparedDeploySource, error) {
+			return &preparedDeploySource{source: &bundlepkg.DeploySource{BundlePath: bundlePath}, close: func() error { return nil }}, nil
+		},
+		orphans: func(_ context.Context, b *spec.UDSBundle, opts bundlepkg.DeployOptions) ([]string, error) {
+			calls = append(calls, "orphans")
+			assert.Equal(t, "k3d-core-init", b.Metadata.Name)
+			assert.True(t, opts.PruneDryRun)
+			return []string{"retired"}, nil
+		},
+		deploy: func(_ context.Context, _ *bundlepkg.DeploySource, opts bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error) {
+			calls = append(calls, "deploy")
+			assert.True(t, opts.PruneDryRun)
+			assert.False(t, opts.Prune)
+			return &bundlepkg.DeployResult{}, nil
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphans", "deploy"}, calls)
+	assert.Contains(t, errOut.String(), "retired")
+}
+
+func TestRunDeployWith_PromptsForUnsetVariables(t *testing.T) {
+	streams, in, _, errOut := iostreams.NewTestIOStreams()
+	in.WriteString("uds.dev\nsecret\n3\ny\ny\n")
+	bundlePath := filepath.Join("..", "..", "..", "tests", "test_data", "bundles", "deploy", "init", bundleFileName)
+	configPath := filepath.Join(t.TempDir(), "config.uds.hcl")
+
+	var deployed bundlepkg.Variables
+	_, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(2), bundlePath, deployRunOptions{Prompt: true, ConfigPath: configPath}, deployRunnerDependencies{
+		prepare: func(context.Context, iostreams.IOStreams, string, string, string, []string) (*preparedDeploySource, error) {
+			return &preparedDeploySource{source: &bundlepkg.DeploySource{BundlePath: bundlePath}, close: func() error { return nil }}, nil
+		},
+		unset: func(context.Context, *bundlepkg.DeploySource, bundlepkg.DeployOptions) ([]bundlepkg.UnsetVariable, error) {
+			return []bundlepkg.UnsetVariable{
+				{Name: "domain", Description: "Base domain", Type: "string"},
+				{Name: "sso.client_secret", Type: "string", Sensitive: true},
+				{Name: "app.replicas", Type: "number"},
+			}, nil
+		},
+		deploy: func(_ context.Context, _ *bundlepkg.DeploySource, opts bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error) {
+			deployed = opts.Config.Variables
+			return &bundlepkg.DeployResult{}, nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Contains(t, errOut.String(), "domain (string) - Base domain: ")
+	assert.Equal(t, "config", deployed["from_config"])
+	assert.Equal(t, "uds.dev", deployed["domain"])
+	assert.Equal(t, bundlepkg.Variables{"client_secret": "secret"}, deployed["sso"])
+	assert.Equal(t, bundlepkg.Variables{"replicas": float64(3)}, deployed["app"])
+
+	written, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(written), "uds.dev")
+	assert.NotContains(t, string(written), "secret")
+}
+
+func TestRunDeployWith_PromptStopsAtEOF(t *testing.T) {
+	streams, in, _, _ := iostreams.NewTestIOStreams()
+	in.WriteString("uds.dev")
+	bundlePath := filepath.Join("..", "..", "..", "tests", "test_data", "bundles", "deploy", "init", bundleFileName)
+	deployCalls := 0
+
+	result, err := runDeployWith(t.Context(), streams, testDeployBaseConfig(2), bundlePath, deployRunOptions{Prompt: true, ConfigPath: filepath.Join(t.TempDir(), "config.uds.hcl")}, deployRunnerDependencies{
+		prepare: func(context.Context, iostreams.IOStreams, string, string, string, []string) (*preparedDeploySource, error) {
+			return &preparedDeploySource{source: &bundlepkg.DeploySource{BundlePath: bundlePath}, close: func() error { return nil }}, nil
+		},
+		unset: func(context.Context, *bundlepkg.DeploySource, bundlepkg.DeployOptions) ([]bundlepkg.UnsetVariable, error) {
+			return []bundlepkg.UnsetVariable{{Name: "domain", Type: "string"}, {Name: "region", Type: "string"}}, nil
+		},
+		deploy: func(context.Context, *bundlepkg.DeploySource, bundlepkg.DeployOptions) (*bundlepkg.DeployResult, error) {
+			deployCalls++
+			return &bundlepkg.DeployResult{}, nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, result)
+	assert.Zero(t, deployCalls)
+}
@@ -95,205 +95,136 @@ func TestDeployOptions_Validate(t *testing.T) {
 			require.ErrorContains(t, err, tt.wantErr)
 		})
 	}
+
+	o := &DeployOptions{
+		BundlePath:   artifact,
+		Prune:        true,
+		PruneDryRun:  true,
+		Verification: VerifyOptions{SkipSignatureVerification: true},
+	}
+	require.ErrorIs(t, o.Validate(), ErrInvalidArgument)
 }
 
-func TestDeployOptions_Run_OCIPullUsesArtifactPathAndCleansWorkspace(t *testing.T) {
+func TestDeployOptions_Run_OCIPreparesSelectedPackagesWithoutPulling(t *testing.T) {
 	streams, _, out, errOut := iostreams.NewTestIOStreams()
 	tmpDir := t.TempDir()
-	puller := &recordingPuller{
-		pullBundle: func(_ context.Context, ref, targetDir string, opts bundle.PullOptions) (*bundle.PullResult, error) {
+	preparer := &recordingOCISourcePreparer{
+		prepare: func(_ context.Context, ref string, packages []string, opts bundle.PullOptions) (*bundle.DeploySource, error) {
 			assert.Equal(t, "oci://example.com/test:1.0.0", ref)
+			assert.Equal(t, []string{"nginx"}, packages)
 			assert.Equal(t, tmpDir, opts.Config.Options.TmpDir)
+			assert.True(t, opts.SkipSignatureVerification)
 			assert.Same(t, streams.Out(), opts.Streams.Out())
-			artifact := filepath.Join(targetDir, "pulled.tar.zst")
-			require.NoError(t, os.WriteFile(artifact, []byte("not an archive"), 0o600))
-			return &bundle.PullResult{OCIReference: ref, OutputPath: artifact}, nil
+			return nil, fmt.Errorf("registry unavailable")
 		},
 	}
 
 	o := NewDeployOptions(streams)
 	o.BundlePath = "oci://example.com/test:1.0.0"
+	o.Packages = []string{"nginx"}
 	o.Verification.SkipSignatureVerification = true
-	o.pullBundle = puller.PullBundle
+	o.prepareOCISource = preparer.Prepare
 	o.flags = CLIFlags{TmpDir: tmpDir, TmpDirChanged: true}
 
 	err := o.Run(t.Context())
-	require.ErrorContains(t, err, "extracting bundle artifact")
-	assert.Equal(t, 1, puller.bundleCalls)
-	assert.Empty(t, out.String(), "pull and failed deploy must not print structured output")
+	require.ErrorContains(t, err, "registry unavailable")
+	assert.Equal(t, 1, preparer.calls)
+	assert.Empty(t, out.String(), "failed deploy must not print structured output")
 	assert.Contains(t, errOut.String(), "preparing bundle for deployment")
 	assert.Contains(t, errOut.String(), o.BundlePath)
 	entries, readErr := os.ReadDir(tmpDir)
 	require.NoError(t, readErr)
-	assert.Empty(t, entries, "OCI deploy workspace should be removed after failure")
+	assert.Empty(t, entries, "OCI deploy must not create a pull workspace")
 }
 
-func TestDeployOptions_Run_LocalArtifactDoesNotPull(t *testing.T) {
+func TestDeployOptions_Run_LocalArtifactDoesNotUseOCISource(t *testing.T) {
 	streams, _, _, _ := iostreams.NewTestIOStreams()
 	artifact := filepath.Join(t.TempDir(), "bundle.tar.zst")
 	require.NoError(t, os.WriteFile(artifact, []byte("not an archive"), 0o600))
-	puller := &recordingPuller{}
+	preparer := &recordingOCISourcePreparer{}
 
 	o := NewDeployOptions(streams)
 	o.BundlePath = artifact
 	o.Verification.SkipSignatureVerification = true
-	o.pullBundle = puller.PullBundle
+	o.prepareOCISource = preparer.Prepare
 	err := o.Run(t.Context())
 	require.ErrorContains(t, err, "extracting bundle artifact")
-	assert.Zero(t, puller.bundleCalls)
+	assert.Zero(t, preparer.calls)
 }
 
-func TestDeployOptions_Run_OCIWorkspaceAndOutputLifecycle(t *testing.T) {
+func TestDeployOptions_Run_OCISourceOutputAndSigners(t *testing.T) {
+	bundlePath := filepath.Join("..", "..", "..", "tests", "test_data", "bundles", "deploy", "init", bundleFileName)
 	tests := []struct {
 		name       string
 		result     *bundle.DeployResult
 		wantOutput string
 	}{
 		{
-			name:       "successful deploy prints only deploy result",
+			name:       "successful deploy prints deploy result with signers",
 			result:     &bundle.DeployResult{BundleName: "test-bundle", Packages: []bundle.DeployPackageResult{{Name: "one"}, {Name: "two"}}},
-			wantOutput: "Bundle Name:  test-bundle",
+			wantOutput: "release",
 		},
-		{name: "cancelled deploy prints no result"},
+		{name: "deploy without result prints nothing"},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			streams, _, out, _ := iostreams.NewTestIOStreams()
-			tmpDir := t.TempDir()
-			puller := &recordingPuller{
-				pullBundle: func(_ context.Context, ref, targetDir string, _ bundle.PullOptions) (*bundle.PullResult, error) {
-					artifact := filepath.Join(targetDir, "pulled.tar.zst")
-					require.NoError(t, os.WriteFile(artifact, []byte("artifact"), 0o600))
-					return &bundle.PullResult{OCIReference: ref, OutputPath: artifact}, nil
+			source := &bundle.DeploySource{BundlePath: bundlePath, SignedBy: []string{"release"}}
+			preparer := &recordingOCISourcePreparer{
+				prepare: func(context.Context, string, []string, bundle.PullOptions) (*bundle.DeploySource, error) {
+					return source, nil
 				},
 			}
-			runnerCalls := 0
-			runner := func(_ context.Context, _ iostreams.IOStreams, _ *bundle.UDSBundleConfig, bundlePath string, _ []string, _, _ bool) (*bundle.DeployResult, error) {
-				runnerCalls++
-				assert.FileExists(t, bundlePath)
-				return tt.result, nil
-			}
+			deployCalls := 0
 
 			o := NewDeployOptions(streams)
 			o.BundlePath = "oci://example.com/test:1.0.0"
+			o.Force = true
 			o.Verification.SkipSignatureVerification = true
-			o.pullBundle = puller.PullBundle
-			o.runDeploy = runner
+			o.prepareOCISource = preparer.Prepare
+			o.deployBundle = func(_ context.Context, got *bundle.DeploySource, opts bundle.DeployOptions) (*bundle.DeployResult, error) {
+				deployCalls++
+				assert.Same(t, source, got)
+				assert.True(t, opts.Force)
+				return tt.result, nil
+			}
+			o.runDeploy = func(context.Context, iostreams.IOStreams, *bundle.UDSBundleConfig, string, deployRunOptions) (*bundle.DeployResult, error) {
+				t.Fatal("OCI deploys must not use the path runner")
+				return nil, nil
+			}
 			bundleCmd := NewBundleCommand(streams)
 			deployCmd, _, err := bundleCmd.Find([]string{"deploy"})
 			require.NoError(t, err)
 			require.NoError(t, o.Complete(deployCmd, []string{o.BundlePath}))
-			o.flags = CLIFlags{TmpDir: tmpDir, TmpDirChanged: true}
+			o.flags = CLIFlags{TmpDir: t.TempDir(), TmpDirChanged: true}
 
 			require.NoError(t, o.Run(t.Context()))
-			assert.Equal(t, 1, runnerCalls)
-			assert.Equal(t, 1, puller.bundleCalls)
-			entries, err := os.ReadDir(tmpDir)
-			require.NoError(t, err)
-			assert.Empty(t, entries)
+			assert.Equal(t, 1, deployCalls)
+			assert.Equal(t, 1, preparer.calls)
 			if tt.wantOutput == "" {
 				assert.Empty(t, out.String())
 			} else {
+				assert.Contains(t, out.String(), "Bundle Name:  test-bundle")
 				assert.Contains(t, out.String(), tt.wantOutput)
-				assert.NotContains(t, out.String(), "OCI Reference")
 			}
 		})
 	}
 }
 
-func TestDeployOptions_Run_RejectsMalformedPullResults(t *testing.T) {
-	tests := []struct {
-		name   string
-		result *bundle.PullResult
-		want   string
-	}{
-		{name: "nil result", want: "puller returned no result"},
-		{name: "empty output path", result: &bundle.PullResult{}, want: "empty output path"},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			streams, _, _, _ := iostreams.NewTestIOStreams()
-			o := NewDeployOptions(streams)
-			o.BundlePath = "oci://example.com/test:1.0.0"
-			o.Verification.SkipSignatureVerification = true
-			o.pullBundle = (&recordingPuller{
-				pullBundle: func(context.Context, string, string, bundle.PullOptions) (*bundle.PullResult, error) {
-					return tt.result, nil
-				},
-			}).PullBundle
-			err := o.Run(t.Context())
-			require.ErrorContains(t, err, tt.want)
-		})
-	}
-}
-
-func TestDeployOptions_Run_RejectsUnsafePulledArtifacts(t *testing.T) {
-	externalArtifact := filepath.Join(t.TempDir(), "external.tar.zst")
-	require.NoError(t, os.WriteFile(externalArtifact, []byte("artifact"), 0o600))
-
-	tests := []struct {
-		name       string
-		outputPath func(string) string
-		want       string
-	}{
-		{
-			name: "path outside workspace",
-			outputPath: func(string) string {
-				return externalArtifact
-			},
-			want: "outside its workspace",
-		},
-		{
-			name: "symlink outside workspace",
-			outputPath: func(targetDir string) string {
-				path := filepath.Join(targetDir, "pulled.tar.zst")
-				require.NoError(t, os.Symlink(externalArtifact, path))
-				return path
-			},
-			want: "outside its workspace",
-		},
-		{
-			name: "source directory",
-			outputPath: func(targetDir string) string {
-				return targetDir
-			},
-			want: "outside its workspace",
-		},
-		{
-			name: "non artifact file",
-			outputPath: func(targetDir string) string {
-				path := filepath.Join(targetDir, "bundle.uds.hcl")
-				require.NoError(t, os.WriteFile(path, []byte("source"), 0o600))
-				return path
-			},
-			want: "non-artifact output path",
+func TestDeployOptions_Run_RejectsMissingOCISource(t *testing.T) {
+	streams, _, _, _ := iostreams.NewTestIOStreams()
+	o := NewDeployOptions(streams)
+	o.BundlePath = "oci://example.com/test:1.0.0"
+	o.Verification.SkipSignatureVerification = true
+	o.prepareOCISource = (&recordingOCISourcePreparer{
+		prepare: func(context.Context, string, []string, bundle.PullOptions) (*bundle.DeploySource, error) {
+			return nil, nil
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			streams, _, _, _ := iostreams.NewTestIOStreams()
-			runnerCalled := false
-			o := NewDeployOptions(streams)
-			o.BundlePath = "oci://example.com/test:1.0.0"
-			o.Verification.SkipSignatureVerification = true
-			o.pullBundle = (&recordingPuller{
-				pullBundle: func(_ context.Context, ref, targetDir string, _ bundle.PullOptions) (*bundle.PullResult, error) {
-					return &bundle.PullResult{OCIReference: ref, OutputPath: tt.outputPath(targetDir)}, nil
-				},
-			}).PullBundle
-			o.runDeploy = func(context.Context, iostreams.IOStreams, *bundle.UDSBundleConfig, string, []string, bool, bool) (*bundle.DeployResult, error) {
-				runnerCalled = true
-				return nil, nil
-			}
-
-			err := o.Run(t.Context())
-			require.ErrorContains(t, err, tt.want)
-			assert.False(t, runnerCalled)
-		})
-	}
+	}).Prepare
+	err := o.Run(t.Context())
+	require.ErrorIs(t, err, ErrPullBundle)
+	require.ErrorContains(t, err, "returned no source")
 }
 
 func TestDeployCommands_Flags(t *testing.T) {
@@ -352,17 +283,17 @@ func TestPromptConfirmation_BrokenReader(t *testing.T) {
 	assert.False(t, confirmed)
 }
 
-type recordingPuller struct {
-	bundleCalls int
-	pullBundle  func(context.Context, string, string, bundle.PullOptions) (*bundle.PullResult, error)
+type recordingOCISourcePreparer struct {
+	calls   int
+	prepare func(context.Context, string, []string, bundle.PullOptions) (*bundle.DeploySource, error)
 }
 
-func (p *recordingPuller) PullBundle(ctx context.Context, ref, targetDir string, opts bundle.PullOptions) (*bundle.PullResult, error) {
-	p.bundleCalls++
-	if p.pullBundle == nil {
-		return nil, fmt.Errorf("unexpected PullBundle call")
+func (p *recordingOCISourcePreparer) Prepare(ctx context.Context, ref string, packages []string, opts bundle.PullOptions) (*bundle.DeploySource, error) {
+	p.calls++
+	if p.prepare == nil {
+		return nil, fmt.Errorf("unexpected OCI source preparation")
 	}
-	return p.pullBundle(ctx, ref, targetDir, opts)
+	return p.prepare(ctx, ref, packages, opts)
 }
 
 type brokenReader struct {
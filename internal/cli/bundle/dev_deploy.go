@@ -19,11 +19,13 @@ const bundleDefinitionDiagnostic = "WARNING: deploying directly from a bundle de
 
 // DevDeployOptions holds options for bundle definition deployment.
 type DevDeployOptions struct {
-	BundlePath string
-	Packages   []string
-	Force      bool
-	Config     *bundlepkg.UDSBundleConfig
-	Printer    printer.ResourcePrinter
+	BundlePath  string
+	Packages    []string
+	Force       bool
+	Prune       bool
+	PruneDryRun bool
+	Config      *bundlepkg.UDSBundleConfig
+	Printer     printer.ResourcePrinter
 
 	flags     CLIFlags
 	runDeploy deployRunnerFunc
@@ -46,7 +48,8 @@ func NewDevDeployCommand(streams iostreams.IOStreams) *cobra.Command {
 		Long: `Deploy a UDS bundle directly from its bundle definition (bundle.uds.hcl).
 
 The optional bundle-definition can be a directory containing bundle.uds.hcl or
-a direct path to bundle.uds.hcl. If omitted, the current directory is used.
+bundle.uds.json, or a direct path to either file. If omitted, the current
+directory is used.
 
 This development workflow does not create an intermediate bundle artifact, so
 bundle provenance and bundle-signature verification are unavailable. Created
@@ -68,6 +71,7 @@ local and OCI bundle artifacts must use uds bundle deploy instead.`,
 	}
 
 	addDeployFlags(cmd, &o.Packages, &o.Force)
+	addPruneFlags(cmd, &o.Prune, &o.PruneDryRun)
 
 	return cmd
 }
@@ -90,7 +94,10 @@ func (o *DevDeployOptions) Complete(cmd *cobra.Command, args []string) error {
 
 // Validate validates development deploy options without modifying state.
 func (o *DevDeployOptions) Validate() error {
-	return ValidateDevDeployPath(o.BundlePath)
+	if err := ValidateDevDeployPath(o.BundlePath); err != nil {
+		return err
+	}
+	return validatePruneFlags(o.Prune, o.PruneDryRun)
 }
 
 // Run executes bundle definition deployment.
@@ -112,7 +119,14 @@ func (o *DevDeployOptions) Run(ctx context.Context) error {
 	if runner == nil {
 		runner = runDeploy
 	}
-	result, err := runner(ctx, o.IOStreams, baseConfig, resolveBundlePath(o.BundlePath), o.Packages, o.Force, o.flags.Prompt)
+	result, err := runner(ctx, o.IOStreams, baseConfig, resolveBundlePath(o.BundlePath), deployRunOptions{
+		Packages:    o.Packages,
+		Force:       o.Force,
+		Prompt:      o.flags.Prompt,
+		Prune:       o.Prune,
+		PruneDryRun: o.PruneDryRun,
+		ConfigPath:  o.flags.ConfigPath,
+	})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,122 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// DiffOptions holds the options for the diff command.
+type DiffOptions struct {
+	Old     string // Path to a .tar.zst artifact or OCI reference
+	New     string // Path to a .tar.zst artifact or OCI reference
+	Config  *bundle.UDSBundleConfig
+	Printer printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewDiffOptions returns a new DiffOptions with default values.
+func NewDiffOptions(streams iostreams.IOStreams) *DiffOptions {
+	return &DiffOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewDiffCommand creates the diff command.
+func NewDiffCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewDiffOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "diff <old-bundle> <new-bundle>",
+		Short: "Compare two UDS bundles",
+		Long: "Compare two built UDS bundles from local .tar.zst artifacts or OCI references, reporting package, " +
+			"version, digest, dependency, defaults, values file, and image changes. Package layers are not pulled " +
+			"and bundle signatures are not verified.",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *DiffOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 1 {
+		o.Old = args[0]
+		o.New = args[1]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// Use the embedded definitions; skip sibling defaults.
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, SnapshotFlags(cmd), "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options without modifying state.
+func (o *DiffOptions) Validate() error {
+	if err := (bundle.DiffOptions{Old: o.Old, New: o.New, Config: o.Config}).Validate(); err != nil {
+		return err
+	}
+	for _, source := range []string{o.Old, o.New} {
+		if isOCIReference(source) {
+			continue
+		}
+		info, err := os.Stat(source)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("bundle artifact not found: %s: %w: %w", source, ErrPathNotFound, err)
+			}
+			return fmt.Errorf("cannot access bundle artifact %s: %w: %w", source, ErrInvalidPath, err)
+		}
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("bundle artifact path is not a regular file: %s: %w", source, ErrInvalidPath)
+		}
+	}
+	return nil
+}
+
+// Run executes the diff command.
+func (o *DiffOptions) Run(ctx context.Context) error {
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Info("comparing bundles", "old", o.Old, "new", o.New)
+	result, err := bundle.Diff(ctx, bundle.DiffOptions{
+		Old:     o.Old,
+		New:     o.New,
+		Config:  o.Config,
+		Streams: o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
@@ -17,9 +17,9 @@ var (
 	ErrForceRequired         = errors.New("operation requires force")
 	ErrPullBundle            = errors.New("pulling bundle")
 	ErrPushBundle            = errors.New("pushing bundle")
-	ErrCreateWorkspace       = errors.New("creating bundle workspace")
 	ErrResolvePath           = errors.New("resolving path")
-	ErrUnsafePullOutput      = errors.New("unsafe pull output path")
 	ErrReadConfirmation      = errors.New("reading confirmation")
+	ErrReadVariable          = errors.New("reading variable")
 	ErrWriteDefinitionNotice = errors.New("writing bundle definition notice")
+	ErrLintFindings          = errors.New("bundle lint reported errors")
 )
@@ -0,0 +1,132 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	units "github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+// ExportOptions holds options for the export command.
+type ExportOptions struct {
+	Source     string
+	OutputDir  string
+	VolumeSize string
+	Config     *bundle.UDSBundleConfig
+	Printer    printer.ResourcePrinter
+
+	volumeBytes int64
+
+	iostreams.IOStreams
+}
+
+// NewExportOptions returns an ExportOptions with default values.
+func NewExportOptions(streams iostreams.IOStreams) *ExportOptions {
+	return &ExportOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewExportCommand creates the export command.
+func NewExportCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewExportOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "export <bundle-tarball> <output-dir>",
+		Short: "Export a bundle to an OCI layout for offline transfer",
+		Long: `Export a UDS bundle archive to an unpacked OCI image layout directory for
+transfer on removable media.
+
+With --volume-size, the layout is split across volume-001, volume-002, ...
+directories that each fit on a volume of that size; blobs larger than a volume
+are split into parts. Every volume holds a manifest listing all volumes with
+per-file digests and per-volume checksums. Use 'uds bundle import' to verify and
+reassemble the volumes.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringVar(&o.VolumeSize, "volume-size", "", "largest volume to write, such as 4GB or 700MB; unlimited when empty")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *ExportOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Source = args[0]
+	}
+	if len(args) > 1 {
+		o.OutputDir = args[1]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, SnapshotFlags(cmd), "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options.
+// Config validation is performed by the library entry point.
+func (o *ExportOptions) Validate() error {
+	if o.Source == "" {
+		return fmt.Errorf("bundle tarball is required: %w", ErrInvalidArgument)
+	}
+	if o.OutputDir == "" {
+		return fmt.Errorf("output directory is required: %w", ErrInvalidArgument)
+	}
+	o.volumeBytes = 0
+	if o.VolumeSize != "" {
+		size, err := units.FromHumanSize(o.VolumeSize)
+		if err != nil {
+			return fmt.Errorf("invalid --volume-size %q: %w: %w", o.VolumeSize, ErrInvalidArgument, err)
+		}
+		if size <= 0 {
+			return fmt.Errorf("--volume-size must be positive, got %q: %w", o.VolumeSize, ErrInvalidArgument)
+		}
+		o.volumeBytes = size
+	}
+	return nil
+}
+
+// Run executes the export command.
+func (o *ExportOptions) Run(ctx context.Context) error {
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Info("exporting bundle", "source", o.Source, "output_dir", o.OutputDir)
+	result, err := bundle.Export(ctx, o.Source, o.OutputDir, bundle.ExportOptions{
+		Config:     o.Config,
+		VolumeSize: o.volumeBytes,
+		Streams:    o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
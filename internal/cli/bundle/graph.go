@@ -0,0 +1,152 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// Graph-only output formats.
+const (
+	outputDOT     = "dot"
+	outputMermaid = "mermaid"
+)
+
+// GraphOptions holds options for the graph command.
+type GraphOptions struct {
+	Source   string // Bundle file, directory, .tar.zst artifact or OCI reference
+	Packages []string
+	Format   string // dot or mermaid; empty uses Printer
+	Config   *bundle.UDSBundleConfig
+	Printer  printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewGraphOptions returns a GraphOptions with default values.
+func NewGraphOptions(streams iostreams.IOStreams) *GraphOptions {
+	return &GraphOptions{
+		Source:    ".",
+		IOStreams: streams,
+	}
+}
+
+// NewGraphCommand creates the graph command.
+func NewGraphCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewGraphOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "graph [bundle|artifact]",
+		Short: "Show the package dependency graph of a bundle",
+		Long: `Show the order deploy follows for the packages of a bundle definition, a local
+.tar.zst artifact or an OCI reference. Packages are grouped into levels that
+deploy one after another; the packages of a level deploy in parallel. The
+critical path, the longest dependency chain, and each package's fan-in, the
+number of packages depending on it, are reported with the graph.
+
+Output is text, json or yaml, or a graph for rendering: -o dot for Graphviz or
+-o mermaid for Mermaid. Levels are drawn as clusters and the critical path in
+bold.
+
+--packages highlights the selected packages together with every package they
+depend on, which a deploy of only those packages needs, and every package
+depending on them, which a removal of those packages would break.`,
+		Example: `  uds bundle graph
+  uds bundle graph ./bundles/core -o dot | dot -Tsvg > core.svg
+  uds bundle graph core.tar.zst -o mermaid --packages keycloak`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(&o.Packages, "packages", "p", nil, "packages to highlight with their dependencies and dependents (comma-separated)")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *GraphOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Source = args[0]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	bundleDir := o.Source
+	if isOCIReference(o.Source) || isTarZst(o.Source) {
+		// Use the embedded definition; skip sibling defaults.
+		bundleDir = ""
+	}
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, SnapshotFlags(cmd), bundleDir)
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	if f := cmd.Flags().Lookup("output"); f != nil {
+		switch format := strings.ToLower(f.Value.String()); format {
+		case outputDOT, outputMermaid:
+			o.Format = format
+			return nil
+		}
+	}
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options without modifying state.
+// Config and package validation are performed by the library entry point.
+func (o *GraphOptions) Validate() error {
+	if isOCIReference(o.Source) {
+		_, err := udsoci.ReferenceIdentifier(o.Source)
+		return err
+	}
+	return ValidateBundlePath(o.Source, AllowArtifactBundlePath())
+}
+
+// Run executes the graph command.
+func (o *GraphOptions) Run(ctx context.Context) error {
+	source := o.Source
+	if !isOCIReference(source) && !isTarZst(source) {
+		source = resolveBundlePath(source)
+	}
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Debug("graphing bundle", "source", source, "packages", o.Packages)
+
+	result, err := bundle.Graph(ctx, source, bundle.GraphOptions{
+		Config:   o.Config,
+		Packages: o.Packages,
+		Streams:  o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	switch o.Format {
+	case outputDOT:
+		return result.WriteDOT(o.Out())
+	case outputMermaid:
+		return result.WriteMermaid(o.Out())
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
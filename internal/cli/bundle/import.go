@@ -0,0 +1,113 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// ImportOptions holds options for the import command.
+type ImportOptions struct {
+	Volumes      []string
+	OutputDir    string
+	OCIReference string
+	Config       *bundle.UDSBundleConfig
+	Printer      printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewImportOptions returns an ImportOptions with default values.
+func NewImportOptions(streams iostreams.IOStreams) *ImportOptions {
+	return &ImportOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewImportCommand creates the import command.
+func NewImportCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewImportOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "import <volume-dir>...",
+		Short: "Import a bundle exported for offline transfer",
+		Long: `Import a UDS bundle written by 'uds bundle export'.
+
+Each argument is a volume directory or a directory holding volume-* directories.
+Every file is checked against the export manifest, all volumes must be present,
+and the reassembled OCI layout is verified before the bundle is written as a
+.tar.zst archive, or pushed to a registry with --push.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.OutputDir, "output-dir", "d", "", "directory to write the bundle tarball (default: current directory)")
+	cmd.Flags().StringVar(&o.OCIReference, "push", "", "push the imported bundle to this OCI reference instead of writing a tarball")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *ImportOptions) Complete(cmd *cobra.Command, args []string) error {
+	o.Volumes = args
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, SnapshotFlags(cmd), "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options.
+// Config validation is performed by the library entry point.
+func (o *ImportOptions) Validate() error {
+	if len(o.Volumes) == 0 {
+		return fmt.Errorf("at least one volume directory is required: %w", ErrInvalidArgument)
+	}
+	if o.OutputDir != "" && o.OCIReference != "" {
+		return fmt.Errorf("--output-dir and --push are mutually exclusive: %w", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// Run executes the import command.
+func (o *ImportOptions) Run(ctx context.Context) error {
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Info("importing bundle", "volumes", o.Volumes)
+	result, err := bundle.Import(ctx, o.Volumes, bundle.ImportOptions{
+		Config:       o.Config,
+		OutputDir:    o.OutputDir,
+		OCIReference: o.OCIReference,
+		Streams:      o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
@@ -167,7 +167,7 @@ func (o *InspectOptions) verificationRequested() bool {
 	if o.Verification.SkipSignatureVerification {
 		return false
 	}
-	return o.Verification.PublicKey != "" || o.Verification.Identity != "" || o.Verification.IdentityRE != "" ||
+	return len(o.Verification.PublicKeys) != 0 || o.Verification.Identity != "" || o.Verification.IdentityRE != "" ||
 		o.Verification.Issuer != "" || o.Verification.IssuerRE != "" || o.Verification.TrustedRoot != "" ||
 		(o.Config != nil && o.Config.SignatureVerification != nil)
 }
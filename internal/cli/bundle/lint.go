@@ -0,0 +1,147 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// outputSARIF is the lint-only output format for code scanning tools.
+const outputSARIF = "sarif"
+
+// LintOptions holds options for the lint command.
+type LintOptions struct {
+	BundlePath string // Path to bundle file or directory (user input, resolved in Run)
+	ConfigPath string
+	Enable     []string
+	Disable    []string
+	SARIF      bool
+	Config     *bundle.UDSBundleConfig
+	Printer    printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewLintOptions returns a LintOptions with default values.
+func NewLintOptions(streams iostreams.IOStreams) *LintOptions {
+	return &LintOptions{
+		BundlePath: ".",
+		IOStreams:  streams,
+	}
+}
+
+// NewLintCommand creates the lint command.
+func NewLintCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewLintOptions(streams)
+
+	var rules strings.Builder
+	for _, rule := range bundle.LintRules() {
+		fmt.Fprintf(&rules, "  %-28s %-8s %s\n", rule.ID, rule.Severity, rule.Description)
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint [directory]",
+		Short: "Check a bundle definition for common mistakes",
+		Long: `Check bundle.uds.hcl, defaults.uds.hcl and config.uds.hcl, or their .json
+forms, for problems that create does not reject. Each finding names the file,
+line and column it refers to. Output is text, json, yaml or sarif (-o sarif)
+for code scanning tools.
+
+The command fails when any error-severity rule reports a finding. Rules can be
+selected with --enable or skipped with --disable:
+
+` + rules.String(),
+		Example: `  uds bundle lint
+  uds bundle lint ./bundles/core --disable missing-namespace,unused-default
+  uds bundle lint -o sarif > lint.sarif`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&o.Enable, "enable", nil, "run only these lint rules")
+	cmd.Flags().StringSliceVar(&o.Disable, "disable", nil, "skip these lint rules")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *LintOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.BundlePath = args[0]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	flags := SnapshotFlags(cmd)
+	cfg, configPath, err := NewConfigResolver().Resolve(ctx, o.IOStreams, flags, o.BundlePath)
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+	o.ConfigPath = configPath
+
+	if f := cmd.Flags().Lookup("output"); f != nil && strings.EqualFold(f.Value.String(), outputSARIF) {
+		o.SARIF = true
+		return nil
+	}
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options without modifying state.
+// Config and rule validation are performed by the library entry point.
+func (o *LintOptions) Validate() error {
+	return ValidateBundlePath(o.BundlePath)
+}
+
+// Run executes the lint command.
+func (o *LintOptions) Run(ctx context.Context) error {
+	bundlePath := resolveBundlePath(o.BundlePath)
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Debug("linting bundle", "source", bundlePath)
+
+	result, err := bundle.Lint(ctx, bundlePath, bundle.LintOptions{
+		Config:     o.Config,
+		ConfigFile: o.ConfigPath,
+		Enable:     o.Enable,
+		Disable:    o.Disable,
+		Streams:    o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	if o.SARIF {
+		err = result.WriteSARIF(o.Out())
+	} else {
+		err = o.Printer.PrintObj(result, o.Out())
+	}
+	if err != nil {
+		return err
+	}
+	if result.Errors > 0 {
+		return fmt.Errorf("%d errors and %d warnings in %q: %w", result.Errors, result.Warnings, bundlePath, ErrLintFindings)
+	}
+	return nil
+}
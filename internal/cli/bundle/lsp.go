@@ -0,0 +1,88 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// LSPOptions holds options for the lsp command.
+type LSPOptions struct {
+	Config *bundle.UDSBundleConfig
+
+	iostreams.IOStreams
+}
+
+// NewLSPOptions returns an LSPOptions with default values.
+func NewLSPOptions(streams iostreams.IOStreams) *LSPOptions {
+	return &LSPOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewLSPCommand creates the lsp command.
+func NewLSPCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewLSPOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run a language server for bundle files over stdio",
+		Long: `Run a Language Server Protocol server for bundle.uds.hcl, defaults.uds.hcl and
+config.uds.hcl, and their .json forms, on standard input and output. Configure
+your editor to start "uds bundle lsp" for these files.
+
+Open files are checked as they change, with the parser and validation create
+uses, and each problem is reported at the range it refers to. The server also
+completes block and attribute names and package, bundle and local references,
+jumps to the package a depends_on entry names and to local definitions, and
+shows documentation on hover. Bundles including other bundles are not fetched,
+so their validation is left to create.
+
+Logs are written to standard error.`,
+		Example: `  uds bundle lsp
+  uds bundle lsp --log-level debug 2> uds-lsp.log`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *LSPOptions) Complete(cmd *cobra.Command, _ []string) error {
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, SnapshotFlags(cmd), "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+	return nil
+}
+
+// Validate validates the options without modifying state.
+// Config validation is performed by the library entry point.
+func (o *LSPOptions) Validate() error {
+	return nil
+}
+
+// Run executes the lsp command.
+func (o *LSPOptions) Run(ctx context.Context) error {
+	return bundle.ServeLSP(ctx, bundle.LSPOptions{
+		Config:  o.Config,
+		Streams: o.IOStreams,
+	})
+}
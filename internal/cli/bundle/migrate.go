@@ -0,0 +1,180 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// legacyBundleFileNames are the names legacy bundle definitions were looked up by.
+var legacyBundleFileNames = []string{"uds-bundle.yaml", "uds-bundle.yml"}
+
+// MigrateOptions holds options for the migrate command.
+type MigrateOptions struct {
+	BundlePath string // Path to uds-bundle.yaml or its directory (user input, resolved in Run)
+	ConfigPath string // Legacy uds-config.yaml, read from --config
+	OutputDir  string
+	Overwrite  bool
+	Config     *bundle.UDSBundleConfig
+	Printer    printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewMigrateOptions returns a MigrateOptions with default values.
+func NewMigrateOptions(streams iostreams.IOStreams) *MigrateOptions {
+	return &MigrateOptions{
+		BundlePath: ".",
+		IOStreams:  streams,
+	}
+}
+
+// NewMigrateCommand creates the migrate command.
+func NewMigrateCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewMigrateOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "migrate [uds-bundle.yaml]",
+		Short: "Convert a legacy uds-bundle.yaml to bundle.uds.hcl",
+		Long: `Convert a legacy uds-bundle.yaml, and the uds-config.yaml given with --config
+or found next to it, into bundle.uds.hcl, defaults.uds.hcl, config.uds.hcl and
+a values file per package with chart overrides.
+
+Repository and ref become the package source and publicKey or
+keylessVerification become signature_verification. Chart override values are
+written under <component>.<chart> in values/<package>.yaml, and override
+variables are read there as .vars.<name>, lowercased, and declared in
+defaults.uds.hcl. Packages depend on the one before them to keep the legacy
+deploy order.
+
+Settings without an equivalent, such as imports and exports, are reported as
+todo diagnostics and as TODO comments in the package block. Existing files are
+not replaced unless --overwrite is set.`,
+		Example: `  uds bundle migrate
+  uds bundle migrate ./bundles/core/uds-bundle.yaml --config ./uds-config.yaml
+  uds bundle migrate uds-bundle.yaml --output-dir ./next -o yaml`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringVarP(&o.OutputDir, "output-dir", "d", "", "directory to write the generated files (default: directory of uds-bundle.yaml)")
+	cmd.Flags().BoolVar(&o.Overwrite, "overwrite", false, "replace generated files that already exist")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *MigrateOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.BundlePath = args[0]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	// --config names the legacy uds-config.yaml to convert, not a
+	// config.uds.hcl to resolve options from.
+	flags := SnapshotFlags(cmd)
+	o.ConfigPath = flags.ConfigPath
+	flags.ConfigPath = ""
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, flags, "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options without modifying state.
+func (o *MigrateOptions) Validate() error {
+	if o.BundlePath == "" {
+		return fmt.Errorf("legacy bundle path is required: %w", ErrInvalidArgument)
+	}
+	if _, err := os.Stat(o.BundlePath); err != nil {
+		return fmt.Errorf("legacy bundle %q: %w", o.BundlePath, ErrPathNotFound)
+	}
+	if o.ConfigPath == "" {
+		return nil
+	}
+	if !isYAMLFile(o.ConfigPath) {
+		return fmt.Errorf("--config %q must be a legacy uds-config.yaml: %w", o.ConfigPath, ErrInvalidPath)
+	}
+	if _, err := os.Stat(o.ConfigPath); err != nil {
+		return fmt.Errorf("--config %q: %w", o.ConfigPath, ErrPathNotFound)
+	}
+	return nil
+}
+
+// Run executes the migrate command.
+func (o *MigrateOptions) Run(ctx context.Context) error {
+	bundleFile, err := resolveLegacyBundlePath(o.BundlePath)
+	if err != nil {
+		return err
+	}
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Debug("migrating legacy bundle", "source", bundleFile)
+
+	result, err := bundle.Migrate(ctx, bundleFile, bundle.MigrateOptions{
+		Config:     o.Config,
+		ConfigFile: o.ConfigPath,
+		OutputDir:  o.OutputDir,
+		Overwrite:  o.Overwrite,
+		Streams:    o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
+
+// resolveLegacyBundlePath returns path, or the legacy bundle definition in
+// path when it is a directory.
+func resolveLegacyBundlePath(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("legacy bundle %q: %w", path, ErrPathNotFound)
+	}
+	if !info.IsDir() {
+		if !isYAMLFile(path) {
+			return "", fmt.Errorf("legacy bundle %q must be a uds-bundle.yaml: %w", path, ErrInvalidPath)
+		}
+		return path, nil
+	}
+	for _, name := range legacyBundleFileNames {
+		candidate := filepath.Join(path, name)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("no %s in %q: %w", legacyBundleFileNames[0], path, ErrPathNotFound)
+}
+
+func isYAMLFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
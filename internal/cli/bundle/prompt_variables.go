@@ -0,0 +1,129 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	bundlepkg "github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"golang.org/x/term"
+)
+
+type unsetVariablesFunc func(ctx context.Context, source *bundlepkg.DeploySource, opts bundlepkg.DeployOptions) ([]bundlepkg.UnsetVariable, error)
+
+// promptVariables asks for each variable the deploy would read without a
+// value and returns the config with the answers set. An empty answer leaves
+// a variable unset, and EOF stops prompting. When anything non-sensitive was
+// answered, it offers to write those answers to configPath, or to
+// config.uds.hcl in the working directory when no --config was given.
+// Sensitive answers are never written.
+func promptVariables(ctx context.Context, streams iostreams.IOStreams, unset []bundlepkg.UnsetVariable, config *bundlepkg.UDSBundleConfig, configPath string) (*bundlepkg.UDSBundleConfig, error) {
+	if len(unset) == 0 {
+		return config, nil
+	}
+	streams.Info("bundle variables are unset", "count", len(unset))
+
+	answers, saved := bundlepkg.Variables{}, bundlepkg.Variables{}
+	skipped := 0
+	for _, v := range unset {
+		answer, ok, err := promptVariable(streams, v)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if answer == "" {
+			streams.Debug("variable left unset", "name", v.Name)
+			continue
+		}
+		value, err := v.ParseValue(answer)
+		if err != nil {
+			return nil, err
+		}
+		answers.Set(v.Name, value)
+		if v.Sensitive {
+			skipped++
+		} else {
+			saved.Set(v.Name, value)
+		}
+	}
+	if len(answers) == 0 {
+		return config, nil
+	}
+
+	resolved := *config
+	resolved.Variables = mergeVariables(config.Variables, answers)
+	if len(saved) == 0 {
+		return &resolved, nil
+	}
+	if configPath == "" {
+		configPath = bundleinternal.ConfigFileName
+	}
+	write, err := PromptConfirmation(streams, fmt.Sprintf("Write these answers to %s?", configPath))
+	if err != nil {
+		return nil, err
+	}
+	if write {
+		if err := bundlepkg.WriteConfigVariables(ctx, configPath, saved); err != nil {
+			return nil, err
+		}
+		streams.Info("variables written", "path", configPath, "sensitive_skipped", skipped)
+	}
+	return &resolved, nil
+}
+
+// promptVariable writes the prompt for v to streams.ErrOut and reads the
+// answer. Sensitive answers are read without echo when streams.In is a
+// terminal. ok is false at EOF.
+func promptVariable(streams iostreams.IOStreams, v bundlepkg.UnsetVariable) (string, bool, error) {
+	prompt := "\n" + v.Name + " (" + v.Type + ")"
+	if v.Description != "" {
+		prompt += " - " + v.Description
+	}
+	_, _ = fmt.Fprint(streams.ErrOut(), prompt+": ")
+
+	if f, ok := streams.In().(*os.File); ok && v.Sensitive && term.IsTerminal(int(f.Fd())) {
+		answer, err := term.ReadPassword(int(f.Fd()))
+		_, _ = fmt.Fprintln(streams.ErrOut())
+		if err != nil {
+			return "", false, fmt.Errorf("%w for variable %q: %w", ErrReadVariable, v.Name, err)
+		}
+		return string(answer), true, nil
+	}
+	answer, ok, err := readLine(streams.In())
+	if err != nil {
+		return "", false, fmt.Errorf("%w for variable %q: %w", ErrReadVariable, v.Name, err)
+	}
+	return answer, ok, nil
+}
+
+// readLine reads one line from in a byte at a time, so input after it is
+// left for later prompts. ok is false at EOF before any input.
+func readLine(in io.Reader) (string, bool, error) {
+	var line []byte
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				return strings.TrimSuffix(string(line), "\r"), true, nil
+			}
+			line = append(line, buf[0])
+		}
+		if errors.Is(err, io.EOF) {
+			return string(line), len(line) > 0, nil
+		}
+		if err != nil {
+			return "", false, err
+		}
+	}
+}
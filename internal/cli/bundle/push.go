@@ -21,6 +21,7 @@ import (
 type PushOptions struct {
 	Tarball      string
 	OCIReference string
+	Split        string
 	Prompt       bool
 	Config       *bundle.UDSBundleConfig
 	Printer      printer.ResourcePrinter
@@ -40,10 +41,14 @@ func NewPushCommand(streams iostreams.IOStreams) *cobra.Command {
 	o := NewPushOptions(streams)
 
 	cmd := &cobra.Command{
-		Use:   "push <bundle-tarball> <oci-reference>",
+		Use:   "push <bundle-tarball> [oci-reference]",
 		Short: "Push a bundle to an OCI registry",
-		Long:  "Push a UDS bundle tarball to a remote OCI registry",
-		Args:  cobra.ExactArgs(2),
+		Long: `Push a UDS bundle tarball to a remote OCI registry.
+
+With --split, the bundle itself is not pushed. Instead each Zarf package in the
+bundle is published as a standalone package at <registry-prefix>/<name>:<version>,
+with the package flavor appended to the tag when it has one.`,
+		Args: cobra.RangeArgs(1, 2),
 		Run: func(cmd *cobra.Command, args []string) {
 			util.CheckErr(o.Complete(cmd, args))
 			util.CheckErr(o.Validate())
@@ -52,6 +57,8 @@ func NewPushCommand(streams iostreams.IOStreams) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&o.Split, "split", "", "publish each bundle package as a standalone Zarf package under this registry prefix instead of pushing the bundle")
+
 	return cmd
 }
 
@@ -97,6 +104,12 @@ func (o *PushOptions) Validate() error {
 		}
 		return fmt.Errorf("cannot access bundle file %s: %w: %w", o.Tarball, ErrInvalidPath, err)
 	}
+	if o.Split != "" {
+		if o.OCIReference != "" {
+			return fmt.Errorf("an OCI reference cannot be combined with --split: %w", ErrInvalidArgument)
+		}
+		return nil
+	}
 	if o.OCIReference == "" {
 		return fmt.Errorf("OCI reference is required: %w", ErrInvalidArgument)
 	}
@@ -106,7 +119,7 @@ func (o *PushOptions) Validate() error {
 // Run executes the push command.
 func (o *PushOptions) Run(ctx context.Context) error {
 	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
-	o.Debug("pushing bundle", "tarball", o.Tarball, "ref", o.OCIReference)
+	o.Debug("pushing bundle", "tarball", o.Tarball, "ref", o.OCIReference, "split", o.Split)
 	if o.Prompt {
 		confirmed, err := PromptConfirmation(o.IOStreams, "Push this bundle?")
 		if err != nil {
@@ -124,6 +137,14 @@ func (o *PushOptions) Run(ctx context.Context) error {
 	if err := pushOpts.Validate(); err != nil {
 		return err
 	}
+	if o.Split != "" {
+		o.Info("pushing bundle packages", "tarball", o.Tarball, "prefix", o.Split)
+		result, err := bundle.PushPackages(ctx, o.Tarball, o.Split, pushOpts)
+		if err != nil {
+			return err
+		}
+		return o.Printer.PrintObj(result, o.Out())
+	}
 	o.Info("pushing bundle", "tarball", o.Tarball, "ref", o.OCIReference)
 
 	result, err := bundle.Push(ctx, o.Tarball, o.OCIReference, pushOpts)
@@ -65,3 +65,20 @@ func TestPushOptions_Run_PromptDecline(t *testing.T) {
 		})
 	}
 }
+
+func TestPushOptions_Validate_Split(t *testing.T) {
+	tarball := filepath.Join(t.TempDir(), "bundle.tar.zst")
+	require.NoError(t, os.WriteFile(tarball, []byte("fake"), 0o600))
+
+	o := &PushOptions{Tarball: tarball, Split: "oci://example.com/packages"}
+	require.NoError(t, o.Validate())
+
+	o.OCIReference = "oci://example.com/bundle:v1"
+	require.ErrorContains(t, o.Validate(), "cannot be combined with --split")
+
+	o.Split = ""
+	require.NoError(t, o.Validate())
+
+	o.OCIReference = ""
+	require.ErrorContains(t, o.Validate(), "OCI reference is required")
+}
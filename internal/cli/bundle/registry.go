@@ -0,0 +1,22 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// NewRegistryCommand creates the bundle registry maintenance parent command.
+func NewRegistryCommand(streams iostreams.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registry",
+		Short: "Maintain bundle repositories in OCI registries",
+		Long:  "Maintain the OCI registry repositories UDS bundles are published to",
+	}
+
+	cmd.AddCommand(NewRegistryGCCommand(streams))
+
+	return cmd
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// RegistryGCOptions holds options for the registry gc command.
+type RegistryGCOptions struct {
+	Repository string
+	DryRun     bool
+	KeepLast   int
+	Prompt     bool
+	Config     *bundle.UDSBundleConfig
+	Printer    printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewRegistryGCOptions returns a RegistryGCOptions with default values.
+func NewRegistryGCOptions(streams iostreams.IOStreams) *RegistryGCOptions {
+	return &RegistryGCOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewRegistryGCCommand creates the registry gc command.
+func NewRegistryGCCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewRegistryGCOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "gc <repository>",
+		Short: "Delete unreachable bundle manifests from a repository",
+		Long: `Delete bundle manifests that no tag in a repository reaches any more.
+
+Re-pushing a bundle tag leaves the previous child indexes, package manifests,
+signatures and attestations in the registry. gc lists the repository's tags,
+walks every bundle they reference and deletes what is left over. With
+--keep-last, only the highest N semantic version tags are kept and the bundles
+behind older versions are deleted too; other tags are always kept.
+
+The registry must support the OCI distribution delete API. Blobs are reclaimed
+by the registry's own garbage collection once no manifest references them.`,
+		Example: `  uds bundle registry gc ghcr.io/org/bundles/app --dry-run
+  uds bundle registry gc ghcr.io/org/bundles/app --keep-last 5`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", false, "report what would be deleted without deleting anything")
+	cmd.Flags().IntVar(&o.KeepLast, "keep-last", 0, "keep only the N highest semantic version tags (0 keeps every tag)")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *RegistryGCOptions) Complete(cmd *cobra.Command, args []string) error {
+	if len(args) > 0 {
+		o.Repository = args[0]
+	}
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	flags := SnapshotFlags(cmd)
+	o.Prompt = flags.Prompt
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, flags, "")
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options.
+// Config validation is performed by the library entry point.
+func (o *RegistryGCOptions) Validate() error {
+	if o.Repository == "" {
+		return fmt.Errorf("repository is required: %w", ErrInvalidArgument)
+	}
+	if o.KeepLast < 0 {
+		return fmt.Errorf("--keep-last must not be negative, got %d: %w", o.KeepLast, ErrInvalidArgument)
+	}
+	return nil
+}
+
+// Run executes the registry gc command.
+func (o *RegistryGCOptions) Run(ctx context.Context) error {
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	o.Debug("collecting registry garbage", "repository", o.Repository, "dry-run", o.DryRun, "keep-last", o.KeepLast)
+	if o.Prompt && !o.DryRun {
+		confirmed, err := PromptConfirmation(o.IOStreams, "Delete unreachable manifests from this repository?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			o.Info("garbage collection cancelled")
+			return nil
+		}
+	}
+	result, err := bundle.CollectGarbage(ctx, o.Repository, bundle.GCOptions{
+		Config:   o.Config,
+		DryRun:   o.DryRun,
+		KeepLast: o.KeepLast,
+		Streams:  o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
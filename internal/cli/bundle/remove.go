@@ -20,11 +20,14 @@ import (
 // RemoveOptions holds options for the remove command.
 type RemoveOptions struct {
 	BundlePath string // Path to bundle file or directory (user input, resolved in Run)
-	Packages   []string
-	Force      bool
-	Prompt     bool
-	Config     *bundle.UDSBundleConfig
-	Printer    printer.ResourcePrinter
+	// Deployed names a deployed bundle to remove from cluster state instead of
+	// a bundle definition.
+	Deployed string
+	Packages []string
+	Force    bool
+	Prompt   bool
+	Config   *bundle.UDSBundleConfig
+	Printer  printer.ResourcePrinter
 
 	// parsedBundle is populated by Validate() after a successful parse and
 	// is consumed by Run(). Centralizing parsing in Validate() lets the
@@ -52,13 +55,18 @@ func NewRemoveCommand(streams iostreams.IOStreams) *cobra.Command {
 		Long: `Remove a UDS bundle from a Kubernetes cluster.
 
 The bundle-path can be:
-  - A directory containing bundle.uds.hcl
-  - A path to a bundle.uds.hcl file
+  - A directory containing bundle.uds.hcl or bundle.uds.json
+  - A path to a bundle.uds.hcl or bundle.uds.json file
   - If omitted, uses the bundle.uds.hcl file in current directory
 
 Packages are removed in reverse order (last deployed first) to respect
 dependency ordering. Use --packages to remove only specific packages.
 
+Use --deployed <bundle-name> instead of a bundle-path when the bundle
+definition or its package sources are no longer available. The packages and
+their dependency order are then rebuilt from metadata recorded on the deployed
+packages at deploy time.
+
 When --packages targets a package that other bundle packages depend on,
 removal is blocked. Pass --force to override the check.
 
@@ -75,6 +83,9 @@ Examples:
   # Remove only specific packages
   uds bundle remove --packages nginx,podinfo
 
+  # Remove a deployed bundle without its definition
+  uds bundle remove --deployed my-bundle
+
   # Force-remove a package even if other packages depend on it
   uds bundle remove --packages core --force
 
@@ -90,6 +101,7 @@ Examples:
 
 	cmd.Flags().StringSliceVarP(&o.Packages, "packages", "p", nil, "specific packages to remove (comma-separated)")
 	cmd.Flags().BoolVarP(&o.Force, "force", "f", false, "remove packages even if other bundle packages depend on them")
+	cmd.Flags().StringVar(&o.Deployed, "deployed", "", "name of a deployed bundle to remove using cluster state instead of a bundle definition")
 
 	return cmd
 }
@@ -98,7 +110,7 @@ Examples:
 func (o *RemoveOptions) Complete(cmd *cobra.Command, args []string) error {
 	if len(args) > 0 {
 		o.BundlePath = args[0]
-	} else {
+	} else if o.Deployed == "" {
 		o.BundlePath = "."
 	}
 
@@ -130,6 +142,14 @@ func (o *RemoveOptions) Complete(cmd *cobra.Command, args []string) error {
 // authoritative gate for direct callers. The parsed bundle is cached on
 // o.parsedBundle for Run() to consume.
 func (o *RemoveOptions) Validate() error {
+	if o.Deployed != "" {
+		// The deployed bundle is rebuilt from cluster state, so package names
+		// and dependency safety are checked by the library.
+		if o.BundlePath != "" {
+			return fmt.Errorf("--deployed cannot be combined with a bundle path: %w", ErrInvalidArgument)
+		}
+		return nil
+	}
 	if err := ValidateBundlePath(o.BundlePath); err != nil {
 		return err
 	}
@@ -164,8 +184,11 @@ func (o *RemoveOptions) Validate() error {
 }
 
 // Run executes the remove command. Validate() must have populated
-// o.parsedBundle.
+// o.parsedBundle unless --deployed is set.
 func (o *RemoveOptions) Run(ctx context.Context) error {
+	if o.Deployed != "" {
+		return o.runDeployed(ctx)
+	}
 	bundlePath := resolveBundlePath(o.BundlePath)
 	s := logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
 
@@ -201,3 +224,32 @@ func (o *RemoveOptions) Run(ctx context.Context) error {
 
 	return o.Printer.PrintObj(result, o.Out())
 }
+
+// runDeployed removes the bundle named by --deployed from cluster state.
+func (o *RemoveOptions) runDeployed(ctx context.Context) error {
+	s := logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	s.Info("deployed bundle to remove", "name", o.Deployed)
+
+	if o.Prompt {
+		confirmed, err := PromptConfirmation(o.IOStreams, "Remove this deployed bundle?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			s.Info("removal cancelled")
+			return nil
+		}
+	}
+
+	result, err := bundle.RemoveDeployed(ctx, o.Deployed, bundle.RemoveOptions{
+		Config:   o.Config,
+		Packages: o.Packages,
+		Force:    o.Force,
+		Streams:  o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+
+	return o.Printer.PrintObj(result, o.Out())
+}
@@ -299,3 +299,20 @@ func TestRemoveOptions_ForceFlag(t *testing.T) {
 	assert.Equal(t, "false", forceFlag.DefValue, "force should default to false")
 	assert.Equal(t, "f", forceFlag.Shorthand, "force should have -f as shorthand")
 }
+
+func TestRemoveOptions_Deployed(t *testing.T) {
+	streams, _, _, _ := iostreams.NewTestIOStreams()
+	defaults := NewConfigResolver().Defaults()
+
+	o := NewRemoveOptions(streams)
+	o.Deployed = "my-bundle"
+	cmd, _, _ := NewBundleCommand(streams).Find([]string{"remove"})
+	require.NotNil(t, cmd.Flags().Lookup("deployed"))
+	require.NoError(t, o.Complete(cmd, nil))
+	assert.Empty(t, o.BundlePath, "a deployed bundle does not default to the current directory")
+	require.NoError(t, o.Validate())
+	assert.Nil(t, o.parsedBundle)
+
+	o = &RemoveOptions{Deployed: "my-bundle", BundlePath: ".", Config: &bundle.UDSBundleConfig{Options: &defaults}, IOStreams: streams}
+	require.ErrorIs(t, o.Validate(), ErrInvalidArgument)
+}
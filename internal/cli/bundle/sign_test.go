@@ -38,3 +38,59 @@ func TestVerifyOptionsPolicy_TrustedRootOverridePreservesConfiguredKeylessPolicy
 	assert.Equal(t, "custom root", policy.Keyless.TrustedRoot)
 	assert.Equal(t, "configured root", configuredKeyless.TrustedRoot)
 }
+
+func TestVerifyOptionsPolicy_ConfiguredSignersAndFlagOverride(t *testing.T) {
+	configured := &bundlepkg.VerificationPolicy{
+		Signers:   []bundlepkg.TrustedSigner{{Name: "release", PublicKey: "key-a"}, {Name: "rotated", PublicKey: "key-b"}},
+		Threshold: 2,
+		Scopes:    []bundlepkg.BundleVerificationScope{{BundleName: "core-*", Signers: []string{"release"}}},
+	}
+	o := VerifyOptions{Config: &bundlepkg.UDSBundleConfig{SignatureVerification: configured}}
+
+	policy, err := o.policy()
+	require.NoError(t, err)
+	assert.Equal(t, *configured, policy)
+
+	o.Identity = "release@example.com"
+	o.Issuer = "https://issuer.example.com"
+	policy, err = o.policy()
+	require.NoError(t, err)
+	assert.Empty(t, policy.Signers)
+	assert.Empty(t, policy.Scopes)
+	assert.Zero(t, policy.Threshold)
+	require.NotNil(t, policy.Keyless)
+	assert.Equal(t, "release@example.com", policy.Keyless.CertificateIdentity)
+}
+
+func TestVerifyOptionsPolicy_RepeatablePublicKeysAndRequireAll(t *testing.T) {
+	dir := t.TempDir()
+	devKey := filepath.Join(dir, "dev.pub")
+	securityKey := filepath.Join(dir, "security.pub")
+	require.NoError(t, os.WriteFile(devKey, []byte("key-a"), 0o600))
+	require.NoError(t, os.WriteFile(securityKey, []byte("key-b"), 0o600))
+
+	o := VerifyOptions{PublicKeys: []string{devKey}}
+	policy, err := o.policy()
+	require.NoError(t, err)
+	assert.Equal(t, bundlepkg.VerificationPolicy{PublicKey: "key-a"}, policy)
+
+	o = VerifyOptions{PublicKeys: []string{devKey, securityKey}, RequireAll: true}
+	policy, err = o.policy()
+	require.NoError(t, err)
+	assert.Equal(t, bundlepkg.VerificationPolicy{
+		Signers: []bundlepkg.TrustedSigner{{Name: devKey, PublicKey: "key-a"}, {Name: securityKey, PublicKey: "key-b"}},
+		Rule:    bundlepkg.VerificationRuleAll,
+	}, policy)
+
+	o = VerifyOptions{
+		Config: &bundlepkg.UDSBundleConfig{SignatureVerification: &bundlepkg.VerificationPolicy{
+			Signers:   []bundlepkg.TrustedSigner{{Name: "release", PublicKey: "key-a"}, {Name: "rotated", PublicKey: "key-b"}},
+			Threshold: 1,
+		}},
+		RequireAll: true,
+	}
+	policy, err = o.policy()
+	require.NoError(t, err)
+	assert.Equal(t, bundlepkg.VerificationRuleAll, policy.Rule)
+	assert.Zero(t, policy.Threshold)
+}
@@ -15,8 +15,8 @@ type preparedDeploySource struct {
 	close  func() error
 }
 
-func prepareDeploySource(ctx context.Context, streams iostreams.IOStreams, path, tmpDir, architecture string) (*preparedDeploySource, error) {
-	source, err := bundlepkg.PrepareDeploySource(ctx, streams, path, tmpDir, architecture)
+func prepareDeploySource(ctx context.Context, streams iostreams.IOStreams, path, tmpDir, architecture string, packages []string) (*preparedDeploySource, error) {
+	source, err := bundlepkg.PrepareDeploySourceForPackages(ctx, streams, path, tmpDir, architecture, packages)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,120 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// UpdateOptions holds options for the update command.
+type UpdateOptions struct {
+	BundlePath string // Path to bundle file or directory (user input, resolved in Run)
+	Packages   []string
+	Prompt     bool
+	Config     *bundle.UDSBundleConfig
+	Printer    printer.ResourcePrinter
+
+	iostreams.IOStreams
+}
+
+// NewUpdateOptions returns an UpdateOptions with default values.
+func NewUpdateOptions(streams iostreams.IOStreams) *UpdateOptions {
+	return &UpdateOptions{
+		IOStreams: streams,
+	}
+}
+
+// NewUpdateCommand creates the update command.
+func NewUpdateCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewUpdateOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "update [package...]",
+		Short: "Update package versions pinned in bundle.lock.hcl",
+		Long: `Resolve OCI package sources again and rewrite bundle.lock.hcl.
+
+Package sources may use a semantic version constraint as their tag, for example
+oci://ghcr.io/uds/core:~0.40, or ~0.40-upstream to select a Zarf flavor. Create
+pins each OCI package to the tag and manifest digest it resolved to the first
+time, and keeps building from that digest until update is run. With package
+names, only those packages are updated.`,
+		Example: `  uds bundle update
+  uds bundle update core --bundle ./bundles/core`,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			ctx := cmd.Context()
+			util.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().StringVar(&o.BundlePath, "bundle", ".", "path to the bundle definition or its directory")
+
+	return cmd
+}
+
+// Complete fills in options from command line args.
+func (o *UpdateOptions) Complete(cmd *cobra.Command, args []string) error {
+	o.Packages = args
+
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	flags := SnapshotFlags(cmd)
+	o.Prompt = flags.Prompt
+	cfg, _, err := NewConfigResolver().Resolve(ctx, o.IOStreams, flags, o.BundlePath)
+	if err != nil {
+		return err
+	}
+	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
+
+	return nil
+}
+
+// Validate validates the options without modifying state.
+// Config validation is performed by the library entry point.
+func (o *UpdateOptions) Validate() error {
+	return ValidateBundlePath(o.BundlePath)
+}
+
+// Run executes the update command.
+func (o *UpdateOptions) Run(ctx context.Context) error {
+	bundlePath := resolveBundlePath(o.BundlePath)
+	o.IOStreams = logger.Bind(o.IOStreams, o.Config.Options.LogLevel)
+	if o.Prompt {
+		confirmed, err := PromptConfirmation(o.IOStreams, "Update the bundle lock file?")
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			o.Info("update cancelled")
+			return nil
+		}
+	}
+	o.Info("updating bundle lock", "source", bundlePath, "packages", o.Packages)
+
+	result, err := bundle.Update(ctx, bundlePath, bundle.UpdateOptions{
+		Config:   o.Config,
+		Packages: o.Packages,
+		Streams:  o.IOStreams,
+	})
+	if err != nil {
+		return err
+	}
+	return o.Printer.PrintObj(result, o.Out())
+}
@@ -55,8 +55,8 @@ func AllowArtifactBundlePath() ValidateBundlePathOption {
 //   - OCI references → error (not yet supported)
 //   - tar.zst archives → validated for existence if AllowArtifactBundlePath(); error otherwise
 //   - Path exists on filesystem
-//   - If directory, contains bundle.uds.hcl
-//   - If file, is named bundle.uds.hcl
+//   - If directory, contains bundle.uds.hcl or bundle.uds.json
+//   - If file, is named bundle.uds.hcl or bundle.uds.json
 //
 // Returns nil if valid, or an error describing the problem.
 func ValidateBundlePath(ref string, opts ...ValidateBundlePathOption) error {
@@ -102,24 +102,24 @@ func ValidateBundlePath(ref string, opts ...ValidateBundlePathOption) error {
 	}
 
 	if info.IsDir() {
-		// If it's a directory, check if bundle.uds.hcl exists in it
-		bundlePath := filepath.Join(ref, bundleFileName)
+		// If it's a directory, check if bundle.uds.hcl or bundle.uds.json exists in it
+		bundlePath := resolveBundlePath(ref)
 		bundleInfo, err := os.Stat(bundlePath)
 		if err != nil {
 			if os.IsNotExist(err) {
-				return fmt.Errorf("directory does not contain %s: %s: %w: %w", bundleFileName, ref, ErrInvalidPath, err)
+				return fmt.Errorf("directory does not contain %s or %s: %s: %w: %w", bundleFileName, bundleJSONFileName, ref, ErrInvalidPath, err)
 			}
-			return fmt.Errorf("cannot access %s in directory %s: %w: %w", bundleFileName, ref, ErrInvalidPath, err)
+			return fmt.Errorf("cannot access %s in directory %s: %w: %w", filepath.Base(bundlePath), ref, ErrInvalidPath, err)
 		}
 		if bundleInfo.IsDir() {
-			return fmt.Errorf("expected %s to be a file: %s: %w", bundleFileName, ref, ErrInvalidPath)
+			return fmt.Errorf("expected %s to be a file: %s: %w", filepath.Base(bundlePath), ref, ErrInvalidPath)
 		}
 		return nil
 	}
 
-	// It's a file - validate it's named bundle.uds.hcl
-	if filepath.Base(ref) != bundleFileName {
-		return fmt.Errorf("expected file named '%s', got: %s: %w", bundleFileName, filepath.Base(ref), ErrInvalidPath)
+	// It's a file - validate it's named bundle.uds.hcl or bundle.uds.json
+	if !bundleinternal.IsBundleFileName(filepath.Base(ref)) {
+		return fmt.Errorf("expected file named '%s' or '%s', got: %s: %w", bundleFileName, bundleJSONFileName, filepath.Base(ref), ErrInvalidPath)
 	}
 
 	return nil
@@ -131,19 +131,18 @@ func ValidateDevDeployPath(ref string) error {
 	info, err := os.Stat(ref)
 	if err == nil {
 		if info.IsDir() {
-			bundlePath := filepath.Join(ref, bundleFileName)
-			if _, err := os.Stat(bundlePath); err != nil {
-				return fmt.Errorf("directory does not contain %s: %s: %w: %w", bundleFileName, ref, ErrInvalidPath, err)
+			if _, err := os.Stat(resolveBundlePath(ref)); err != nil {
+				return fmt.Errorf("directory does not contain %s or %s: %s: %w: %w", bundleFileName, bundleJSONFileName, ref, ErrInvalidPath, err)
 			}
 			return nil
 		}
-		if filepath.Base(ref) == bundleFileName {
+		if bundleinternal.IsBundleFileName(filepath.Base(ref)) {
 			return nil
 		}
 		if isTarZst(ref) {
 			return fmt.Errorf("created bundle artifacts must be deployed with 'uds bundle deploy <bundle-artifact>': %w", ErrUnsupportedSource)
 		}
-		return fmt.Errorf("expected file named '%s', got: %s: %w", bundleFileName, filepath.Base(ref), ErrInvalidPath)
+		return fmt.Errorf("expected file named '%s' or '%s', got: %s: %w", bundleFileName, bundleJSONFileName, filepath.Base(ref), ErrInvalidPath)
 	}
 	if !os.IsNotExist(err) {
 		return fmt.Errorf("cannot access bundle definition %s: %w: %w", ref, ErrInvalidPath, err)
@@ -167,7 +166,7 @@ func ValidateArtifactReference(ref string) error {
 		if info.IsDir() {
 			return fmt.Errorf("bundle definitions must be deployed with 'uds bundle dev deploy <bundle-definition>': %w", ErrUnsupportedSource)
 		}
-		if filepath.Base(ref) == bundleFileName {
+		if bundleinternal.IsBundleFileName(filepath.Base(ref)) {
 			return fmt.Errorf("bundle definitions must be deployed with 'uds bundle dev deploy <bundle-definition>': %w", ErrUnsupportedSource)
 		}
 		if isTarZst(ref) {
@@ -184,7 +183,7 @@ func ValidateArtifactReference(ref string) error {
 	if isTarZst(ref) {
 		return fmt.Errorf("bundle artifact not found: %s: %w: %w", ref, ErrPathNotFound, err)
 	}
-	if filepath.Base(ref) == bundleFileName {
+	if bundleinternal.IsBundleFileName(filepath.Base(ref)) {
 		return fmt.Errorf("bundle definitions must be deployed with 'uds bundle dev deploy <bundle-definition>': %w", ErrUnsupportedSource)
 	}
 	if isOCIReference(ref) {
@@ -160,6 +160,12 @@ func TestValidateBundlePath(t *testing.T) {
 	validBundleFile := filepath.Join(validDir, bundleFileName)
 	require.NoError(t, os.WriteFile(validBundleFile, []byte("test content"), 0o600))
 
+	// Create a directory with only bundle.uds.json
+	jsonDir := filepath.Join(tempDir, "json")
+	require.NoError(t, os.Mkdir(jsonDir, 0o755))
+	jsonBundleFile := filepath.Join(jsonDir, bundleJSONFileName)
+	require.NoError(t, os.WriteFile(jsonBundleFile, []byte("{}"), 0o600))
+
 	// Create an empty directory (no bundle.uds.hcl)
 	emptyDir := filepath.Join(tempDir, "empty")
 	require.NoError(t, os.Mkdir(emptyDir, 0o755))
@@ -192,6 +198,16 @@ func TestValidateBundlePath(t *testing.T) {
 			ref:     validDir,
 			wantErr: "",
 		},
+		{
+			name:    "valid JSON file",
+			ref:     jsonBundleFile,
+			wantErr: "",
+		},
+		{
+			name:    "valid directory with bundle.uds.json",
+			ref:     jsonDir,
+			wantErr: "",
+		},
 		// Error cases - empty/invalid input
 		{
 			name:    "empty string",
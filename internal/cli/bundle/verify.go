@@ -9,6 +9,7 @@ import (
 	"os"
 
 	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
 	bundlepkg "github.com/defenseunicorns/uds-cli/pkg/bundle"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -18,13 +19,15 @@ import (
 type VerifyOptions struct {
 	Source                    string
 	Config                    *bundlepkg.UDSBundleConfig
-	PublicKey                 string
+	PublicKeys                []string
+	RequireAll                bool
 	Identity                  string
 	IdentityRE                string
 	Issuer                    string
 	IssuerRE                  string
 	TrustedRoot               string
 	SkipSignatureVerification bool
+	Printer                   printer.ResourcePrinter
 
 	iostreams.IOStreams
 }
@@ -58,6 +61,12 @@ func (o *VerifyOptions) Complete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	o.Config = cfg
+
+	p, err := ResolvePrinter(cmd)
+	if err != nil {
+		return err
+	}
+	o.Printer = p
 	return nil
 }
 
@@ -70,17 +79,25 @@ func (o *VerifyOptions) Validate() error {
 	return err
 }
 
-// Run verifies a bundle artifact.
+// Run verifies a bundle artifact and prints the verified signature and attestations.
 func (o *VerifyOptions) Run(ctx context.Context) error {
 	policy, err := o.policy()
 	if err != nil {
 		return err
 	}
-	return bundlepkg.Verify(ctx, bundlepkg.VerifyOptions{Source: o.Source, Policy: policy, Config: o.Config, TmpDir: o.Config.Options.TmpDir, Streams: o.IOStreams})
+	result, err := bundlepkg.VerifyWithResult(ctx, bundlepkg.VerifyOptions{Source: o.Source, Policy: policy, Config: o.Config, TmpDir: o.Config.Options.TmpDir, Streams: o.IOStreams})
+	if err != nil {
+		return err
+	}
+	if o.Printer == nil {
+		return nil
+	}
+	return o.Printer.PrintObj(result, o.Out())
 }
 
 func addVerificationFlags(cmd *cobra.Command, o *VerifyOptions, allowSkip bool) {
-	cmd.Flags().StringVar(&o.PublicKey, "public-key", "", "path to trusted bundle public key")
+	cmd.Flags().StringArrayVar(&o.PublicKeys, "public-key", nil, "path to a trusted bundle public key (repeatable)")
+	cmd.Flags().BoolVar(&o.RequireAll, "require-all", false, "require a signature from every trusted signer")
 	cmd.Flags().StringVar(&o.Identity, "certificate-identity", "", "trusted keyless certificate identity")
 	cmd.Flags().StringVar(&o.IdentityRE, "certificate-identity-regexp", "", "trusted keyless certificate identity regexp")
 	cmd.Flags().StringVar(&o.Issuer, "certificate-oidc-issuer", "", "trusted keyless OIDC issuer")
@@ -91,20 +108,44 @@ func addVerificationFlags(cmd *cobra.Command, o *VerifyOptions, allowSkip bool)
 	}
 }
 
+// requested reports whether verification flags or the resolved config ask for
+// signature verification, for commands where verification is optional.
+func (o *VerifyOptions) requested() bool {
+	if o.Config != nil && o.Config.SignatureVerification != nil {
+		return true
+	}
+	return len(o.PublicKeys) != 0 || o.Identity != "" || o.IdentityRE != "" || o.Issuer != "" || o.IssuerRE != "" || o.TrustedRoot != ""
+}
+
 func (o *VerifyOptions) policy() (bundlepkg.VerificationPolicy, error) {
 	policy := bundlepkg.VerificationPolicy{}
 	if o.Config != nil && o.Config.SignatureVerification != nil {
 		policy = *o.Config.SignatureVerification
 	}
-	if o.PublicKey != "" {
-		data, err := os.ReadFile(o.PublicKey)
+	if len(o.PublicKeys) == 1 && !o.RequireAll {
+		data, err := os.ReadFile(o.PublicKeys[0])
 		if err != nil {
 			return bundlepkg.VerificationPolicy{}, fmt.Errorf("reading public key: %w", err)
 		}
 		policy = bundlepkg.VerificationPolicy{PublicKey: string(data)}
+	} else if len(o.PublicKeys) != 0 {
+		// Each key becomes a trusted signer named by its path.
+		policy = bundlepkg.VerificationPolicy{}
+		for _, keyPath := range o.PublicKeys {
+			data, err := os.ReadFile(keyPath)
+			if err != nil {
+				return bundlepkg.VerificationPolicy{}, fmt.Errorf("reading public key: %w", err)
+			}
+			policy.Signers = append(policy.Signers, bundlepkg.TrustedSigner{Name: keyPath, PublicKey: string(data)})
+		}
+	}
+	if o.RequireAll && len(policy.Signers) != 0 {
+		policy.Rule = bundlepkg.VerificationRuleAll
+		policy.Threshold = 0
 	}
 	if o.Identity != "" || o.IdentityRE != "" || o.Issuer != "" || o.IssuerRE != "" || o.TrustedRoot != "" {
-		policy.PublicKey = ""
+		// Flags replace a multi-signer config policy but refine a single keyless identity.
+		policy = bundlepkg.VerificationPolicy{Keyless: policy.Keyless}
 		keyless := bundlepkg.KeylessVerification{}
 		if policy.Keyless != nil {
 			keyless = *policy.Keyless
@@ -7,9 +7,12 @@ package cli
 import (
 	"log/slog"
 	"os"
+	"time"
 
 	"github.com/defenseunicorns/uds-cli/internal/cli/bundle"
+	cmdlogs "github.com/defenseunicorns/uds-cli/internal/cli/logs"
 	"github.com/defenseunicorns/uds-cli/internal/cli/tools"
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
 	cmdversion "github.com/defenseunicorns/uds-cli/internal/cli/version"
 	cmdzarf "github.com/defenseunicorns/uds-cli/internal/cli/zarf"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
@@ -21,20 +24,35 @@ import (
 // NewRootCommand creates the root command for uds.
 func NewRootCommand(streams iostreams.IOStreams) *cobra.Command {
 	var logLevel string
+	var noLogFile bool
+
+	// Every command shares one logger: the console keeps the --log-level
+	// chosen by the user, while operations also write every debug record to
+	// their operation log.
+	oplog := logger.NewOperationLog()
+	level := new(slog.LevelVar)
+	streams = streams.WithLogger(logger.NewWithOperationLog(streams.ErrOut(), level, oplog), level)
 
 	rootCmd := &cobra.Command{
 		Use:   "uds",
 		Short: "UDS CLI - The entrypoint to the Defense Unicorns ecosystem",
 		Long:  `UDS CLI is a command-line tool for managing UDS Bundles and interacting with the Defense Unicorns ecosystem.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
-			level, err := logger.ParseLevel(logLevel)
+			lvl, err := logger.ParseLevel(logLevel)
 			if err != nil {
 				return err
 			}
-			slog.SetDefault(logger.New(os.Stderr, level))
+			level.Set(lvl)
+			slog.SetDefault(logger.New(os.Stderr, lvl))
 			cmd.SetContext(ocischeme.WithNegotiator(cmd.Context(), ocischeme.New(ocischeme.Options{})))
+			if !noLogFile && isOperation(cmd) {
+				startOperationLog(streams, oplog, cmd.CommandPath())
+			}
 			return nil
 		},
+		PersistentPostRunE: func(*cobra.Command, []string) error {
+			return oplog.Finish(nil)
+		},
 		// TraverseChildren ensures cobra finds the deepest matching subcommand before parsing flags.
 		// Without this, flags like "-n" passed to deeply nested commands (e.g., "uds tools zarf tools kubectl -n ...")
 		// would be incorrectly parsed by intermediate parent commands.
@@ -46,10 +64,12 @@ func NewRootCommand(streams iostreams.IOStreams) *cobra.Command {
 	rootCmd.SetErr(streams.ErrOut())
 
 	rootCmd.PersistentFlags().StringVarP(&logLevel, "log-level", "l", "info", "log level (debug, info, warn, error)")
-	rootCmd.PersistentFlags().Bool("prompt", false, "enable interactive confirmation prompts")
+	rootCmd.PersistentFlags().Bool("prompt", false, "enable interactive confirmation prompts, and on deploy prompt for unset bundle variables")
+	rootCmd.PersistentFlags().BoolVar(&noLogFile, "no-log-file", false, "do not write an operation log for uds logs")
 
 	rootCmd.AddCommand(cmdversion.NewVersionCommand(streams))
 	rootCmd.AddCommand(bundle.NewBundleCommand(streams))
+	rootCmd.AddCommand(cmdlogs.NewLogsCommand(streams))
 	rootCmd.AddCommand(tools.NewToolsCommand())
 	// Hidden root-level zarf command for internal Zarf callbacks.
 	// Zarf's ActionsCommandZarfPrefix is set to "zarf" (single word) at build time,
@@ -58,3 +78,33 @@ func NewRootCommand(streams iostreams.IOStreams) *cobra.Command {
 
 	return rootCmd
 }
+
+// isOperation reports whether cmd or a parent is annotated as an operation.
+func isOperation(cmd *cobra.Command) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Annotations[logger.OperationAnnotation] == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// startOperationLog opens the operation log and records a failure reported
+// through util.CheckErr before the process exits. Operation log errors are
+// reported but never fail the command.
+func startOperationLog(streams iostreams.IOStreams, oplog *logger.OperationLog, command string) {
+	dir, err := logger.OperationLogDir()
+	if err == nil {
+		_, err = oplog.Start(dir, command, time.Now())
+	}
+	if err != nil {
+		streams.Warn("operation log error", "error", err)
+	}
+	if oplog.ID() == "" {
+		return
+	}
+	util.BeforeExit = func(err error) {
+		_ = oplog.Finish(err)
+	}
+	streams.Debug("operation log started", "id", oplog.ID(), "path", oplog.Path())
+}
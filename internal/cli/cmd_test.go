@@ -4,9 +4,13 @@
 package cli
 
 import (
+	"path/filepath"
 	"testing"
 
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -38,3 +42,41 @@ func TestRootCommand_LogLevelFlag(t *testing.T) {
 		})
 	}
 }
+
+func TestRootCommand_WritesOperationLogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantLog bool
+	}{
+		{name: "operation", args: []string{"op"}, wantLog: true},
+		{name: "operation without log file", args: []string{"--no-log-file", "op"}},
+		{name: "not an operation", args: []string{"version"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			home := t.TempDir()
+			t.Setenv(logger.HomeEnv, home)
+			streams, _, _, _ := iostreams.NewTestIOStreams()
+			root := NewRootCommand(streams)
+			root.AddCommand(&cobra.Command{
+				Use:         "op",
+				Annotations: map[string]string{logger.OperationAnnotation: "true"},
+				Run:         func(*cobra.Command, []string) {},
+			})
+			root.SetArgs(tt.args)
+			require.NoError(t, root.Execute())
+
+			operations, err := logger.ListOperations(filepath.Join(home, "logs"))
+			require.NoError(t, err)
+			if !tt.wantLog {
+				assert.Empty(t, operations)
+				return
+			}
+			require.Len(t, operations, 1)
+			assert.Equal(t, "uds op", operations[0].Command)
+			assert.Equal(t, logger.OperationSucceeded, operations[0].Status)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package logs provides the logs command for the UDS CLI.
+package logs
+
+import (
+	"strconv"
+
+	"github.com/defenseunicorns/uds-cli/internal/cli/util"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/printer"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+// Options holds options for the logs command.
+type Options struct {
+	// Operation is the ID of the operation to replay; empty lists operations.
+	Operation string
+	JSON      bool
+	// Dir is the operation log directory.
+	Dir string
+
+	iostreams.IOStreams
+}
+
+// Result lists the operation logs kept in Directory, newest first.
+type Result struct {
+	Directory  string                    `json:"directory" yaml:"directory" text:"Directory"`
+	Operations []logger.OperationSummary `json:"operations" yaml:"operations" text:"Operations"`
+}
+
+// NewOptions returns a new Options with default values.
+func NewOptions(streams iostreams.IOStreams) *Options {
+	return &Options{
+		IOStreams: streams,
+	}
+}
+
+// NewLogsCommand creates the logs command.
+func NewLogsCommand(streams iostreams.IOStreams) *cobra.Command {
+	o := NewOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "logs",
+		Short: "List and replay operation logs",
+		Long: `List and replay the logs of recent bundle operations.
+
+Every uds bundle command that changes files, registries or clusters, such as
+create, deploy and remove, writes a debug-level JSON log, tagged with an
+operation ID, to the logs directory under the UDS home directory (~/.uds, or
+$UDS_HOME when set). The console log level does not affect it, so the detail
+of a failed operation can be read afterwards without re-running it. The most
+recent ` + strconv.Itoa(logger.MaxOperationLogs) + ` operations are kept. Read-only commands such as
+inspect, lint and graph write no log.
+
+Without --operation, the kept operations are listed. With --operation, that
+operation's log is replayed; --json prints the raw JSON records instead.`,
+		Example: `  # List recent operations
+  uds logs
+
+  # Replay an operation at debug level
+  uds logs --operation 20260101T120000Z-a1b2c3
+
+  # Print an operation's raw JSON records
+  uds logs --operation 20260101T120000Z-a1b2c3 --json`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			util.CheckErr(o.Complete(cmd, args))
+			util.CheckErr(o.Validate())
+			util.CheckErr(o.Run())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.Operation, "operation", "", "ID of the operation to replay")
+	cmd.Flags().BoolVar(&o.JSON, "json", false, "print JSON instead of text")
+
+	return cmd
+}
+
+// Complete resolves the operation log directory.
+func (o *Options) Complete(_ *cobra.Command, _ []string) error {
+	if o.Dir != "" {
+		return nil
+	}
+	dir, err := logger.OperationLogDir()
+	if err != nil {
+		return err
+	}
+	o.Dir = dir
+	return nil
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	// Operation IDs are checked when the log is read.
+	return nil
+}
+
+// Run lists operations or replays one.
+func (o *Options) Run() error {
+	if o.Operation != "" {
+		return logger.ReplayOperation(o.Dir, o.Operation, o.Out(), o.JSON)
+	}
+	operations, err := logger.ListOperations(o.Dir)
+	if err != nil {
+		return err
+	}
+	format := printer.FormatText
+	if o.JSON {
+		format = printer.FormatJSON
+	}
+	p, err := printer.NewPrinter(format)
+	if err != nil {
+		return err
+	}
+	return p.PrintObj(&Result{Directory: o.Dir, Operations: operations}, o.Out())
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package logs
+
+import (
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+func TestOptions_Run(t *testing.T) {
+	dir := t.TempDir()
+	oplog := logger.NewOperationLog()
+	id, err := oplog.Start(dir, "uds bundle deploy", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	slog.New(oplog.Handler()).Debug("pulling package", "name", "init")
+	require.NoError(t, oplog.Finish(nil))
+
+	streams, _, out, _ := iostreams.NewTestIOStreams()
+	o := &Options{Dir: dir, IOStreams: streams}
+	require.NoError(t, o.Run())
+	assert.Contains(t, out.String(), "ID:          "+id)
+	assert.Contains(t, out.String(), "Command:     uds bundle deploy")
+	assert.Contains(t, out.String(), "Status:      succeeded")
+
+	out.Reset()
+	o.JSON = true
+	require.NoError(t, o.Run())
+	var result Result
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	require.Len(t, result.Operations, 1)
+	assert.Equal(t, 3, result.Operations[0].Records)
+
+	out.Reset()
+	o.JSON = false
+	o.Operation = id
+	require.NoError(t, o.Run())
+	assert.Contains(t, out.String(), "DBG pulling package name=init")
+
+	o.Operation = "missing"
+	require.ErrorIs(t, o.Run(), logger.ErrOperationNotFound)
+}
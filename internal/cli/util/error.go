@@ -8,9 +8,16 @@ import (
 	"os"
 )
 
+// BeforeExit, when set, is called with the error CheckErr is about to report,
+// so the error can be recorded before the process exits.
+var BeforeExit func(error)
+
 // CheckErr prints a user-friendly error message and exits with status 1.
 func CheckErr(err error) {
 	if err != nil {
+		if BeforeExit != nil {
+			BeforeExit(err)
+		}
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
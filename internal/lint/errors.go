@@ -0,0 +1,31 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrLoadBundle   = errors.New("loading bundle for lint")
+	ErrParseSyntax  = errors.New("parsing HCL syntax")
+	ErrUnknownRule  = errors.New("unknown lint rule")
+	ErrRuleConflict = errors.New("lint rule is both enabled and disabled")
+)
+
+var _ error = (*UnknownRulesError)(nil)
+
+// UnknownRulesError names rule IDs that are not in the rule set.
+type UnknownRulesError struct {
+	Rules []string
+	Known []string
+}
+
+func (e UnknownRulesError) Error() string {
+	return fmt.Sprintf("unknown lint rules %s (available rules: %s)", strings.Join(e.Rules, ", "), strings.Join(e.Known, ", "))
+}
+
+func (e UnknownRulesError) Unwrap() error { return ErrUnknownRule }
@@ -0,0 +1,128 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package lint checks bundle definitions for problems that structural
+// validation does not catch, reporting each finding at its HCL source range.
+package lint
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// Severity is how serious a finding is. The values match SARIF result levels.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is one problem reported by a rule.
+type Finding struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	Range    hcl.Range
+}
+
+// Rule is a single, individually toggleable check.
+type Rule struct {
+	ID          string
+	Description string
+	Severity    Severity
+	check       func(*Input) []Finding
+}
+
+// Options selects the rules a lint run applies.
+type Options struct {
+	// Enable, when set, runs only these rules.
+	Enable []string
+	// Disable skips these rules.
+	Disable []string
+}
+
+// Report is the result of a lint run.
+type Report struct {
+	// Rules lists the IDs of the rules that ran.
+	Rules    []string
+	Findings []Finding
+}
+
+// Rules returns every lint rule, ordered by ID.
+func Rules() []Rule {
+	rules := []Rule{
+		{ID: "unreachable-package", Severity: SeverityError, Description: "package depends on an unknown package or a dependency cycle and can never be deployed", check: checkUnreachablePackages},
+		{ID: "redundant-depends-on", Severity: SeverityWarning, Description: "depends_on entry is already implied through another dependency", check: checkRedundantDependsOn},
+		{ID: "undefined-variable", Severity: SeverityError, Description: "values file reads a .vars key that neither defaults.uds.hcl nor config.uds.hcl defines", check: checkUndefinedVariables},
+		{ID: "unused-default", Severity: SeverityWarning, Description: "defaults.uds.hcl defines a variable no values file reads", check: checkUnusedDefaults},
+		{ID: "missing-namespace", Severity: SeverityWarning, Description: "package does not set a namespace", check: checkMissingNamespaces},
+		{ID: "unpinned-tag", Severity: SeverityWarning, Description: "OCI package source is neither pinned by digest nor locked in bundle.lock.hcl", check: checkUnpinnedTags},
+		{ID: "unknown-optional-component", Severity: SeverityError, Description: "optional component is not defined in the package's zarf.yaml", check: checkOptionalComponents},
+		{ID: "invalid-values-file", Severity: SeverityError, Description: "values file cannot be read or parsed as a template", check: checkValuesFiles},
+	}
+	slices.SortFunc(rules, func(a, b Rule) int { return cmp.Compare(a.ID, b.ID) })
+	return rules
+}
+
+// Run applies the rules selected by opts to in.
+func Run(_ context.Context, in *Input, opts Options) (*Report, error) {
+	rules, err := selectRules(opts)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Rules: []string{}, Findings: []Finding{}}
+	for _, rule := range rules {
+		report.Rules = append(report.Rules, rule.ID)
+		for _, f := range rule.check(in) {
+			f.Rule, f.Severity = rule.ID, rule.Severity
+			report.Findings = append(report.Findings, f)
+		}
+	}
+	slices.SortStableFunc(report.Findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.Range.Filename, b.Range.Filename),
+			cmp.Compare(a.Range.Start.Line, b.Range.Start.Line),
+			cmp.Compare(a.Range.Start.Column, b.Range.Start.Column),
+			cmp.Compare(a.Rule, b.Rule),
+		)
+	})
+	return report, nil
+}
+
+func selectRules(opts Options) ([]Rule, error) {
+	all := Rules()
+	known := make([]string, len(all))
+	for i, rule := range all {
+		known[i] = rule.ID
+	}
+	var unknown []string
+	for _, id := range slices.Concat(opts.Enable, opts.Disable) {
+		if !slices.Contains(known, id) && !slices.Contains(unknown, id) {
+			unknown = append(unknown, id)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, UnknownRulesError{Rules: unknown, Known: known}
+	}
+	for _, id := range opts.Enable {
+		if slices.Contains(opts.Disable, id) {
+			return nil, fmt.Errorf("%w: %s", ErrRuleConflict, id)
+		}
+	}
+	var rules []Rule
+	for _, rule := range all {
+		if len(opts.Enable) > 0 && !slices.Contains(opts.Enable, rule.ID) {
+			continue
+		}
+		if slices.Contains(opts.Disable, rule.ID) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
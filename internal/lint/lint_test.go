@@ -0,0 +1,230 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lint
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+const lintBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "lint"
+  version = "1.0.0"
+}
+package "base" {
+  source              = "./base"
+  namespace           = "base"
+  values_files        = ["values.yaml"]
+  optional_components = ["extra", "-missing"]
+}
+package "app" {
+  source     = "oci://ghcr.io/uds/app:1.0.0"
+  depends_on = [package.base]
+}
+package "top" {
+  source     = "oci://ghcr.io/uds/top@sha256:` + "0000000000000000000000000000000000000000000000000000000000000000" + `"
+  namespace  = "top"
+  depends_on = [package.app, package.base]
+}
+package "orphan" {
+  source     = "./orphan"
+  namespace  = "orphan"
+  depends_on = [package.ghost]
+}
+package "loop_a" {
+  source     = "./loop"
+  namespace  = "loop"
+  depends_on = [package.loop_b]
+}
+package "loop_b" {
+  source     = "./loop"
+  namespace  = "loop"
+  depends_on = [package.loop_a]
+}
+`
+
+const lintDefaults = `variables = {
+  domain = "uds.dev"
+  logging = {
+    level  = "info"
+    format = "json"
+  }
+}
+`
+
+const lintValues = `level: {{ .vars.logging.level }}
+host: {{ .vars.ingress.host }}
+optional: {{ lookup "ingress.class" | default "nginx" }}
+`
+
+func writeLintBundle(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		"bundle.uds.hcl":   lintBundle,
+		"defaults.uds.hcl": lintDefaults,
+		"values.yaml":      lintValues,
+		"base/zarf.yaml":   "kind: ZarfPackageConfig\ncomponents:\n  - name: base\n  - name: extra\n",
+	}
+	for name, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+	return dir
+}
+
+func runLint(t *testing.T, dir string, opts Options) *Report {
+	t.Helper()
+	in, err := Load(t.Context(), LoadOptions{BundleFile: filepath.Join(dir, bundleinternal.BundleFileName), Streams: iostreams.IOStreams{}})
+	require.NoError(t, err)
+	report, err := Run(t.Context(), in, opts)
+	require.NoError(t, err)
+	return report
+}
+
+// findings returns "rule file:line:col" for each finding, with file relative to dir.
+func findings(t *testing.T, dir string, report *Report) []string {
+	t.Helper()
+	var got []string
+	for _, f := range report.Findings {
+		rel, err := filepath.Rel(dir, f.Range.Filename)
+		require.NoError(t, err)
+		got = append(got, fmt.Sprintf("%s %s:%d:%d", f.Rule, rel, f.Range.Start.Line, f.Range.Start.Column))
+	}
+	return got
+}
+
+func TestRun_ReportsEachRule(t *testing.T) {
+	dir := writeLintBundle(t)
+	report := runLint(t, dir, Options{})
+
+	assert.Len(t, report.Rules, len(Rules()))
+	assert.Equal(t, []string{
+		"unknown-optional-component bundle.uds.hcl:12:35",
+		"missing-namespace bundle.uds.hcl:14:1",
+		"unpinned-tag bundle.uds.hcl:15:3",
+		"redundant-depends-on bundle.uds.hcl:21:30",
+		"unreachable-package bundle.uds.hcl:26:17",
+		"unreachable-package bundle.uds.hcl:31:17",
+		"unreachable-package bundle.uds.hcl:36:17",
+		"unused-default defaults.uds.hcl:5:5",
+		"undefined-variable values.yaml:2:10",
+	}, findings(t, dir, report))
+
+	messages := map[string]string{}
+	for _, f := range report.Findings {
+		messages[f.Rule] += f.Message + "\n"
+	}
+	assert.Contains(t, messages["unreachable-package"], `package "orphan" depends on unknown package "ghost"`)
+	assert.Contains(t, messages["unreachable-package"], `package "loop_a" is part of a depends_on cycle through "loop_b"`)
+	assert.Contains(t, messages["redundant-depends-on"], `package "top" depends on "base", which is already implied through "app"`)
+	assert.Contains(t, messages["undefined-variable"], ".vars.ingress.host, which neither defaults.uds.hcl nor config.uds.hcl defines")
+	assert.Contains(t, messages["unused-default"], `"logging.format"`)
+	assert.Contains(t, messages["unknown-optional-component"], `"-missing"`)
+	assert.Equal(t, SeverityError, report.Findings[0].Severity)
+}
+
+func TestRun_SelectsRules(t *testing.T) {
+	dir := writeLintBundle(t)
+
+	report := runLint(t, dir, Options{Enable: []string{"missing-namespace"}})
+	assert.Equal(t, []string{"missing-namespace"}, report.Rules)
+	assert.Equal(t, []string{"missing-namespace bundle.uds.hcl:14:1"}, findings(t, dir, report))
+
+	report = runLint(t, dir, Options{Disable: []string{"unreachable-package", "unused-default"}})
+	assert.NotContains(t, report.Rules, "unreachable-package")
+	for _, f := range report.Findings {
+		assert.NotContains(t, []string{"unreachable-package", "unused-default"}, f.Rule)
+	}
+
+	in, err := Load(t.Context(), LoadOptions{BundleFile: filepath.Join(dir, bundleinternal.BundleFileName)})
+	require.NoError(t, err)
+	_, err = Run(t.Context(), in, Options{Disable: []string{"no-such-rule"}})
+	var unknown UnknownRulesError
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, []string{"no-such-rule"}, unknown.Rules)
+	require.ErrorIs(t, err, ErrUnknownRule)
+	_, err = Run(t.Context(), in, Options{Enable: []string{"unpinned-tag"}, Disable: []string{"unpinned-tag"}})
+	require.ErrorIs(t, err, ErrRuleConflict)
+}
+
+func TestRun_LockAndConfigSatisfyRules(t *testing.T) {
+	dir := writeLintBundle(t)
+	require.NoError(t, bundleinternal.WriteLock(bundleinternal.LockPath(dir), &bundleinternal.Lock{Packages: []bundleinternal.LockedPackage{
+		{Name: "app", Source: "oci://ghcr.io/uds/app:1.0.0", Digest: "sha256:" + strings.Repeat("a", 64)},
+	}}))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleinternal.ConfigFileName), []byte("variables = {\n  ingress = {\n    host = \"example.com\"\n  }\n}\n"), 0o600))
+
+	report := runLint(t, dir, Options{Enable: []string{"unpinned-tag", "undefined-variable"}})
+	assert.Empty(t, report.Findings)
+}
+
+func TestRun_InvalidValuesFile(t *testing.T) {
+	dir := writeLintBundle(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values.yaml"), []byte("host: {{ .vars.domain\n"), 0o600))
+
+	report := runLint(t, dir, Options{Enable: []string{"invalid-values-file", "unused-default"}})
+	assert.Equal(t, []string{"invalid-values-file bundle.uds.hcl:11:26"}, findings(t, dir, report))
+}
+
+func TestRun_UnreadableOptionalComponents(t *testing.T) {
+	dir := writeLintBundle(t)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "base", "zarf.yaml"), []byte("components: [\n"), 0o600))
+
+	report := runLint(t, dir, Options{Enable: []string{"unknown-optional-component"}})
+	assert.Equal(t, []string{"unknown-optional-component bundle.uds.hcl:9:3"}, findings(t, dir, report))
+	assert.Contains(t, report.Findings[0].Message, `package "base"`)
+	assert.Contains(t, report.Findings[0].Message, "parsing zarf.yaml")
+}
+
+func TestRun_JSONSyntax(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		bundleinternal.BundleJSONFileName: `{
+  "uds": {"bundle_api_version": "uds.dev/v1alpha1"},
+  "metadata": {"name": "lint", "version": "1.0.0"},
+  "package": {
+    "app": {
+      "source": "oci://ghcr.io/uds/app:1.0.0",
+      "namespace": "app",
+      "values_files": ["values.yaml"]
+    }
+  }
+}
+`,
+		bundleinternal.BundleDefaultsJSONFileName: `{
+  "variables": {
+    "domain": "uds.dev",
+    "logging": {"level": "info", "format": "json"}
+  }
+}
+`,
+		bundleinternal.ConfigJSONFileName: `{"variables": {"ingress": {"host": "example.com"}}}`,
+		"values.yaml":                     lintValues,
+	}
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	in, err := Load(t.Context(), LoadOptions{BundleFile: bundleinternal.ResolveBundlePath(dir), Streams: iostreams.IOStreams{}})
+	require.NoError(t, err)
+	report, err := Run(t.Context(), in, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"unpinned-tag bundle.uds.json:6:7",
+		"unused-default defaults.uds.json:4:34",
+	}, findings(t, dir, report))
+}
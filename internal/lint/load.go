@@ -0,0 +1,261 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lint
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/zarf"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// LoadOptions locates the files a lint run reads.
+type LoadOptions struct {
+	// BundleFile is the path to bundle.uds.hcl or bundle.uds.json.
+	BundleFile string
+	// ConfigFile overrides the config.uds.hcl or config.uds.json next to the
+	// bundle.
+	ConfigFile string
+	Streams    iostreams.IOStreams
+}
+
+// Input is a parsed bundle together with the source ranges rules report against.
+type Input struct {
+	BundleFile string
+	BundleDir  string
+	Bundle     *spec.UDSBundle
+	// Packages holds the syntax of each package block, keyed by package name.
+	Packages map[string]*PackageSyntax
+	// DefaultsFile is empty when the bundle has no defaults.uds.hcl.
+	DefaultsFile string
+	Defaults     bundleinternal.Variables
+	// DefaultRanges maps each dotted defaults path to the range of its key.
+	DefaultRanges map[string]hcl.Range
+	Config        bundleinternal.Variables
+	Lock          *bundleinternal.Lock
+	// ValuesFiles holds the parsed values files of each package, keyed by package name.
+	ValuesFiles map[string][]ValuesFile
+}
+
+// PackageSyntax records where the parts of a package block are written.
+type PackageSyntax struct {
+	DefRange   hcl.Range
+	Attributes map[string]hcl.Range
+	// Elements holds the range of each list element, keyed by attribute name.
+	Elements map[string][]hcl.Range
+}
+
+// ValuesFile is a values file referenced by a package and the config
+// variables its template reads.
+type ValuesFile struct {
+	Path       string
+	Range      hcl.Range
+	References []zarf.VariableReference
+	Err        error
+}
+
+// Range returns the range of a package attribute, or the block header when
+// the attribute is not set.
+func (p *PackageSyntax) Range(attr string) hcl.Range {
+	if rng, ok := p.Attributes[attr]; ok {
+		return rng
+	}
+	return p.DefRange
+}
+
+// Element returns the range of element i of a list attribute, falling back
+// to the attribute when the list is not written literally.
+func (p *PackageSyntax) Element(attr string, i int) hcl.Range {
+	if elems := p.Elements[attr]; i < len(elems) {
+		return elems[i]
+	}
+	return p.Range(attr)
+}
+
+// Load parses the bundle, its defaults, config and lock file.
+func Load(ctx context.Context, opts LoadOptions) (*Input, error) {
+	if opts.BundleFile == "" {
+		return nil, bundleinternal.EmptyParameterError{Name: "BundleFile"}
+	}
+	parser := bundleinternal.NewHCLParser("", opts.Streams)
+	b, err := parser.ParseBundleFile(ctx, opts.BundleFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadBundle, err)
+	}
+	in := &Input{
+		BundleFile:    opts.BundleFile,
+		BundleDir:     filepath.Dir(opts.BundleFile),
+		Bundle:        b,
+		DefaultRanges: map[string]hcl.Range{},
+		ValuesFiles:   map[string][]ValuesFile{},
+	}
+	if in.Packages, err = parsePackageSyntax(opts.BundleFile); err != nil {
+		return nil, err
+	}
+
+	if in.DefaultsFile, err = bundleinternal.AdjacentDefaultsPath(in.BundleDir); err != nil {
+		return nil, fmt.Errorf("%w: locating defaults: %w", ErrLoadBundle, err)
+	}
+	if in.DefaultsFile != "" {
+		if in.Defaults, err = bundleinternal.ParseDefaults(ctx, in.DefaultsFile); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrLoadBundle, err)
+		}
+		if err := defaultRanges(in.DefaultsFile, in.DefaultRanges); err != nil {
+			return nil, err
+		}
+	}
+
+	configFile := opts.ConfigFile
+	if configFile == "" {
+		if configFile, err = bundleinternal.AdjacentConfigPath(in.BundleDir); err != nil {
+			return nil, fmt.Errorf("%w: locating config: %w", ErrLoadBundle, err)
+		}
+	}
+	if configFile != "" {
+		cfg, err := parser.ParseBundleConfig(ctx, configFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrLoadBundle, err)
+		}
+		in.Config = cfg.Variables
+	}
+
+	if in.Lock, err = bundleinternal.ReadLock(bundleinternal.LockPath(in.BundleDir)); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadBundle, err)
+	}
+
+	for _, pkg := range b.Packages {
+		syntax := in.packageSyntax(pkg.Name)
+		for i, path := range pkg.ValuesFiles {
+			in.ValuesFiles[pkg.Name] = append(in.ValuesFiles[pkg.Name], loadValuesFile(in.BundleDir, path, syntax.Element("values_files", i)))
+		}
+	}
+	return in, nil
+}
+
+// packageSyntax returns the syntax for a package, never nil, so rules can
+// report against the bundle file when a block could not be matched. Packages
+// declared by for_each or count report against the block that declares them.
+func (in *Input) packageSyntax(name string) *PackageSyntax {
+	if syntax, ok := in.Packages[name]; ok {
+		return syntax
+	}
+	if i := strings.IndexByte(name, '['); i > 0 {
+		if syntax, ok := in.Packages[name[:i]]; ok {
+			return syntax
+		}
+	}
+	return &PackageSyntax{DefRange: hcl.Range{Filename: in.BundleFile, Start: hcl.InitialPos, End: hcl.InitialPos}}
+}
+
+func loadValuesFile(bundleDir, path string, rng hcl.Range) ValuesFile {
+	vf := ValuesFile{Path: path, Range: rng}
+	resolved := path
+	if !filepath.IsAbs(resolved) {
+		resolved = filepath.Join(bundleDir, path)
+	}
+	src, err := os.ReadFile(resolved)
+	if err != nil {
+		vf.Err = err
+		return vf
+	}
+	vf.Path = resolved
+	vf.References, vf.Err = zarf.ValuesTemplateReferences(filepath.Base(resolved), src)
+	return vf
+}
+
+// parsePackageSyntax re-parses the bundle file to find the ranges of each package
+// block. The bundle has already been decoded, so only syntax errors can occur.
+func parsePackageSyntax(path string) (map[string]*PackageSyntax, error) {
+	body, err := parseSyntax(path)
+	if err != nil {
+		return nil, err
+	}
+	content, _, _ := body.PartialContent(&hcl.BodySchema{Blocks: []hcl.BlockHeaderSchema{{Type: "package", LabelNames: []string{"name"}}}})
+	packages := map[string]*PackageSyntax{}
+	for _, block := range content.Blocks {
+		syntax := &PackageSyntax{
+			DefRange:   block.DefRange,
+			Attributes: map[string]hcl.Range{},
+			Elements:   map[string][]hcl.Range{},
+		}
+		// Nested blocks make JustAttributes report errors, but the attributes
+		// it returns are still complete.
+		attrs, _ := block.Body.JustAttributes()
+		for name, attr := range attrs {
+			syntax.Attributes[name] = attr.Range
+			if elems, diags := hcl.ExprList(attr.Expr); !diags.HasErrors() {
+				for _, elem := range elems {
+					syntax.Elements[name] = append(syntax.Elements[name], elem.Range())
+				}
+			}
+		}
+		packages[block.Labels[0]] = syntax
+	}
+	return packages, nil
+}
+
+// defaultRanges records the key range of every entry in the variables
+// object of a defaults file.
+func defaultRanges(path string, ranges map[string]hcl.Range) error {
+	body, err := parseSyntax(path)
+	if err != nil {
+		return err
+	}
+	content, _, _ := body.PartialContent(&hcl.BodySchema{Attributes: []hcl.AttributeSchema{{Name: "variables"}}})
+	if attr, ok := content.Attributes["variables"]; ok {
+		objectKeyRanges(attr.Expr, "", ranges)
+	}
+	return nil
+}
+
+func objectKeyRanges(expr hcl.Expression, prefix string, ranges map[string]hcl.Range) {
+	items, diags := hcl.ExprMap(expr)
+	if diags.HasErrors() {
+		return
+	}
+	for _, item := range items {
+		key, diags := item.Key.Value(nil)
+		if diags.HasErrors() || key.Type() != cty.String || key.IsNull() {
+			continue
+		}
+		path := key.AsString()
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		ranges[path] = item.Key.Range()
+		objectKeyRanges(item.Value, path, ranges)
+	}
+}
+
+// parseSyntax parses a bundle or defaults file in the native or JSON syntax.
+func parseSyntax(path string) (hcl.Body, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseSyntax, path, err)
+	}
+	file, diags := bundleinternal.ParseHCL(src, path)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseSyntax, path, diags)
+	}
+	return file.Body, nil
+}
+
+// dottedPrefixes returns a.b.c as a, a.b and a.b.c.
+func dottedPrefixes(path string) []string {
+	parts := strings.Split(path, ".")
+	prefixes := make([]string, len(parts))
+	for i := range parts {
+		prefixes[i] = strings.Join(parts[:i+1], ".")
+	}
+	return prefixes
+}
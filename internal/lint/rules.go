@@ -0,0 +1,296 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lint
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/internal/zarf"
+)
+
+// dependencies maps each package to the names in its depends_on list.
+func (in *Input) dependencies() map[string][]string {
+	deps := make(map[string][]string, len(in.Bundle.Packages))
+	for _, pkg := range in.Bundle.Packages {
+		deps[pkg.Name] = []string{}
+		for _, ref := range pkg.DependsOn {
+			deps[pkg.Name] = append(deps[pkg.Name], ref.Name)
+		}
+	}
+	return deps
+}
+
+// checkUnreachablePackages reports packages that no deployment order can
+// reach: those depending, directly or not, on an unknown package or a cycle.
+func checkUnreachablePackages(in *Input) []Finding {
+	deps := in.dependencies()
+	reachable := map[string]bool{}
+	for changed := true; changed; {
+		changed = false
+		for _, pkg := range in.Bundle.Packages {
+			if reachable[pkg.Name] {
+				continue
+			}
+			if !slices.ContainsFunc(deps[pkg.Name], func(dep string) bool { return !reachable[dep] }) {
+				reachable[pkg.Name] = true
+				changed = true
+			}
+		}
+	}
+
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		if reachable[pkg.Name] {
+			continue
+		}
+		syntax := in.packageSyntax(pkg.Name)
+		for i, dep := range deps[pkg.Name] {
+			if reachable[dep] {
+				continue
+			}
+			var msg string
+			switch _, known := deps[dep]; {
+			case !known:
+				msg = fmt.Sprintf("package %q depends on unknown package %q and can never be deployed", pkg.Name, dep)
+			case dependsOn(deps, dep, pkg.Name):
+				msg = fmt.Sprintf("package %q is part of a depends_on cycle through %q and can never be deployed", pkg.Name, dep)
+			default:
+				msg = fmt.Sprintf("package %q depends on %q, which can never be deployed", pkg.Name, dep)
+			}
+			findings = append(findings, Finding{Message: msg, Range: syntax.Element("depends_on", i)})
+			break
+		}
+	}
+	return findings
+}
+
+// checkRedundantDependsOn reports depends_on entries that another entry of
+// the same package already implies.
+func checkRedundantDependsOn(in *Input) []Finding {
+	deps := in.dependencies()
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		syntax := in.packageSyntax(pkg.Name)
+		for i, dep := range deps[pkg.Name] {
+			for _, via := range deps[pkg.Name] {
+				if via == dep || via == pkg.Name || !dependsOn(deps, via, dep) {
+					continue
+				}
+				findings = append(findings, Finding{
+					Message: fmt.Sprintf("package %q depends on %q, which is already implied through %q", pkg.Name, dep, via),
+					Range:   syntax.Element("depends_on", i),
+				})
+				break
+			}
+		}
+	}
+	return findings
+}
+
+// dependsOn reports whether from transitively depends on target.
+func dependsOn(deps map[string][]string, from, target string) bool {
+	seen := map[string]bool{}
+	stack := slices.Clone(deps[from])
+	for len(stack) > 0 {
+		name := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if name == target {
+			return true
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		stack = append(stack, deps[name]...)
+	}
+	return false
+}
+
+// checkUndefinedVariables reports values file reads of variables that
+// neither defaults.uds.hcl nor config.uds.hcl define. Reads through lookup
+// tolerate unset variables and are skipped.
+func checkUndefinedVariables(in *Input) []Finding {
+	defined := bundleinternal.MergeVariables(in.Defaults, in.Config)
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		for _, vf := range in.ValuesFiles[pkg.Name] {
+			for _, ref := range vf.References {
+				if ref.Lookup || defined.Defined(ref.Path) {
+					continue
+				}
+				findings = append(findings, Finding{
+					Message: fmt.Sprintf("values file for package %q reads .vars.%s, which neither %s nor %s defines", pkg.Name, ref.Path, bundleinternal.BundleDefaultsFileName, bundleinternal.ConfigFileName),
+					Range:   referenceRange(vf.Path, ref),
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func referenceRange(file string, ref zarf.VariableReference) hcl.Range {
+	width := len(".vars.") + len(ref.Path)
+	return hcl.Range{
+		Filename: file,
+		Start:    hcl.Pos{Line: ref.Line, Column: ref.Column},
+		End:      hcl.Pos{Line: ref.Line, Column: ref.Column + width},
+	}
+}
+
+// checkUnusedDefaults reports nested defaults that no values file reads.
+// Top-level scalars are also passed to Zarf as package variables, so they
+// are never reported. The rule is skipped while any values file fails to
+// parse, since its references are unknown.
+func checkUnusedDefaults(in *Input) []Finding {
+	var used []string
+	for _, files := range in.ValuesFiles {
+		for _, vf := range files {
+			if vf.Err != nil {
+				return nil
+			}
+			for _, ref := range vf.References {
+				used = append(used, ref.Path)
+			}
+		}
+	}
+
+	var findings []Finding
+	for _, leaf := range variableLeaves(in.Defaults, "") {
+		if !strings.Contains(leaf, ".") {
+			continue
+		}
+		if slices.ContainsFunc(used, func(ref string) bool { return pathOverlaps(ref, leaf) }) {
+			continue
+		}
+		rng, ok := in.DefaultRanges[leaf]
+		if !ok {
+			rng = hcl.Range{Filename: in.DefaultsFile, Start: hcl.InitialPos, End: hcl.InitialPos}
+		}
+		findings = append(findings, Finding{
+			Message: fmt.Sprintf("default variable %q is not read by any values file", leaf),
+			Range:   rng,
+		})
+	}
+	return findings
+}
+
+// variableLeaves returns the dotted paths of every non-object value in vars.
+func variableLeaves(vars bundleinternal.Variables, prefix string) []string {
+	var leaves []string
+	for key, value := range vars {
+		p := key
+		if prefix != "" {
+			p = prefix + "." + key
+		}
+		if nested, ok := value.(bundleinternal.Variables); ok && len(nested) > 0 {
+			leaves = append(leaves, variableLeaves(nested, p)...)
+			continue
+		}
+		leaves = append(leaves, p)
+	}
+	slices.Sort(leaves)
+	return leaves
+}
+
+// pathOverlaps reports whether one dotted path is the other or contains it,
+// so reading a whole object counts as reading its leaves.
+func pathOverlaps(a, b string) bool {
+	return slices.Contains(dottedPrefixes(a), b) || slices.Contains(dottedPrefixes(b), a)
+}
+
+// checkMissingNamespaces reports packages without a namespace.
+func checkMissingNamespaces(in *Input) []Finding {
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		if pkg.Namespace != "" {
+			continue
+		}
+		findings = append(findings, Finding{
+			Message: fmt.Sprintf("package %q does not set a namespace", pkg.Name),
+			Range:   in.packageSyntax(pkg.Name).DefRange,
+		})
+	}
+	return findings
+}
+
+// checkUnpinnedTags reports OCI sources that resolve through a mutable tag.
+// A source recorded in bundle.lock.hcl is pinned by the lock.
+func checkUnpinnedTags(in *Input) []Finding {
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		if !zarf.IsRemoteSource(pkg.Source) || strings.Contains(udsoci.TrimScheme(pkg.Source), "@") {
+			continue
+		}
+		if locked, ok := in.Lock.Package(pkg.Name); ok && locked.Source == pkg.Source && locked.Digest != "" {
+			continue
+		}
+		findings = append(findings, Finding{
+			Message: fmt.Sprintf("package %q source %q is not pinned by digest; run \"uds bundle update\" to lock it", pkg.Name, pkg.Source),
+			Range:   in.packageSyntax(pkg.Name).Range("source"),
+		})
+	}
+	return findings
+}
+
+// checkOptionalComponents reports optional components that the zarf.yaml of
+// a local package does not declare, and local packages whose components
+// cannot be read. OCI sources are not fetched.
+func checkOptionalComponents(in *Input) []Finding {
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		if len(pkg.OptionalComponents) == 0 {
+			continue
+		}
+		syntax := in.packageSyntax(pkg.Name)
+		components, ok, err := zarf.LocalPackageComponents(pkg.Source, in.BundleDir)
+		if err != nil {
+			findings = append(findings, Finding{
+				Message: fmt.Sprintf("package %q: reading components to check optional components: %v", pkg.Name, err),
+				Range:   syntax.Range("source"),
+			})
+			continue
+		}
+		if !ok {
+			continue
+		}
+		for i, name := range pkg.OptionalComponents {
+			pattern := strings.TrimPrefix(name, "-")
+			if slices.ContainsFunc(components, func(c string) bool {
+				matched, _ := path.Match(pattern, c)
+				return matched
+			}) {
+				continue
+			}
+			findings = append(findings, Finding{
+				Message: fmt.Sprintf("package %q has no component matching optional component %q", pkg.Name, name),
+				Range:   syntax.Element("optional_components", i),
+			})
+		}
+	}
+	return findings
+}
+
+// checkValuesFiles reports values files that cannot be read or parsed.
+func checkValuesFiles(in *Input) []Finding {
+	var findings []Finding
+	for _, pkg := range in.Bundle.Packages {
+		for _, vf := range in.ValuesFiles[pkg.Name] {
+			if vf.Err == nil {
+				continue
+			}
+			findings = append(findings, Finding{
+				Message: fmt.Sprintf("values file %q for package %q: %v", vf.Path, pkg.Name, vf.Err),
+				Range:   vf.Range,
+			})
+		}
+	}
+	return findings
+}
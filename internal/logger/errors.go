@@ -5,4 +5,14 @@ package logger
 
 import "errors"
 
-var ErrInvalidLevel = errors.New("invalid log level")
+var (
+	ErrInvalidLevel = errors.New("invalid log level")
+	// ErrOperationLogDir occurs when the operation log directory cannot be resolved or read.
+	ErrOperationLogDir = errors.New("operation log directory")
+	// ErrOpenOperationLog occurs when an operation log file cannot be created or opened.
+	ErrOpenOperationLog = errors.New("opening operation log")
+	// ErrOperationNotFound occurs when no log exists for an operation ID.
+	ErrOperationNotFound = errors.New("operation log not found")
+	// ErrReadOperationLog occurs when an operation log cannot be parsed or replayed.
+	ErrReadOperationLog = errors.New("reading operation log")
+)
@@ -0,0 +1,261 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package logger
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	console "github.com/phsym/console-slog"
+)
+
+// HomeEnv overrides the UDS home directory, which defaults to ~/.uds.
+const HomeEnv = "UDS_HOME"
+
+// MaxOperationLogs is the number of operation logs kept. Starting an
+// operation removes the oldest logs beyond it.
+const MaxOperationLogs = 20
+
+// OperationAnnotation marks a command, and every command below it, as an
+// operation whose log is written to the operation log directory.
+const OperationAnnotation = "dev.uds.operation-log"
+
+// OperationKey is the attribute carrying the operation ID on every record.
+const OperationKey = "operation"
+
+const operationLogExt = ".jsonl"
+
+// Messages recorded by Start and Finish, which List reads back to describe
+// each operation.
+const (
+	msgOperationStarted  = "operation started"
+	msgOperationFinished = "operation finished"
+	msgOperationFailed   = "operation failed"
+)
+
+// Home returns the UDS home directory: $UDS_HOME when set, otherwise ~/.uds.
+func Home() (string, error) {
+	if home := os.Getenv(HomeEnv); home != "" {
+		return home, nil
+	}
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(userHome, ".uds"), nil
+}
+
+// OperationLogDir returns the directory operation logs are written to.
+func OperationLogDir() (string, error) {
+	home, err := Home()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrOperationLogDir, err)
+	}
+	return filepath.Join(home, "logs"), nil
+}
+
+// OperationLog is a debug-level JSON log of a single CLI operation. Its
+// handler discards records until Start opens the log file, so a logger can be
+// built before the command that runs is known.
+type OperationLog struct {
+	mu      sync.Mutex
+	id      string
+	path    string
+	file    *os.File
+	handler slog.Handler
+}
+
+// NewOperationLog returns an operation log that has not been started.
+func NewOperationLog() *OperationLog {
+	return &OperationLog{}
+}
+
+// Start creates a log file for a new operation in dir, records the command
+// being run and removes the oldest logs beyond MaxOperationLogs. It returns
+// the operation ID.
+func (l *OperationLog) Start(dir, command string, now time.Time) (string, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file != nil {
+		return l.id, nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrOperationLogDir, dir, err)
+	}
+	id, err := newOperationID(now)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, id+operationLogExt)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrOpenOperationLog, path, err)
+	}
+	l.id, l.path, l.file = id, path, f
+	l.handler = slog.NewJSONHandler(f, &slog.HandlerOptions{Level: slog.LevelDebug}).
+		WithAttrs([]slog.Attr{slog.String(OperationKey, id)})
+	l.record(now, slog.LevelInfo, msgOperationStarted, slog.String("command", command))
+	return id, rotateOperationLogs(dir, MaxOperationLogs)
+}
+
+// Finish records whether the operation failed and closes the log file.
+func (l *OperationLog) Finish(err error) error {
+	l.mu.Lock()
+	if l.handler != nil {
+		if err != nil {
+			l.record(time.Now(), slog.LevelError, msgOperationFailed, slog.String("error", err.Error()))
+		} else {
+			l.record(time.Now(), slog.LevelInfo, msgOperationFinished)
+		}
+	}
+	l.mu.Unlock()
+	return l.Close()
+}
+
+// record writes directly to the started handler. The caller holds mu.
+func (l *OperationLog) record(t time.Time, level slog.Level, msg string, attrs ...slog.Attr) {
+	r := slog.NewRecord(t, level, msg, 0)
+	r.AddAttrs(attrs...)
+	_ = l.handler.Handle(context.Background(), r)
+}
+
+// ID returns the operation ID, or "" before Start.
+func (l *OperationLog) ID() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.id
+}
+
+// Path returns the log file path, or "" before Start.
+func (l *OperationLog) Path() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.path
+}
+
+// Close closes the log file. Later records are discarded.
+func (l *OperationLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.file == nil {
+		return nil
+	}
+	err := l.file.Close()
+	l.file, l.handler = nil, nil
+	return err
+}
+
+// Handler returns a handler that writes to the operation log once started.
+func (l *OperationLog) Handler() slog.Handler {
+	return &operationHandler{log: l}
+}
+
+// current returns the started handler, or nil.
+func (l *OperationLog) current() slog.Handler {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.handler
+}
+
+// operationHandler defers WithAttrs and WithGroup until a record is handled,
+// since the underlying handler does not exist until the log is started.
+type operationHandler struct {
+	log *OperationLog
+	ops []func(slog.Handler) slog.Handler
+}
+
+func (h *operationHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= slog.LevelDebug && h.log.current() != nil
+}
+
+func (h *operationHandler) Handle(ctx context.Context, r slog.Record) error {
+	handler := h.log.current()
+	if handler == nil {
+		return nil
+	}
+	for _, op := range h.ops {
+		handler = op(handler)
+	}
+	return handler.Handle(ctx, r)
+}
+
+func (h *operationHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(next slog.Handler) slog.Handler { return next.WithAttrs(attrs) })
+}
+
+func (h *operationHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(next slog.Handler) slog.Handler { return next.WithGroup(name) })
+}
+
+func (h *operationHandler) with(op func(slog.Handler) slog.Handler) slog.Handler {
+	return &operationHandler{log: h.log, ops: append(slices.Clip(h.ops), op)}
+}
+
+// NewWithOperationLog creates a logger writing to w at level, like New, and to
+// the operation log at debug level regardless of level.
+func NewWithOperationLog(w io.Writer, level slog.Leveler, oplog *OperationLog) *slog.Logger {
+	if w == nil {
+		w = io.Discard
+	}
+	return slog.New(slog.NewMultiHandler(
+		console.NewHandler(w, &console.HandlerOptions{Level: level}),
+		oplog.Handler(),
+	))
+}
+
+// newOperationID returns a UTC timestamp followed by a random suffix, so IDs
+// sort by start time.
+func newOperationID(now time.Time) (string, error) {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("generating operation ID: %w", err)
+	}
+	return now.UTC().Format("20060102T150405Z") + "-" + hex.EncodeToString(suffix), nil
+}
+
+// operationIDs returns the IDs of the logs in dir, oldest first.
+func operationIDs(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrOperationLogDir, dir, err)
+	}
+	var ids []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.Type().IsRegular() && strings.HasSuffix(name, operationLogExt) {
+			ids = append(ids, strings.TrimSuffix(name, operationLogExt))
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func rotateOperationLogs(dir string, keep int) error {
+	ids, err := operationIDs(dir)
+	if err != nil {
+		return err
+	}
+	for len(ids) > keep {
+		if err := os.Remove(filepath.Join(dir, ids[0]+operationLogExt)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("removing old operation log %q: %w", ids[0], err)
+		}
+		ids = ids[1:]
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package logger
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationLog_WritesDebugRecordsAtAnyConsoleLevel(t *testing.T) {
+	dir := t.TempDir()
+	var console bytes.Buffer
+	oplog := NewOperationLog()
+	level := new(slog.LevelVar)
+	s := iostreams.New(nil, nil, &console).WithLogger(NewWithOperationLog(&console, level, oplog), level)
+
+	s.Debug("before start")
+	id, err := oplog.Start(dir, "uds bundle deploy", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(id, "20260102T030405Z-"))
+
+	s = Bind(s, "warn")
+	s.Debug("resolving bundle", "ref", "oci://example.com/app:1.0.0")
+	grouped := s.Logger().WithGroup("zarf").With("package", "init")
+	grouped.Info("deploying")
+	require.NoError(t, oplog.Finish(errors.New("cluster unreachable")))
+	s.Error("after finish")
+
+	assert.NotContains(t, console.String(), "resolving bundle")
+	assert.Contains(t, console.String(), "after finish")
+
+	var raw bytes.Buffer
+	require.NoError(t, ReplayOperation(dir, id, &raw, true))
+	lines := strings.Split(strings.TrimSpace(raw.String()), "\n")
+	require.Len(t, lines, 4)
+	assert.Contains(t, lines[0], `"msg":"operation started","operation":"`+id+`","command":"uds bundle deploy"`)
+	assert.Contains(t, lines[1], `"msg":"resolving bundle","operation":"`+id+`","ref":"oci://example.com/app:1.0.0"`)
+	assert.Contains(t, lines[2], `"zarf":{"package":"init"}`)
+	assert.Contains(t, lines[3], `"level":"ERROR","msg":"operation failed"`)
+
+	var text bytes.Buffer
+	require.NoError(t, ReplayOperation(dir, id, &text, false))
+	assert.Contains(t, text.String(), "DBG resolving bundle ref=oci://example.com/app:1.0.0")
+	assert.NotContains(t, text.String(), id)
+
+	operations, err := ListOperations(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []OperationSummary{{
+		ID:      id,
+		Command: "uds bundle deploy",
+		Started: "2026-01-02T03:04:05Z",
+		Status:  OperationFailed,
+		Records: 4,
+	}}, operations)
+}
+
+func TestOperationLog_Rotates(t *testing.T) {
+	dir := t.TempDir()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	var ids []string
+	for i := range MaxOperationLogs + 2 {
+		oplog := NewOperationLog()
+		id, err := oplog.Start(dir, "uds bundle inspect", start.Add(time.Duration(i)*time.Second))
+		require.NoError(t, err)
+		require.NoError(t, oplog.Finish(nil))
+		ids = append(ids, id)
+	}
+
+	operations, err := ListOperations(dir)
+	require.NoError(t, err)
+	require.Len(t, operations, MaxOperationLogs)
+	assert.Equal(t, ids[len(ids)-1], operations[0].ID)
+	assert.Equal(t, ids[2], operations[len(operations)-1].ID)
+	assert.Equal(t, OperationSucceeded, operations[0].Status)
+}
+
+func TestReplayOperation_Errors(t *testing.T) {
+	dir := t.TempDir()
+	require.ErrorIs(t, ReplayOperation(dir, "missing", &bytes.Buffer{}, false), ErrOperationNotFound)
+	require.ErrorIs(t, ReplayOperation(dir, "../escape", &bytes.Buffer{}, false), ErrOperationNotFound)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.jsonl"), []byte("not json\n"), 0o600))
+	require.ErrorIs(t, ReplayOperation(dir, "bad", &bytes.Buffer{}, false), ErrReadOperationLog)
+
+	operations, err := ListOperations(filepath.Join(dir, "absent"))
+	require.NoError(t, err)
+	assert.Empty(t, operations)
+}
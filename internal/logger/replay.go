@@ -0,0 +1,185 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	console "github.com/phsym/console-slog"
+)
+
+// OperationStatus describes how a logged operation ended.
+type OperationStatus string
+
+const (
+	OperationSucceeded OperationStatus = "succeeded"
+	OperationFailed    OperationStatus = "failed"
+	// OperationIncomplete is reported for an operation that is still running
+	// or whose process exited without finishing the log.
+	OperationIncomplete OperationStatus = "incomplete"
+)
+
+// OperationSummary describes one operation log.
+type OperationSummary struct {
+	ID      string          `json:"id" yaml:"id" text:"ID"`
+	Command string          `json:"command" yaml:"command" text:"Command"`
+	Started string          `json:"started" yaml:"started" text:"Started"`
+	Status  OperationStatus `json:"status" yaml:"status" text:"Status"`
+	Records int             `json:"records" yaml:"records" text:"Records"`
+}
+
+// ListOperations summarizes the operation logs in dir, newest first.
+func ListOperations(dir string) ([]OperationSummary, error) {
+	ids, err := operationIDs(dir)
+	if err != nil {
+		return nil, err
+	}
+	summaries := make([]OperationSummary, 0, len(ids))
+	for _, id := range slices.Backward(ids) {
+		summary := OperationSummary{ID: id, Status: OperationIncomplete}
+		err := readOperation(dir, id, func(_ []byte, rec *logRecord) error {
+			summary.Records++
+			switch rec.msg {
+			case msgOperationStarted:
+				summary.Started = rec.time.UTC().Format(time.RFC3339)
+				summary.Command, _ = rec.attr("command").(string)
+			case msgOperationFinished:
+				summary.Status = OperationSucceeded
+			case msgOperationFailed:
+				summary.Status = OperationFailed
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// ReplayOperation writes the log of operation id in dir to w. With raw set,
+// the JSON records are copied unchanged; otherwise every record is rendered
+// the way the console shows it at debug level.
+func ReplayOperation(dir, id string, w io.Writer, raw bool) error {
+	if raw {
+		return readOperation(dir, id, func(line []byte, _ *logRecord) error {
+			if _, err := w.Write(line); err != nil {
+				return err
+			}
+			_, err := io.WriteString(w, "\n")
+			return err
+		})
+	}
+	handler := console.NewHandler(w, &console.HandlerOptions{Level: slog.LevelDebug, NoColor: true})
+	return readOperation(dir, id, func(_ []byte, rec *logRecord) error {
+		r := slog.NewRecord(rec.time, rec.level, rec.msg, 0)
+		for _, attr := range rec.attrs {
+			if attr.Key != OperationKey {
+				r.AddAttrs(attr)
+			}
+		}
+		return handler.Handle(context.Background(), r)
+	})
+}
+
+// readOperation calls fn with each record of operation id in dir.
+func readOperation(dir, id string, fn func([]byte, *logRecord) error) error {
+	if id == "" || filepath.Base(id) != id {
+		return fmt.Errorf("%w: invalid operation ID %q", ErrOperationNotFound, id)
+	}
+	f, err := os.Open(filepath.Join(dir, id+operationLogExt))
+	if errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("%w: %q", ErrOperationNotFound, id)
+	}
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadOperationLog, id, err)
+	}
+	defer func() { _ = f.Close() }()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		rec, err := parseLogRecord(scanner.Bytes())
+		if err != nil {
+			return fmt.Errorf("%w %q line %d: %w", ErrReadOperationLog, id, line, err)
+		}
+		if err := fn(scanner.Bytes(), rec); err != nil {
+			return err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadOperationLog, id, err)
+	}
+	return nil
+}
+
+// logRecord is a JSON log record with its attributes in written order.
+type logRecord struct {
+	time  time.Time
+	level slog.Level
+	msg   string
+	attrs []slog.Attr
+}
+
+func (r *logRecord) attr(key string) any {
+	for _, attr := range r.attrs {
+		if attr.Key == key {
+			return attr.Value.Any()
+		}
+	}
+	return nil
+}
+
+func parseLogRecord(line []byte) (*logRecord, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	dec.UseNumber()
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	rec := &logRecord{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var value any
+		if err := dec.Decode(&value); err != nil {
+			return nil, err
+		}
+		switch key {
+		case slog.TimeKey:
+			s, _ := value.(string)
+			if rec.time, err = time.Parse(time.RFC3339Nano, s); err != nil {
+				return nil, err
+			}
+		case slog.LevelKey:
+			s, _ := value.(string)
+			if err := rec.level.UnmarshalText([]byte(s)); err != nil {
+				return nil, err
+			}
+		case slog.MessageKey:
+			rec.msg, _ = value.(string)
+		default:
+			rec.attrs = append(rec.attrs, slog.Any(key, value))
+		}
+	}
+	return rec, nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// diagnosticSource names the server in diagnostics.
+const diagnosticSource = "uds"
+
+// offlineResolver stands in for the bundles bundle blocks include, which are
+// only fetched by create. Each resolves to one placeholder package so
+// bundle.<name> references decode; validation, which needs the included
+// packages, is skipped for bundles that include others.
+type offlineResolver struct{}
+
+var _ bundleinternal.BundleResolver = offlineResolver{}
+
+func (offlineResolver) ResolveBundle(_ context.Context, _, source string) (*bundleinternal.IncludedBundle, error) {
+	return &bundleinternal.IncludedBundle{Bundle: &spec.UDSBundle{Packages: []spec.Package{{Name: "*", Source: source}}}}, nil
+}
+
+// diagnose parses d the way the CLI does and reports every problem at the
+// range it refers to. Bundles are also checked by spec.UDSBundle.Validate.
+func (s *Server) diagnose(ctx context.Context, d *document) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	switch d.kind {
+	case kindBundle:
+		b, err := s.parser.ParseBundleSource(ctx, d.text, d.path)
+		if err != nil {
+			return append(diagnostics, d.errorDiagnostics(err)...)
+		}
+		if slices.ContainsFunc(b.Packages, func(pkg spec.Package) bool { return pkg.Bundle != "" }) {
+			return diagnostics
+		}
+		err = b.Validate()
+		if err == nil {
+			return diagnostics
+		}
+		errs := []error{err}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			errs = joined.Unwrap()
+		}
+		for _, err := range errs {
+			rng := d.validationRange(err)
+			diagnostics = append(diagnostics, Diagnostic{Range: d.rangeOf(rng), Severity: SeverityError, Source: diagnosticSource, Message: err.Error()})
+		}
+	case kindDefaults:
+		if _, _, err := bundleinternal.ParseDefaultsSource(ctx, d.text, d.path); err != nil {
+			diagnostics = append(diagnostics, d.errorDiagnostics(err)...)
+		}
+	case kindConfig:
+		if _, err := s.parser.ParseBundleConfigSource(ctx, d.text, d.path); err != nil {
+			diagnostics = append(diagnostics, d.errorDiagnostics(err)...)
+		}
+	}
+	return diagnostics
+}
+
+// errorDiagnostics converts a parse error into diagnostics. HCL diagnostics
+// keep their own ranges; local errors are reported where the local is
+// written. Other errors are reported at the start of the document.
+func (d *document) errorDiagnostics(err error) []Diagnostic {
+	var (
+		duplicate *bundleinternal.DuplicateLocalError
+		undefined *bundleinternal.UndefinedLocalDependencyError
+		cycle     *bundleinternal.CyclicLocalDependencyError
+		diags     hcl.Diagnostics
+	)
+	at := func(rng *hcl.Range) []Diagnostic {
+		return []Diagnostic{{Range: d.rangeOf(rng), Severity: SeverityError, Source: diagnosticSource, Message: err.Error()}}
+	}
+	switch {
+	case errors.As(err, &duplicate):
+		return at(&duplicate.Duplicate)
+	case errors.As(err, &undefined):
+		return at(&undefined.Position)
+	case errors.As(err, &cycle):
+		if attr := d.local(cycle.Cycle[0]); attr != nil {
+			return at(&attr.NameRange)
+		}
+	case errors.As(err, &diags) && len(diags) > 0:
+		var diagnostics []Diagnostic
+		for _, diag := range diags {
+			severity := SeverityError
+			if diag.Severity == hcl.DiagWarning {
+				severity = SeverityWarning
+			}
+			message := diag.Summary
+			if diag.Detail != "" {
+				message += ": " + diag.Detail
+			}
+			diagnostics = append(diagnostics, Diagnostic{Range: d.rangeOf(diag.Subject), Severity: severity, Source: diagnosticSource, Message: message})
+		}
+		return diagnostics
+	}
+	return at(nil)
+}
+
+// validationRange returns the range of the part of the bundle a
+// spec.UDSBundle.Validate error is about, or nil when it cannot be found.
+func (d *document) validationRange(err error) *hcl.Range {
+	var (
+		version            *spec.UnsupportedBundleAPIVersionError
+		nameRequired       *spec.PackageNameRequiredError
+		invalidName        *spec.InvalidPackageNameError
+		duplicateName      *spec.DuplicatePackageNameError
+		sourceRequired     *spec.PackageSourceRequiredError
+		self               *spec.SelfDependencyError
+		unknown            *spec.UnknownDependencyError
+		emptyComponent     *spec.EmptyOptionalComponentError
+		duplicateComponent *spec.DuplicateOptionalComponentError
+	)
+	switch {
+	case errors.As(err, &version), errors.Is(err, spec.ErrBundleAPIVersionRequired):
+		return d.attributeRange("uds", "bundle_api_version")
+	case errors.Is(err, spec.ErrMetadataNameRequired):
+		return d.attributeRange("metadata", "name")
+	case errors.As(err, &nameRequired):
+		return d.labelRange(d.labeled("package", ""), 0)
+	case errors.As(err, &invalidName):
+		return d.labelRange(d.labeled("package", invalidName.Name), 0)
+	case errors.As(err, &duplicateName):
+		return d.labelRange(d.labeled("package", duplicateName.Name), 1)
+	case errors.As(err, &sourceRequired):
+		if block := d.packageBlock(sourceRequired.Package); block != nil {
+			if attr, ok := block.Body.Attributes["source"]; ok {
+				return &attr.SrcRange
+			}
+			return d.labelRange([]*hclsyntax.Block{block}, 0)
+		}
+	case errors.As(err, &self):
+		return d.dependencyRange(self.Package, self.Package)
+	case errors.As(err, &unknown):
+		return d.dependencyRange(unknown.Package, unknown.Dependency)
+	case errors.As(err, &emptyComponent):
+		return d.componentRange(emptyComponent.Package, "", 0)
+	case errors.As(err, &duplicateComponent):
+		return d.componentRange(duplicateComponent.Package, duplicateComponent.Component, 1)
+	}
+	return nil
+}
+
+// packageBlock returns the block that declares package name, including the
+// instances of blocks expanded by for_each or count.
+func (d *document) packageBlock(name string) *hclsyntax.Block {
+	if i := strings.IndexByte(name, '['); i > 0 {
+		name = name[:i]
+	}
+	if blocks := d.labeled("package", name); len(blocks) > 0 {
+		return blocks[0]
+	}
+	return nil
+}
+
+// labelRange returns the label range of blocks[i], or of the last block when
+// there are fewer.
+func (d *document) labelRange(blocks []*hclsyntax.Block, i int) *hcl.Range {
+	if len(blocks) == 0 {
+		return nil
+	}
+	block := blocks[min(i, len(blocks)-1)]
+	if len(block.LabelRanges) > 0 {
+		return &block.LabelRanges[0]
+	}
+	rng := block.DefRange()
+	return &rng
+}
+
+// attributeRange returns the range of attribute name in the first typ block,
+// or of the block header when the attribute is not set.
+func (d *document) attributeRange(typ, name string) *hcl.Range {
+	blocks := d.blocks(typ)
+	if len(blocks) == 0 {
+		return nil
+	}
+	if attr, ok := blocks[0].Body.Attributes[name]; ok {
+		return &attr.SrcRange
+	}
+	rng := blocks[0].DefRange()
+	return &rng
+}
+
+// dependencyRange returns the range of the depends_on element of package
+// pkg that references dependency.
+func (d *document) dependencyRange(pkg, dependency string) *hcl.Range {
+	block := d.packageBlock(pkg)
+	if block == nil {
+		return nil
+	}
+	attr, ok := block.Body.Attributes["depends_on"]
+	if !ok {
+		return d.labelRange([]*hclsyntax.Block{block}, 0)
+	}
+	if i := strings.IndexByte(dependency, '['); i > 0 {
+		dependency = dependency[:i]
+	}
+	elems, _ := hcl.ExprList(attr.Expr)
+	for _, elem := range elems {
+		ref := elem
+		if index, ok := elem.(*hclsyntax.IndexExpr); ok {
+			// package.<name>[each.key] or package.<name>[count.index]
+			ref = index.Collection
+		}
+		traversal, diags := hcl.AbsTraversalForExpr(ref)
+		if !diags.HasErrors() && referenceName(traversal) == dependency {
+			rng := elem.Range()
+			return &rng
+		}
+	}
+	return &attr.SrcRange
+}
+
+// componentRange returns the range of occurrence n of component in the
+// optional_components of package pkg.
+func (d *document) componentRange(pkg, component string, n int) *hcl.Range {
+	block := d.packageBlock(pkg)
+	if block == nil {
+		return nil
+	}
+	attr, ok := block.Body.Attributes["optional_components"]
+	if !ok {
+		return d.labelRange([]*hclsyntax.Block{block}, 0)
+	}
+	elems, _ := hcl.ExprList(attr.Expr)
+	for _, elem := range elems {
+		value, diags := elem.Value(nil)
+		if diags.HasErrors() || value.Type() != cty.String || value.IsNull() || value.AsString() != component {
+			continue
+		}
+		if n == 0 {
+			rng := elem.Range()
+			return &rng
+		}
+		n--
+	}
+	return &attr.SrcRange
+}
+
+// referenceName returns the block label a package.<name>, package["<name>"],
+// bundle.<name> or local.<name> traversal refers to.
+func referenceName(traversal hcl.Traversal) string {
+	if len(traversal) < 2 {
+		return ""
+	}
+	switch step := traversal[1].(type) {
+	case hcl.TraverseAttr:
+		return step.Name
+	case hcl.TraverseIndex:
+		if step.Key.Type() == cty.String && step.Key.IsKnown() && !step.Key.IsNull() {
+			return step.Key.AsString()
+		}
+	}
+	return ""
+}
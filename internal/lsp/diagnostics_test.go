@@ -0,0 +1,117 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnose(t *testing.T) {
+	const header = "uds {\n  bundle_api_version = \"uds.dev/v1alpha1\"\n}\nmetadata {\n  name = \"core\"\n}\n"
+	tests := []struct {
+		name    string
+		file    string
+		text    string
+		message string
+		rng     Range
+	}{
+		{
+			name:    "syntax error",
+			file:    "bundle.uds.hcl",
+			text:    header + "package \"a\" {\n  source = \n}\n",
+			message: "Invalid expression: Expected the start of an expression, but found an invalid expression token.",
+			rng:     Range{Start: Position{Line: 7, Character: 11}, End: Position{Line: 8, Character: 0}},
+		},
+		{
+			name:    "undefined local",
+			file:    "bundle.uds.hcl",
+			text:    header + "locals {\n  a = local.b\n}\npackage \"a\" {\n  source = local.a\n}\n",
+			message: "undefined local dependency: b referenced at ",
+			rng:     Range{Start: Position{Line: 7, Character: 6}, End: Position{Line: 7, Character: 13}},
+		},
+		{
+			name:    "missing attribute",
+			file:    "bundle.uds.hcl",
+			text:    header + "package \"a\" {\n  namespace = \"a\"\n}\n",
+			message: "Missing required argument: The argument \"source\" is required, but no definition was found.",
+			rng:     Range{Start: Position{Line: 6, Character: 12}, End: Position{Line: 6, Character: 12}},
+		},
+		{
+			name:    "duplicate package",
+			file:    "bundle.uds.hcl",
+			text:    header + "package \"a\" {\n  source = \"./a\"\n}\npackage \"a\" {\n  source = \"./b\"\n}\n",
+			message: "package[1]: duplicate package name \"a\"",
+			rng:     Range{Start: Position{Line: 9, Character: 8}, End: Position{Line: 9, Character: 11}},
+		},
+		{
+			name:    "duplicate optional component",
+			file:    "bundle.uds.hcl",
+			text:    header + "package \"a\" {\n  source              = \"./a\"\n  optional_components = [\"x\", \"x\"]\n}\n",
+			message: "package \"a\": duplicate optional component \"x\"",
+			rng:     Range{Start: Position{Line: 8, Character: 30}, End: Position{Line: 8, Character: 33}},
+		},
+		{
+			name:    "unsupported api version",
+			file:    "bundle.uds.hcl",
+			text:    "uds {\n  bundle_api_version = \"v2\"\n}\nmetadata {\n  name = \"core\"\n}\npackage \"a\" {\n  source = \"./a\"\n}\n",
+			message: "uds.bundle_api_version \"v2\" is not supported; expected \"uds.dev/v1alpha1\"",
+			rng:     Range{Start: Position{Line: 1, Character: 2}, End: Position{Line: 1, Character: 27}},
+		},
+		{
+			name:    "defaults",
+			file:    "defaults.uds.hcl",
+			text:    "variables = {}\noptions {}\n",
+			message: "Unsupported block type: Blocks of type \"options\" are not expected here.",
+			rng:     Range{Start: Position{Line: 1, Character: 0}, End: Position{Line: 1, Character: 7}},
+		},
+		{
+			name:    "config",
+			file:    "config.uds.hcl",
+			text:    "options {\n  concurrency = \"many\"\n}\n",
+			message: "Unsuitable value type: Unsuitable value: a number is required",
+			rng:     Range{Start: Position{Line: 1, Character: 17}, End: Position{Line: 1, Character: 21}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newDocument("file://"+filepath.ToSlash(filepath.Join(t.TempDir(), tt.file)), tt.text)
+			diagnostics := NewServer(Options{}).diagnose(t.Context(), d)
+			require.Len(t, diagnostics, 1)
+			assert.Contains(t, diagnostics[0].Message, tt.message)
+			assert.Equal(t, tt.rng, diagnostics[0].Range)
+			assert.Equal(t, SeverityError, diagnostics[0].Severity)
+		})
+	}
+}
+
+func TestDiagnose_Valid(t *testing.T) {
+	dir := t.TempDir()
+	s := NewServer(Options{})
+	d := newDocument("file://"+filepath.ToSlash(filepath.Join(dir, "bundle.uds.hcl")), testBundle)
+	assert.Len(t, s.diagnose(t.Context(), d), 1)
+
+	// Included bundles are not fetched, so their packages are not validated.
+	d = newDocument("file://"+filepath.ToSlash(filepath.Join(dir, "bundle.uds.hcl")), `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "platform"
+}
+bundle "core" {
+  source = "oci://ghcr.io/uds/core:1.0.0"
+}
+package "app" {
+  source     = "./app.tar.zst"
+  depends_on = [bundle.core, package["core.keycloak"]]
+}
+`)
+	assert.Empty(t, s.diagnose(t.Context(), d))
+
+	d = newDocument("file://"+filepath.ToSlash(filepath.Join(dir, "notes.hcl")), "not = [")
+	assert.Empty(t, s.diagnose(t.Context(), d))
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"net/url"
+	"path/filepath"
+	"sort"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// fileKind is the bundle file a document holds, recognized by its name.
+type fileKind string
+
+const (
+	kindBundle   fileKind = "bundle"
+	kindDefaults fileKind = "defaults"
+	kindConfig   fileKind = "config"
+)
+
+// kindOf returns the kind of the file at path, or "" for files the server
+// does not handle.
+func kindOf(path string) fileKind {
+	switch filepath.Base(path) {
+	case bundleinternal.BundleFileName, bundleinternal.BundleJSONFileName:
+		return kindBundle
+	case bundleinternal.BundleDefaultsFileName, bundleinternal.BundleDefaultsJSONFileName:
+		return kindDefaults
+	case bundleinternal.ConfigFileName, bundleinternal.ConfigJSONFileName:
+		return kindConfig
+	}
+	return ""
+}
+
+// document is an open text document and its parsed syntax.
+type document struct {
+	uri  string
+	path string
+	kind fileKind
+	text []byte
+	// lines holds the byte offset each line starts at.
+	lines []int
+	// body is the native syntax body, recovered as far as the parser could
+	// despite errors. It is nil for JSON syntax.
+	body *hclsyntax.Body
+}
+
+func newDocument(uri, text string) *document {
+	d := &document{uri: uri, path: uriPath(uri), text: []byte(text), lines: []int{0}}
+	d.kind = kindOf(d.path)
+	for i, b := range d.text {
+		if b == '\n' {
+			d.lines = append(d.lines, i+1)
+		}
+	}
+	if file, _ := bundleinternal.ParseHCL(d.text, d.path); file != nil {
+		d.body, _ = file.Body.(*hclsyntax.Body)
+	}
+	return d
+}
+
+// uriPath returns the file system path of a file URI, or "" for other schemes.
+func uriPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return ""
+	}
+	return filepath.FromSlash(u.Path)
+}
+
+// position converts a byte offset into a protocol position.
+func (d *document) position(offset int) Position {
+	offset = min(max(offset, 0), len(d.text))
+	line := sort.Search(len(d.lines), func(i int) bool { return d.lines[i] > offset }) - 1
+	character := 0
+	for _, r := range string(d.text[d.lines[line]:offset]) {
+		character += utf16.RuneLen(r)
+	}
+	return Position{Line: line, Character: character}
+}
+
+// offset converts a protocol position into a byte offset, clamped to the
+// line it names.
+func (d *document) offset(pos Position) int {
+	if pos.Line < 0 {
+		return 0
+	}
+	if pos.Line >= len(d.lines) {
+		return len(d.text)
+	}
+	offset := d.lines[pos.Line]
+	for character := 0; offset < len(d.text) && character < pos.Character; {
+		r, size := utf8.DecodeRune(d.text[offset:])
+		if r == '\n' {
+			break
+		}
+		character += utf16.RuneLen(r)
+		offset += size
+	}
+	return offset
+}
+
+// rangeOf converts an HCL range of this document into a protocol range.
+// Ranges in other files, and missing ranges, become the start of the document.
+func (d *document) rangeOf(rng *hcl.Range) Range {
+	if rng == nil || (rng.Filename != "" && rng.Filename != d.path) {
+		return Range{}
+	}
+	return Range{Start: d.position(rng.Start.Byte), End: d.position(rng.End.Byte)}
+}
+
+// blocks returns the top-level blocks of type typ.
+func (d *document) blocks(typ string) []*hclsyntax.Block {
+	if d.body == nil {
+		return nil
+	}
+	var blocks []*hclsyntax.Block
+	for _, block := range d.body.Blocks {
+		if block.Type == typ {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// labeled returns the top-level blocks of type typ labeled name.
+func (d *document) labeled(typ, name string) []*hclsyntax.Block {
+	var blocks []*hclsyntax.Block
+	for _, block := range d.blocks(typ) {
+		if len(block.Labels) > 0 && block.Labels[0] == name {
+			blocks = append(blocks, block)
+		}
+	}
+	return blocks
+}
+
+// local returns the attribute that declares local name.
+func (d *document) local(name string) *hclsyntax.Attribute {
+	for _, block := range d.blocks("locals") {
+		if attr, ok := block.Body.Attributes[name]; ok {
+			return attr
+		}
+	}
+	return nil
+}
+
+// source returns the source text of rng.
+func (d *document) source(rng hcl.Range) string {
+	start, end := min(rng.Start.Byte, len(d.text)), min(rng.End.Byte, len(d.text))
+	return string(d.text[start:end])
+}
@@ -0,0 +1,21 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import "errors"
+
+var (
+	// ErrReadMessage occurs when a message from the client is not framed correctly.
+	ErrReadMessage = errors.New("reading message")
+	// ErrWriteMessage occurs when a message cannot be sent to the client.
+	ErrWriteMessage = errors.New("writing message")
+	// ErrNotInitialized occurs when a request arrives before initialize.
+	ErrNotInitialized = errors.New("server is not initialized")
+	// ErrUnknownMethod occurs when the client calls a method the server does not implement.
+	ErrUnknownMethod = errors.New("unknown method")
+	// ErrInvalidParams occurs when request parameters cannot be decoded.
+	ErrInvalidParams = errors.New("invalid params")
+	// ErrExitWithoutShutdown occurs when the client sends exit before shutdown.
+	ErrExitWithoutShutdown = errors.New("exit received before shutdown")
+)
@@ -0,0 +1,287 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	// referencePrefix matches a package, bundle or local reference being
+	// typed at the end of a line.
+	referencePrefix = regexp.MustCompile(`(?:^|[^\w.-])(package|bundle|local)\.[\w-]*$`)
+	// namePrefix matches a line where an attribute or block name is being typed.
+	namePrefix = regexp.MustCompile(`^\s*[\w-]*$`)
+	// dependsOnPrefix matches a line inside an unfinished depends_on list.
+	dependsOnPrefix = regexp.MustCompile(`\bdepends_on\s*=\s*\[[^\]]*$`)
+)
+
+// contains reports whether offset is within rng, including its end.
+func contains(rng hcl.Range, offset int) bool {
+	return rng.Start.Byte <= offset && offset <= rng.End.Byte
+}
+
+// enclosing returns the blocks whose bodies contain offset, outermost first,
+// and the schema of the innermost one. The schema is nil inside blocks the
+// schema does not describe.
+func (d *document) enclosing(offset int) ([]*hclsyntax.Block, *blockSchema) {
+	var path []*hclsyntax.Block
+	schema := schemaFor(d.kind)
+	for body := d.body; body != nil && schema != nil; {
+		var next *hclsyntax.Block
+		for _, block := range body.Blocks {
+			if block.OpenBraceRange.End.Byte <= offset && offset <= block.CloseBraceRange.Start.Byte {
+				next = block
+				break
+			}
+		}
+		if next == nil {
+			break
+		}
+		path = append(path, next)
+		schema = schema.block(next.Type)
+		body = next.Body
+	}
+	return path, schema
+}
+
+// complete returns the completions at offset: package, bundle and local
+// references after their prefix or inside depends_on, and otherwise the
+// attributes and blocks the enclosing block accepts.
+func (d *document) complete(offset int) []CompletionItem {
+	if d.body == nil {
+		return nil
+	}
+	line := string(d.text[d.lines[d.position(offset).Line]:offset])
+	path, schema := d.enclosing(offset)
+	current := ""
+	if len(path) > 0 && path[0].Type == "package" && len(path[0].Labels) > 0 {
+		current = path[0].Labels[0]
+	}
+
+	if d.kind == kindBundle {
+		if m := referencePrefix.FindStringSubmatch(line); m != nil {
+			return d.references(m[1], current, false)
+		}
+		if dependsOnPrefix.MatchString(line) || d.inDependsOn(path, offset) {
+			return append(d.references("package", current, true), d.references("bundle", current, true)...)
+		}
+	}
+	if schema == nil || schema.freeform || !namePrefix.MatchString(line) {
+		return nil
+	}
+
+	body := d.body
+	if len(path) > 0 {
+		body = path[len(path)-1].Body
+	}
+	var items []CompletionItem
+	for _, attr := range schema.attributes {
+		if _, set := body.Attributes[attr.name]; set {
+			continue
+		}
+		items = append(items, CompletionItem{Label: attr.name, Kind: kindProperty, Detail: "attribute", Documentation: markdown(attr.doc), InsertText: attr.name + " = "})
+	}
+	for _, block := range schema.blocks {
+		items = append(items, CompletionItem{Label: block.name, Kind: kindKeyword, Detail: "block", Documentation: markdown(block.schema.doc)})
+	}
+	return items
+}
+
+// inDependsOn reports whether offset is inside the depends_on expression of
+// the innermost enclosing block.
+func (d *document) inDependsOn(path []*hclsyntax.Block, offset int) bool {
+	if len(path) == 0 {
+		return false
+	}
+	attr, ok := path[len(path)-1].Body.Attributes["depends_on"]
+	return ok && contains(attr.Expr.Range(), offset)
+}
+
+// references returns completions for the labels of package or bundle
+// blocks, or for locals. Package current, the one being edited, is left out.
+// qualified completions include the root, such as package.core.
+func (d *document) references(root, current string, qualified bool) []CompletionItem {
+	var items []CompletionItem
+	add := func(name string, kind int, detail string) {
+		label := name
+		if qualified {
+			label = root + "." + name
+		}
+		if !hclsyntax.ValidIdentifier(name) || slices.ContainsFunc(items, func(item CompletionItem) bool { return item.Label == label }) {
+			return
+		}
+		items = append(items, CompletionItem{Label: label, Kind: kind, Detail: detail})
+	}
+	switch root {
+	case "package", "bundle":
+		for _, block := range d.blocks(root) {
+			if len(block.Labels) == 0 || (root == "package" && block.Labels[0] == current) {
+				continue
+			}
+			add(block.Labels[0], kindModule, d.literal(block.Body.Attributes["source"]))
+		}
+	case "local":
+		for _, block := range d.blocks("locals") {
+			for _, attr := range sortedAttributes(block.Body) {
+				add(attr.Name, kindVariable, d.source(attr.Expr.Range()))
+			}
+		}
+	}
+	return items
+}
+
+// definition returns where the package, bundle or local referenced at
+// offset is declared.
+func (d *document) definition(offset int) []Location {
+	traversal, _, ok := d.traversalAt(offset)
+	if !ok {
+		return nil
+	}
+	name := referenceName(traversal)
+	var locations []Location
+	switch root := traversal.RootName(); root {
+	case "package", "bundle":
+		for _, block := range d.labeled(root, name) {
+			rng := block.DefRange()
+			locations = append(locations, Location{URI: d.uri, Range: d.rangeOf(&rng)})
+		}
+	case "local":
+		if attr := d.local(name); attr != nil {
+			locations = append(locations, Location{URI: d.uri, Range: d.rangeOf(&attr.NameRange)})
+		}
+	}
+	return locations
+}
+
+// traversalAt returns the reference expression at offset.
+func (d *document) traversalAt(offset int) (hcl.Traversal, hcl.Range, bool) {
+	if d.body == nil {
+		return nil, hcl.Range{}, false
+	}
+	var (
+		found hcl.Traversal
+		rng   hcl.Range
+	)
+	_ = hclsyntax.VisitAll(d.body, func(node hclsyntax.Node) hcl.Diagnostics {
+		if expr, ok := node.(*hclsyntax.ScopeTraversalExpr); ok && contains(expr.SrcRange, offset) {
+			found, rng = expr.Traversal, expr.SrcRange
+		}
+		return nil
+	})
+	return found, rng, found != nil
+}
+
+// hover returns documentation for the reference, attribute name or block
+// type at offset.
+func (d *document) hover(offset int) *Hover {
+	if traversal, rng, ok := d.traversalAt(offset); ok {
+		if text := d.describeReference(traversal); text != "" {
+			r := d.rangeOf(&rng)
+			return &Hover{Contents: *markdown(text), Range: &r}
+		}
+		return nil
+	}
+
+	schema := schemaFor(d.kind)
+	for body := d.body; body != nil && schema != nil; {
+		for _, attr := range sortedAttributes(body) {
+			if !contains(attr.NameRange, offset) {
+				continue
+			}
+			if a := schema.attribute(attr.Name); a != nil {
+				r := d.rangeOf(&attr.NameRange)
+				return &Hover{Contents: *markdown(fmt.Sprintf("`%s` attribute\n\n%s", a.name, a.doc)), Range: &r}
+			}
+			return nil
+		}
+		var next *hclsyntax.Block
+		for _, block := range body.Blocks {
+			if contains(block.TypeRange, offset) {
+				if b := schema.block(block.Type); b != nil {
+					r := d.rangeOf(&block.TypeRange)
+					return &Hover{Contents: *markdown(fmt.Sprintf("`%s` block\n\n%s", blockHeader(block.Type, b), b.doc)), Range: &r}
+				}
+				return nil
+			}
+			if contains(block.Body.SrcRange, offset) {
+				next = block
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		schema, body = schema.block(next.Type), next.Body
+	}
+	return nil
+}
+
+// describeReference returns Markdown describing what a reference names.
+func (d *document) describeReference(traversal hcl.Traversal) string {
+	name := referenceName(traversal)
+	switch root := traversal.RootName(); root {
+	case "package", "bundle":
+		blocks := d.labeled(root, name)
+		if len(blocks) == 0 {
+			return fmt.Sprintf("`%s %q` is not declared in this file", root, name)
+		}
+		text := fmt.Sprintf("`%s %q`", root, name)
+		if source := d.literal(blocks[0].Body.Attributes["source"]); source != "" {
+			text += "\n\nsource: `" + source + "`"
+		}
+		return text
+	case "local":
+		attr := d.local(name)
+		if attr == nil {
+			return fmt.Sprintf("`local.%s` is not declared in this file", name)
+		}
+		return fmt.Sprintf("```hcl\n%s = %s\n```", name, d.source(attr.Expr.Range()))
+	case "sys":
+		if name == "arch" {
+			return "`sys.arch` is the target architecture of the bundle, set by `--architecture` or the config."
+		}
+	}
+	return ""
+}
+
+// literal returns the value of a string attribute written without
+// references, or "".
+func (d *document) literal(attr *hclsyntax.Attribute) string {
+	if attr == nil {
+		return ""
+	}
+	value, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || value.Type() != cty.String || value.IsNull() || !value.IsKnown() {
+		return ""
+	}
+	return value.AsString()
+}
+
+// sortedAttributes returns the attributes of body in source order.
+func sortedAttributes(body *hclsyntax.Body) []*hclsyntax.Attribute {
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+	slices.SortFunc(attrs, func(a, b *hclsyntax.Attribute) int { return a.SrcRange.Start.Byte - b.SrcRange.Start.Byte })
+	return attrs
+}
+
+func blockHeader(typ string, schema *blockSchema) string {
+	if len(schema.labels) == 0 {
+		return typ
+	}
+	return typ + ` "<` + strings.Join(schema.labels, `>" "<`) + `>"`
+}
+
+func markdown(text string) *MarkupContent {
+	return &MarkupContent{Kind: "markdown", Value: text}
+}
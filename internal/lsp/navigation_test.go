@@ -0,0 +1,159 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// at returns the document for text with the cursor marker | removed, and the
+// offset of the marker.
+func at(t *testing.T, name, text string) (*document, int) {
+	t.Helper()
+	offset := strings.Index(text, "|")
+	require.GreaterOrEqual(t, offset, 0)
+	return newDocument("file:///bundles/"+name, text[:offset]+text[offset+1:]), offset
+}
+
+func labels(items []CompletionItem) []string {
+	var result []string
+	for _, item := range items {
+		result = append(result, item.Label)
+	}
+	return result
+}
+
+func TestComplete(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		text string
+		want []string
+	}{
+		{
+			name: "top level",
+			file: "bundle.uds.hcl",
+			text: "|",
+			want: []string{"uds", "metadata", "locals", "package", "bundle"},
+		},
+		{
+			name: "package attributes not yet set",
+			file: "bundle.uds.hcl",
+			text: "package \"a\" {\n  source = \"./a\"\n  na|\n}\n",
+			want: []string{"namespace", "depends_on", "values_files", "optional_components", "for_each", "count", "signature_verification"},
+		},
+		{
+			name: "nested block",
+			file: "bundle.uds.hcl",
+			text: "package \"a\" {\n  signature_verification {\n    keyless {\n      use_signed_timestamps = true\n      |\n    }\n  }\n}\n",
+			want: []string{"certificate_identity", "certificate_identity_regexp", "certificate_oidc_issuer", "certificate_oidc_issuer_regexp", "trusted_root", "insecure_ignore_tlog", "insecure_ignore_sct"},
+		},
+		{
+			name: "package references",
+			file: "bundle.uds.hcl",
+			text: "package \"core-base\" {}\npackage \"x\" {\n  for_each = {}\n}\npackage \"app\" {\n  depends_on = [package.|]\n}\n",
+			want: []string{"core-base", "x"},
+		},
+		{
+			name: "depends_on without a prefix",
+			file: "bundle.uds.hcl",
+			text: "bundle \"core\" {}\npackage \"base\" {}\npackage \"app\" {\n  depends_on = [\n    |\n  ]\n}\n",
+			want: []string{"package.base", "bundle.core"},
+		},
+		{
+			name: "locals",
+			file: "bundle.uds.hcl",
+			text: "locals {\n  tag = \"1.0\"\n  registry = \"ghcr.io\"\n}\npackage \"a\" {\n  source = \"${local.|}\"\n}\n",
+			want: []string{"tag", "registry"},
+		},
+		{
+			name: "inside locals",
+			file: "bundle.uds.hcl",
+			text: "locals {\n  |\n}\n",
+		},
+		{
+			name: "attribute value",
+			file: "bundle.uds.hcl",
+			text: "package \"a\" {\n  source = |\n}\n",
+		},
+		{
+			name: "config",
+			file: "config.uds.hcl",
+			text: "signature_verification {\n  signer \"ci\" {\n    |\n  }\n}\n",
+			want: []string{"public_key", "keyless"},
+		},
+		{
+			name: "defaults",
+			file: "defaults.uds.hcl",
+			text: "variables = {}\n|",
+			want: []string{"variable"},
+		},
+		{
+			name: "json syntax",
+			file: "bundle.uds.json",
+			text: "{|}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, offset := at(t, tt.file, tt.text)
+			assert.Equal(t, tt.want, labels(d.complete(offset)))
+		})
+	}
+}
+
+func TestDefinition(t *testing.T) {
+	d, offset := at(t, "bundle.uds.hcl", "locals {\n  tag = \"1.0\"\n}\npackage \"base\" {\n  source = \"./base-${local.t|ag}.tar.zst\"\n}\n")
+	assert.Equal(t, []Location{{URI: "file:///bundles/bundle.uds.hcl", Range: Range{Start: Position{Line: 1, Character: 2}, End: Position{Line: 1, Character: 5}}}}, d.definition(offset))
+
+	d, offset = at(t, "bundle.uds.hcl", "package \"x\" {\n  count = 2\n}\npackage \"app\" {\n  depends_on = [package.x[|1]]\n}\n")
+	assert.Equal(t, []Location{{URI: "file:///bundles/bundle.uds.hcl", Range: Range{End: Position{Character: 11}}}}, d.definition(offset))
+
+	d, offset = at(t, "bundle.uds.hcl", "package \"app\" {\n  source = \"./a|pp\"\n}\n")
+	assert.Empty(t, d.definition(offset))
+}
+
+func TestHover(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "attribute", text: "package \"a\" {\n  optional_co|mponents = []\n}\n", want: "`optional_components` attribute\n\nOptional components of the package to deploy."},
+		{name: "block", text: "pack|age \"a\" {\n}\n", want: "`package \"<name>\"` block"},
+		{name: "nested block", text: "package \"a\" {\n  signature_verification {\n    key|less {}\n  }\n}\n", want: "`keyless` block"},
+		{name: "package reference", text: "package \"base\" {\n  source = \"oci://ghcr.io/uds/base:1.0.0\"\n}\npackage \"app\" {\n  depends_on = [package.ba|se]\n}\n", want: "`package \"base\"`\n\nsource: `oci://ghcr.io/uds/base:1.0.0`"},
+		{name: "unknown package", text: "package \"app\" {\n  depends_on = [package.gh|ost]\n}\n", want: "`package \"ghost\"` is not declared in this file"},
+		{name: "local", text: "locals {\n  tag = \"1.0\"\n}\npackage \"a\" {\n  source = local.t|ag\n}\n", want: "```hcl\ntag = \"1.0\"\n```"},
+		{name: "unknown attribute", text: "package \"a\" {\n  col|or = 1\n}\n"},
+		{name: "inside locals", text: "locals {\n  t|ag = 1\n}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, offset := at(t, "bundle.uds.hcl", tt.text)
+			hover := d.hover(offset)
+			if tt.want == "" {
+				assert.Nil(t, hover)
+				return
+			}
+			require.NotNil(t, hover)
+			assert.True(t, strings.HasPrefix(hover.Contents.Value, tt.want), hover.Contents.Value)
+		})
+	}
+}
+
+func TestPositions(t *testing.T) {
+	d := newDocument("file:///bundle.uds.hcl", "a = \"é😀\"\nb = 1\n")
+	assert.Equal(t, Position{Line: 0, Character: 8}, d.position(strings.Index(string(d.text), "\"\n")))
+	assert.Equal(t, strings.Index(string(d.text), "\"\n"), d.offset(Position{Line: 0, Character: 8}))
+	assert.Equal(t, Position{Line: 1, Character: 2}, d.position(d.offset(Position{Line: 1, Character: 2})))
+	assert.Equal(t, len(d.text[:strings.Index(string(d.text), "\n")]), d.offset(Position{Line: 0, Character: 99}))
+	assert.Equal(t, len(d.text), d.offset(Position{Line: 9}))
+	assert.Equal(t, "/bundles/core/bundle.uds.hcl", uriPath("file:///bundles/core/bundle.uds.hcl"))
+	assert.Empty(t, uriPath("untitled:Untitled-1"))
+}
@@ -0,0 +1,199 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+// blockSchema describes the attributes and nested blocks a block, or the
+// top level of a file, may contain. It drives completion and hover; the
+// parser remains the authority on what is valid.
+type blockSchema struct {
+	doc string
+	// labels names the labels the block takes, such as name for package.
+	labels []string
+	// freeform is set for blocks whose attribute names are chosen by the
+	// author, such as locals.
+	freeform   bool
+	attributes []attributeSchema
+	blocks     []nestedBlock
+}
+
+type attributeSchema struct {
+	name string
+	doc  string
+}
+
+type nestedBlock struct {
+	name   string
+	schema *blockSchema
+}
+
+func (s *blockSchema) attribute(name string) *attributeSchema {
+	for i := range s.attributes {
+		if s.attributes[i].name == name {
+			return &s.attributes[i]
+		}
+	}
+	return nil
+}
+
+func (s *blockSchema) block(name string) *blockSchema {
+	for _, b := range s.blocks {
+		if b.name == name {
+			return b.schema
+		}
+	}
+	return nil
+}
+
+var keylessAttributes = []attributeSchema{
+	{"certificate_identity", "Exact certificate identity, such as a workflow URL, the signature must be issued to. Set this or `certificate_identity_regexp`."},
+	{"certificate_identity_regexp", "Regular expression the certificate identity must match. Set this or `certificate_identity`."},
+	{"certificate_oidc_issuer", "Exact OIDC issuer of the signing certificate. Set this or `certificate_oidc_issuer_regexp`."},
+	{"certificate_oidc_issuer_regexp", "Regular expression the OIDC issuer of the signing certificate must match. Set this or `certificate_oidc_issuer`."},
+	{"trusted_root", "Sigstore trusted root JSON, usually read with `file()`. Defaults to the public Sigstore instance."},
+}
+
+var packageKeylessSchema = &blockSchema{
+	doc: "Trusts a keyless Sigstore signature whose certificate matches the identity and issuer constraints.",
+	attributes: append(append([]attributeSchema(nil), keylessAttributes...),
+		attributeSchema{"insecure_ignore_tlog", "Skips the transparency log check. Only use this with private Sigstore instances without a log."},
+		attributeSchema{"insecure_ignore_sct", "Skips the signed certificate timestamp check."},
+		attributeSchema{"use_signed_timestamps", "Verifies the signature against a signed timestamp instead of the transparency log."},
+	),
+}
+
+var bundleSchema = &blockSchema{
+	blocks: []nestedBlock{
+		{"uds", &blockSchema{
+			doc: "Declares the bundle API version the file is written for.",
+			attributes: []attributeSchema{
+				{"bundle_api_version", "Bundle API version. The only supported version is `\"uds.dev/v1alpha1\"`."},
+			},
+		}},
+		{"metadata", &blockSchema{
+			doc: "Identifies the bundle.",
+			attributes: []attributeSchema{
+				{"name", "Bundle name. Required."},
+				{"description", "Short description of the bundle."},
+				{"version", "Bundle version, used as the tag when the bundle is published."},
+			},
+		}},
+		{"locals", &blockSchema{
+			doc:      "Declares values other expressions read as `local.<name>`. Locals may read each other, `sys.arch` and `file()`.",
+			freeform: true,
+		}},
+		{"package", &blockSchema{
+			doc:    "Declares a Zarf package the bundle deploys. Packages without `depends_on` between them deploy in parallel.",
+			labels: []string{"name"},
+			attributes: []attributeSchema{
+				{"source", "Where the package is read from: an `oci://` reference or a path to a package archive relative to the bundle."},
+				{"namespace", "Namespace override passed to Zarf when the package is deployed."},
+				{"depends_on", "Packages that must deploy before this one, as `package.<name>` or `bundle.<name>` references."},
+				{"values_files", "Templated Helm values files, relative to the bundle, applied to the package's charts. Templates read config variables as `.vars.<name>`."},
+				{"optional_components", "Optional components of the package to deploy."},
+				{"for_each", "Map, object or set of strings to declare one package per element, named `<label>[\"key\"]` and reading `each.key` and `each.value`."},
+				{"count", "Number of packages to declare, named `<label>[index]` and reading `count.index`."},
+			},
+			blocks: []nestedBlock{
+				{"signature_verification", &blockSchema{
+					doc: "How the package signature is verified when the bundle is created. Set exactly one of `public_key` or `keyless`, or `verify = false`.",
+					attributes: []attributeSchema{
+						{"verify", "Set to false to skip signature verification for this package. Defaults to true."},
+						{"public_key", "PEM public key the package must be signed with, usually read with `file()`."},
+					},
+					blocks: []nestedBlock{{"keyless", packageKeylessSchema}},
+				}},
+			},
+		}},
+		{"bundle", &blockSchema{
+			doc:    "Includes the packages of another bundle, named `<bundle>.<package>`. Depend on all of them with `bundle.<name>`.",
+			labels: []string{"name"},
+			attributes: []attributeSchema{
+				{"source", "The included bundle: an `oci://` reference or a path to a bundle archive relative to the bundle."},
+			},
+		}},
+	},
+}
+
+var defaultsSchema = &blockSchema{
+	attributes: []attributeSchema{
+		{"variables", "Default values of the variables values files read as `.vars.<name>`. config.uds.hcl overrides them."},
+	},
+	blocks: []nestedBlock{
+		{"variable", &blockSchema{
+			doc:    "Declares a variable, named by a dotted path below `vars`, that deployers provide through config.uds.hcl or `--prompt`.",
+			labels: []string{"name"},
+			attributes: []attributeSchema{
+				{"description", "Describes the variable when deployers are prompted for it."},
+				{"type", "Type answers are converted to, such as `number` or `list(string)`. Defaults to `string`."},
+				{"sensitive", "Hides the answer while prompting and in output."},
+			},
+		}},
+	},
+}
+
+var configKeylessSchema = &blockSchema{
+	doc:        "Trusts a keyless Sigstore signature whose certificate matches the identity and issuer constraints.",
+	attributes: keylessAttributes,
+}
+
+var configSchema = &blockSchema{
+	attributes: []attributeSchema{
+		{"variables", "Variables for values files, read as `.vars.<name>`. They override the bundle's defaults.uds.hcl."},
+	},
+	blocks: []nestedBlock{
+		{"options", &blockSchema{
+			doc: "Options for bundle commands. Command line flags override them.",
+			attributes: []attributeSchema{
+				{"log_level", "Log level: `debug`, `info`, `warn` or `error`."},
+				{"architecture", "Target architecture, exposed to bundles as `sys.arch`."},
+				{"plain_http", "Allows plain HTTP when a registry does not support HTTPS."},
+				{"skip_tls_verify", "Skips TLS certificate verification."},
+				{"tmp_dir", "Existing directory for temporary files."},
+				{"concurrency", "Degree of parallelism for concurrent operations, from 1 to 25."},
+				{"chunk_concurrency", "Number of parallel ranged requests used to download each large blob. Zero or one downloads each blob in one stream."},
+			},
+		}},
+		{"signature_verification", &blockSchema{
+			doc: "Signers trusted for bundle signatures. Set `public_key` or `keyless` for a single signer, or `signer` blocks combined by `rule`.",
+			attributes: []attributeSchema{
+				{"public_key", "PEM public key bundles must be signed with, usually read with `file()`."},
+				{"rule", "How many signers must match: `any`, `all` or `threshold`."},
+				{"threshold", "Number of distinct signers required when `rule` is `threshold`."},
+			},
+			blocks: []nestedBlock{
+				{"keyless", configKeylessSchema},
+				{"signer", &blockSchema{
+					doc:    "A named trusted signer.",
+					labels: []string{"name"},
+					attributes: []attributeSchema{
+						{"public_key", "PEM public key of the signer, usually read with `file()`."},
+					},
+					blocks: []nestedBlock{{"keyless", configKeylessSchema}},
+				}},
+				{"scope", &blockSchema{
+					doc:    "Adds a signer requirement for bundles whose name matches the label, a pattern such as `\"core-*\"`.",
+					labels: []string{"bundle_name"},
+					attributes: []attributeSchema{
+						{"signers", "Names of the signer blocks that count toward the scope. Defaults to all of them."},
+						{"rule", "How many signers must match: `any`, `all` or `threshold`."},
+						{"threshold", "Number of distinct signers required when `rule` is `threshold`."},
+					},
+				}},
+			},
+		}},
+	},
+}
+
+// schemaFor returns the top-level schema of a file kind.
+func schemaFor(kind fileKind) *blockSchema {
+	switch kind {
+	case kindBundle:
+		return bundleSchema
+	case kindDefaults:
+		return defaultsSchema
+	case kindConfig:
+		return configSchema
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package lsp implements a Language Server Protocol server for
+// bundle.uds.hcl, defaults.uds.hcl and config.uds.hcl. Diagnostics come from
+// the same parser and validation the CLI uses; completion, go-to-definition
+// and hover read the recovered syntax of the open document.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// Options configures a Server.
+type Options struct {
+	// Architecture is exposed to bundles as sys.arch. Empty uses the runtime
+	// architecture.
+	Architecture string
+	// Version is reported to the client in the initialize response.
+	Version string
+	Streams iostreams.IOStreams
+}
+
+// Server is a language server for bundle files. It handles one client and
+// processes messages in the order they arrive.
+type Server struct {
+	parser      *bundleinternal.HCLParser
+	version     string
+	streams     iostreams.IOStreams
+	conn        *conn
+	documents   map[string]*document
+	initialized bool
+	shutdown    bool
+}
+
+// NewServer creates a Server.
+func NewServer(opts Options) *Server {
+	return &Server{
+		parser:    bundleinternal.NewHCLParser(opts.Architecture, opts.Streams).WithBundleResolver(offlineResolver{}),
+		version:   opts.Version,
+		streams:   opts.Streams,
+		documents: map[string]*document{},
+	}
+}
+
+// Serve reads messages from in and writes responses and diagnostics to out
+// until the client sends exit or closes in. It fails when the client exits
+// without shutting the server down first.
+func (s *Server) Serve(ctx context.Context, in io.Reader, out io.Writer) error {
+	s.conn = newConn(in, out)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		msg, err := s.conn.read()
+		var rpcErr *rpcError
+		switch {
+		case errors.Is(err, io.EOF):
+			s.streams.Debug("language server client closed the connection")
+			return nil
+		case errors.As(err, &rpcErr):
+			if err := s.conn.respond(nil, nil, err); err != nil {
+				return err
+			}
+			continue
+		case err != nil:
+			return err
+		}
+
+		if msg.Method == "exit" {
+			if !s.shutdown {
+				return ErrExitWithoutShutdown
+			}
+			return nil
+		}
+		if msg.ID == nil {
+			if err := s.notification(ctx, msg); err != nil {
+				s.streams.Warn("language server notification failed", "method", msg.Method, "error", err)
+			}
+			continue
+		}
+		result, err := s.request(msg)
+		if err != nil {
+			s.streams.Debug("language server request failed", "method", msg.Method, "error", err)
+		}
+		if err := s.conn.respond(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// request handles a message that expects a response.
+func (s *Server) request(msg *message) (any, error) {
+	if !s.initialized && msg.Method != "initialize" {
+		return nil, &rpcError{code: codeServerNotInitialized, err: ErrNotInitialized}
+	}
+	switch msg.Method {
+	case "initialize":
+		s.initialized = true
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   textDocumentSyncOptions{OpenClose: true, Change: textDocumentSyncFull, Save: true},
+				CompletionProvider: completionOptions{TriggerCharacters: []string{"."}},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "uds", Version: s.version},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/completion", "textDocument/definition", "textDocument/hover":
+		var params positionParams
+		if err := decodeParams(msg, &params); err != nil {
+			return nil, err
+		}
+		d, ok := s.documents[params.TextDocument.URI]
+		if !ok {
+			return nil, nil
+		}
+		offset := d.offset(params.Position)
+		switch msg.Method {
+		case "textDocument/completion":
+			return d.complete(offset), nil
+		case "textDocument/definition":
+			return d.definition(offset), nil
+		}
+		if hover := d.hover(offset); hover != nil {
+			return hover, nil
+		}
+		return nil, nil
+	}
+	return nil, &rpcError{code: codeMethodNotFound, err: fmt.Errorf("%w %q", ErrUnknownMethod, msg.Method)}
+}
+
+// notification handles a message that expects no response. Unknown
+// notifications are ignored, as the protocol requires.
+func (s *Server) notification(ctx context.Context, msg *message) error {
+	if !s.initialized {
+		return nil
+	}
+	switch msg.Method {
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := decodeParams(msg, &params); err != nil {
+			return err
+		}
+		return s.update(ctx, newDocument(params.TextDocument.URI, params.TextDocument.Text))
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := decodeParams(msg, &params); err != nil {
+			return err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil
+		}
+		// Full sync sends the whole document as the last change.
+		return s.update(ctx, newDocument(params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text))
+	case "textDocument/didSave":
+		var params didSaveParams
+		if err := decodeParams(msg, &params); err != nil {
+			return err
+		}
+		// Files read with file() may have changed, so diagnose again.
+		if d, ok := s.documents[params.TextDocument.URI]; ok {
+			return s.update(ctx, d)
+		}
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := decodeParams(msg, &params); err != nil {
+			return err
+		}
+		delete(s.documents, params.TextDocument.URI)
+		return s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+	}
+	return nil
+}
+
+// update stores d and publishes its diagnostics. Files the server does not
+// recognize are stored without diagnostics.
+func (s *Server) update(ctx context.Context, d *document) error {
+	s.documents[d.uri] = d
+	if d.kind == "" {
+		return nil
+	}
+	diagnostics := s.diagnose(ctx, d)
+	s.streams.Debug("publishing diagnostics", "uri", d.uri, "count", len(diagnostics))
+	return s.conn.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{URI: d.uri, Diagnostics: diagnostics})
+}
+
+func decodeParams(msg *message, params any) error {
+	if err := json.Unmarshal(msg.Params, params); err != nil {
+		return &rpcError{code: codeInvalidParams, err: fmt.Errorf("%w for %s: %w", ErrInvalidParams, msg.Method, err)}
+	}
+	return nil
+}
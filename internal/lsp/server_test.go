@@ -0,0 +1,160 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "core"
+}
+locals {
+  registry = "oci://ghcr.io/uds"
+}
+package "base" {
+  source = "${local.registry}/base:1.0.0"
+}
+package "app" {
+  source     = "./app.tar.zst"
+  depends_on = [package.base, package.ghost]
+}
+`
+
+// session frames requests and notifications for Serve to read in order.
+type session struct {
+	in bytes.Buffer
+	id int
+}
+
+func (s *session) send(method string, params any, request bool) {
+	msg := map[string]any{"jsonrpc": "2.0", "method": method}
+	if params != nil {
+		msg["params"] = params
+	}
+	if request {
+		s.id++
+		msg["id"] = s.id
+	}
+	body, _ := json.Marshal(msg)
+	fmt.Fprintf(&s.in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func (s *session) request(method string, params any) { s.send(method, params, true) }
+
+func (s *session) notify(method string, params any) { s.send(method, params, false) }
+
+// readMessages splits the framed output of Serve into messages.
+func readMessages(t *testing.T, out *bytes.Buffer) []map[string]json.RawMessage {
+	t.Helper()
+	c := newConn(out, nil)
+	var msgs []map[string]json.RawMessage
+	for {
+		header, err := c.r.ReadMIMEHeader()
+		if len(header) == 0 {
+			return msgs
+		}
+		require.NoError(t, err)
+		var length int
+		_, err = fmt.Sscan(header.Get("Content-Length"), &length)
+		require.NoError(t, err)
+		body := make([]byte, length)
+		_, err = io.ReadFull(c.r.R, body)
+		require.NoError(t, err)
+		var msg map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(body, &msg))
+		msgs = append(msgs, msg)
+	}
+}
+
+func position(uri string, line, character int) map[string]any {
+	return map[string]any{"textDocument": map[string]any{"uri": uri}, "position": map[string]any{"line": line, "character": character}}
+}
+
+func TestServe(t *testing.T) {
+	uri := "file://" + filepath.ToSlash(filepath.Join(t.TempDir(), "bundle.uds.hcl"))
+	s := &session{}
+	s.request("initialize", map[string]any{"capabilities": map[string]any{}})
+	s.notify("initialized", map[string]any{})
+	s.notify("textDocument/didOpen", map[string]any{"textDocument": map[string]any{"uri": uri, "languageId": "hcl", "version": 1, "text": testBundle}})
+	s.request("textDocument/completion", position(uri, 14, 31))
+	s.request("textDocument/definition", position(uri, 14, 20))
+	s.request("textDocument/hover", position(uri, 13, 4))
+	s.request("uds/unknown", map[string]any{})
+	s.notify("textDocument/didClose", map[string]any{"textDocument": map[string]any{"uri": uri}})
+	s.request("shutdown", nil)
+	s.notify("exit", nil)
+
+	var out bytes.Buffer
+	server := NewServer(Options{Version: "test", Streams: iostreams.New(nil, nil, nil)})
+	require.NoError(t, server.Serve(t.Context(), &s.in, &out))
+	msgs := readMessages(t, &out)
+	require.Len(t, msgs, 8)
+
+	var initialized initializeResult
+	require.NoError(t, json.Unmarshal(msgs[0]["result"], &initialized))
+	assert.Equal(t, "test", initialized.ServerInfo.Version)
+	assert.True(t, initialized.Capabilities.DefinitionProvider)
+
+	var published publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(msgs[1]["params"], &published))
+	require.Len(t, published.Diagnostics, 1)
+	assert.Equal(t, `package "app": depends_on references unknown package "ghost"`, published.Diagnostics[0].Message)
+	assert.Equal(t, Range{Start: Position{Line: 14, Character: 30}, End: Position{Line: 14, Character: 43}}, published.Diagnostics[0].Range)
+
+	var completions []CompletionItem
+	require.NoError(t, json.Unmarshal(msgs[2]["result"], &completions))
+	require.Len(t, completions, 1)
+	assert.Equal(t, "package.base", completions[0].Label)
+
+	var locations []Location
+	require.NoError(t, json.Unmarshal(msgs[3]["result"], &locations))
+	require.Len(t, locations, 1)
+	assert.Equal(t, Location{URI: uri, Range: Range{Start: Position{Line: 9}, End: Position{Line: 9, Character: 14}}}, locations[0])
+
+	var hover Hover
+	require.NoError(t, json.Unmarshal(msgs[4]["result"], &hover))
+	assert.True(t, strings.HasPrefix(hover.Contents.Value, "`source` attribute"))
+
+	var unknown responseError
+	require.NoError(t, json.Unmarshal(msgs[5]["error"], &unknown))
+	assert.Equal(t, codeMethodNotFound, unknown.Code)
+
+	require.NoError(t, json.Unmarshal(msgs[6]["params"], &published))
+	assert.Empty(t, published.Diagnostics)
+	assert.Equal(t, "null", string(msgs[7]["result"]))
+}
+
+func TestServe_Lifecycle(t *testing.T) {
+	s := &session{}
+	s.request("textDocument/hover", position("file:///bundle.uds.hcl", 0, 0))
+	s.notify("exit", nil)
+
+	var out bytes.Buffer
+	err := NewServer(Options{}).Serve(t.Context(), &s.in, &out)
+	require.ErrorIs(t, err, ErrExitWithoutShutdown)
+	msgs := readMessages(t, &out)
+	require.Len(t, msgs, 1)
+	var notInitialized responseError
+	require.NoError(t, json.Unmarshal(msgs[0]["error"], &notInitialized))
+	assert.Equal(t, codeServerNotInitialized, notInitialized.Code)
+
+	err = NewServer(Options{}).Serve(t.Context(), strings.NewReader("Content-Length: x\r\n\r\n{}"), &out)
+	require.ErrorIs(t, err, ErrReadMessage)
+
+	require.NoError(t, NewServer(Options{}).Serve(t.Context(), strings.NewReader(""), &out))
+}
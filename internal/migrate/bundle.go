@@ -0,0 +1,272 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package migrate
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/pkg/legacy/types"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// packageBlock is a converted legacy package, ready to be written.
+type packageBlock struct {
+	name         string
+	comments     []string
+	source       hclwrite.Tokens
+	namespace    string
+	optional     []string
+	verification func(*hclwrite.Body)
+	valuesFile   string
+}
+
+func (c *converter) bundleFile() ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	body.AppendUnstructuredTokens(comment(fmt.Sprintf("Migrated from %s by uds bundle migrate.", filepath.Base(c.opts.BundleFile))))
+
+	body.AppendNewBlock("uds", nil).Body().SetAttributeValue("bundle_api_version", cty.StringVal(bundleAPIVersion))
+	body.AppendNewline()
+	metadata := body.AppendNewBlock("metadata", nil).Body()
+	metadata.SetAttributeValue("name", cty.StringVal(c.bundle.Metadata.Name))
+	if c.bundle.Metadata.Description != "" {
+		metadata.SetAttributeValue("description", cty.StringVal(c.bundle.Metadata.Description))
+	}
+	if c.bundle.Metadata.Version != "" {
+		metadata.SetAttributeValue("version", cty.StringVal(c.bundle.Metadata.Version))
+	}
+	c.checkMetadata()
+
+	names := map[string]string{}
+	previous := ""
+	for _, pkg := range c.bundle.Packages {
+		block, err := c.convertPackage(pkg)
+		if err != nil {
+			return nil, err
+		}
+		if legacy, ok := names[block.name]; ok {
+			return nil, fmt.Errorf("%w: packages %q and %q both become package %q", ErrInvalidLegacyInput, legacy, pkg.Name, block.name)
+		}
+		names[block.name] = pkg.Name
+		body.AppendNewline()
+		writePackage(body, block, previous)
+		previous = block.name
+	}
+	if len(c.bundle.Packages) > 1 {
+		c.note("", "each package depends on the one before it so packages deploy in the legacy order; remove depends_on entries that are not real dependencies to deploy packages in parallel")
+	}
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// checkMetadata reports legacy metadata that bundle.uds.hcl cannot hold.
+func (c *converter) checkMetadata() {
+	m := c.bundle.Metadata
+	dropped := []struct {
+		field string
+		set   bool
+	}{
+		{"url", m.URL != ""},
+		{"authors", m.Authors != ""},
+		{"documentation", m.Documentation != ""},
+		{"source", m.Source != ""},
+		{"vendor", m.Vendor != ""},
+		{"uncompressed", m.Uncompressed},
+	}
+	for _, d := range dropped {
+		if d.set {
+			c.note("", fmt.Sprintf("metadata.%s has no equivalent and was not migrated", d.field))
+		}
+	}
+	if m.Architecture != "" {
+		c.note("", fmt.Sprintf("metadata.architecture %q was not migrated; pass --architecture when creating the bundle", m.Architecture))
+	}
+}
+
+func (c *converter) convertPackage(pkg types.Package) (*packageBlock, error) {
+	block := &packageBlock{
+		name:      identifier(pkg.Name),
+		namespace: pkg.Namespace,
+		optional:  pkg.OptionalComponents,
+	}
+	if block.name != pkg.Name {
+		c.note(pkg.Name, fmt.Sprintf("package %q is renamed to %q, a valid HCL identifier", pkg.Name, block.name))
+	}
+	if pkg.Description != "" {
+		block.comments = append(block.comments, pkg.Description)
+	}
+
+	source, err := c.source(pkg)
+	if err != nil {
+		return nil, err
+	}
+	block.source = source
+	block.verification = c.verification(pkg, block)
+
+	if pkg.Timeout != "" {
+		c.note(pkg.Name, fmt.Sprintf("timeout %s has no equivalent and was not migrated", pkg.Timeout))
+	}
+	for _, imp := range pkg.Imports {
+		block.todo(c, pkg.Name, fmt.Sprintf("imports %s from package %q, but packages cannot pass variables to each other; set %s in config.uds.hcl", imp.Name, imp.Package, variableName(imp.Name)))
+	}
+	for _, exp := range pkg.Exports {
+		block.todo(c, pkg.Name, fmt.Sprintf("exports %s, but packages cannot pass variables to each other; set %s in config.uds.hcl for the packages that imported it", exp.Name, variableName(exp.Name)))
+	}
+
+	values, err := c.valuesFile(pkg, block)
+	if err != nil {
+		return nil, err
+	}
+	if values != nil {
+		block.valuesFile = values.Path
+		c.extra = append(c.extra, *values)
+	}
+	return block, nil
+}
+
+// todo records a TODO diagnostic and repeats it as a comment in the block.
+func (b *packageBlock) todo(c *converter, pkg, message string) {
+	c.todo(pkg, message)
+	b.comments = append(b.comments, "TODO: "+message)
+}
+
+// source returns the source expression for a legacy repository and ref, or
+// for a local path resolved the way legacy create did.
+func (c *converter) source(pkg types.Package) (hclwrite.Tokens, error) {
+	switch {
+	case pkg.Repository != "":
+		if pkg.Ref == "" {
+			return nil, fmt.Errorf("%w: package %q: ref is required with repository", ErrInvalidLegacyInput, pkg.Name)
+		}
+		if pkg.Flavor != "" {
+			c.note(pkg.Name, fmt.Sprintf("flavor %s only applied to local packages and was not migrated", pkg.Flavor))
+		}
+		return hclwrite.TokensForValue(cty.StringVal("oci://" + strings.TrimPrefix(pkg.Repository, "oci://") + ":" + pkg.Ref)), nil
+	case pkg.Path != "":
+		if strings.HasSuffix(pkg.Path, ".tar.zst") {
+			return hclwrite.TokensForValue(cty.StringVal(c.localSource(pkg.Path))), nil
+		}
+		if pkg.Ref == "" {
+			return nil, fmt.Errorf("%w: package %q: ref is required with a path that is not a .tar.zst file", ErrInvalidLegacyInput, pkg.Name)
+		}
+		prefix := "zarf-package-" + pkg.Name + "-"
+		if pkg.Name == "init" {
+			prefix = "zarf-init-"
+		}
+		suffix := "-" + pkg.Ref
+		if pkg.Flavor != "" {
+			suffix += "-" + pkg.Flavor
+		}
+		// The architecture legacy create filled in is left to sys.arch.
+		dir := strings.TrimSuffix(c.localSource(pkg.Path), "/")
+		return templateTokens(escapeTemplate(dir+"/"+prefix) + "${sys.arch}" + escapeTemplate(suffix+".tar.zst")), nil
+	default:
+		return nil, fmt.Errorf("%w: package %q: repository or path is required", ErrInvalidLegacyInput, pkg.Name)
+	}
+}
+
+// verification returns a writer for the package's signature_verification
+// block. Public keys and trusted roots move to files read with file().
+func (c *converter) verification(pkg types.Package, block *packageBlock) func(*hclwrite.Body) {
+	switch {
+	case pkg.HasPublicKey():
+		keyFile := "keys/" + block.name + ".pub"
+		c.extra = append(c.extra, File{Path: keyFile, Data: []byte(pkg.PublicKey)})
+		return func(body *hclwrite.Body) {
+			body.SetAttributeRaw("public_key", fileCall(keyFile))
+		}
+	case pkg.HasKeylessConfig():
+		k := pkg.KeylessVerification
+		rootFile := ""
+		if k.TrustedRoot != "" {
+			rootFile = "keys/" + block.name + ".trusted-root.json"
+			c.extra = append(c.extra, File{Path: rootFile, Data: []byte(k.TrustedRoot)})
+		}
+		return func(body *hclwrite.Body) {
+			keyless := body.AppendNewBlock("keyless", nil).Body()
+			for _, attr := range []struct{ name, value string }{
+				{"certificate_identity", k.CertificateIdentity},
+				{"certificate_identity_regexp", k.CertificateIdentityRegexp},
+				{"certificate_oidc_issuer", k.CertificateOIDCIssuer},
+				{"certificate_oidc_issuer_regexp", k.CertificateOIDCIssuerRegexp},
+			} {
+				if attr.value != "" {
+					keyless.SetAttributeValue(attr.name, cty.StringVal(attr.value))
+				}
+			}
+			if rootFile != "" {
+				keyless.SetAttributeRaw("trusted_root", fileCall(rootFile))
+			}
+			if k.InsecureIgnoreTlog {
+				keyless.SetAttributeValue("insecure_ignore_tlog", cty.True)
+			}
+			if k.UseSignedTimestamps {
+				keyless.SetAttributeValue("use_signed_timestamps", cty.True)
+			}
+		}
+	default:
+		block.todo(c, pkg.Name, "the legacy bundle did not verify this package's signature, so verify is false; set public_key or keyless to verify it")
+		return func(body *hclwrite.Body) {
+			body.SetAttributeValue("verify", cty.False)
+		}
+	}
+}
+
+func writePackage(body *hclwrite.Body, block *packageBlock, previous string) {
+	pkg := body.AppendNewBlock("package", []string{block.name}).Body()
+	for _, text := range block.comments {
+		pkg.AppendUnstructuredTokens(comment(text))
+	}
+	pkg.SetAttributeRaw("source", block.source)
+	if block.namespace != "" {
+		pkg.SetAttributeValue("namespace", cty.StringVal(block.namespace))
+	}
+	if previous != "" {
+		pkg.SetAttributeRaw("depends_on", hclwrite.TokensForTuple([]hclwrite.Tokens{
+			hclwrite.TokensForTraversal(hcl.Traversal{hcl.TraverseRoot{Name: "package"}, hcl.TraverseAttr{Name: previous}}),
+		}))
+	}
+	if len(block.optional) > 0 {
+		components := make([]cty.Value, len(block.optional))
+		for i, name := range block.optional {
+			components[i] = cty.StringVal(name)
+		}
+		pkg.SetAttributeValue("optional_components", cty.ListVal(components))
+	}
+	if block.valuesFile != "" {
+		pkg.SetAttributeValue("values_files", cty.ListVal([]cty.Value{cty.StringVal(block.valuesFile)}))
+	}
+	block.verification(pkg.AppendNewBlock("signature_verification", nil).Body())
+}
+
+func comment(text string) hclwrite.Tokens {
+	var tokens hclwrite.Tokens
+	for _, line := range strings.Split(text, "\n") {
+		tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenComment, Bytes: []byte(strings.TrimRight("# "+line, " ") + "\n")})
+	}
+	return tokens
+}
+
+func fileCall(path string) hclwrite.Tokens {
+	return hclwrite.TokensForFunctionCall("file", hclwrite.TokensForValue(cty.StringVal(path)))
+}
+
+// templateTokens returns a quoted template whose content is already escaped.
+func templateTokens(template string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOQuote, Bytes: []byte(`"`)},
+		{Type: hclsyntax.TokenQuotedLit, Bytes: []byte(template)},
+		{Type: hclsyntax.TokenCQuote, Bytes: []byte(`"`)},
+	}
+}
+
+// escapeTemplate escapes s for use as literal text in a quoted template.
+func escapeTemplate(s string) string {
+	quoted := string(hclwrite.TokensForValue(cty.StringVal(s)).Bytes())
+	return quoted[1 : len(quoted)-1]
+}
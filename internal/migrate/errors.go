@@ -0,0 +1,15 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package migrate
+
+import "errors"
+
+var (
+	ErrReadLegacyBundle   = errors.New("reading legacy bundle")
+	ErrParseLegacyBundle  = errors.New("parsing legacy bundle")
+	ErrReadLegacyConfig   = errors.New("reading legacy config")
+	ErrParseLegacyConfig  = errors.New("parsing legacy config")
+	ErrInvalidLegacyInput = errors.New("invalid legacy bundle")
+	ErrConvertValue       = errors.New("converting legacy value")
+)
@@ -0,0 +1,593 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+// Package migrate converts a legacy uds-bundle.yaml, and optionally its
+// uds-config.yaml, into bundle.uds.hcl, defaults.uds.hcl, config.uds.hcl and
+// templated values files. Settings without an equivalent are reported as
+// diagnostics and, when they belong to a package, as TODO comments in its
+// package block.
+package migrate
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/legacy/types"
+	"github.com/defenseunicorns/uds-cli/pkg/legacy/types/chartvariable"
+	goyaml "github.com/goccy/go-yaml"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// LegacyConfigFileName is the conventional name of a legacy deploy config.
+	LegacyConfigFileName = "uds-config.yaml"
+
+	// bundleAPIVersion is the bundle_api_version generated bundles declare.
+	bundleAPIVersion = "uds.dev/v1alpha1"
+	legacyKind       = "UDSBundle"
+)
+
+// Severity is how much attention a diagnostic needs.
+type Severity string
+
+const (
+	// SeverityTodo marks settings that need a manual change before the
+	// generated bundle behaves like the legacy one.
+	SeverityTodo Severity = "todo"
+	// SeverityNote marks settings that were converted with a change the
+	// author should know about.
+	SeverityNote Severity = "note"
+)
+
+// Diagnostic is one message about the conversion.
+type Diagnostic struct {
+	Severity Severity
+	// Package is the legacy package name, empty for bundle-wide messages.
+	Package string
+	Message string
+}
+
+// File is a generated file.
+type File struct {
+	// Path is slash-separated and relative to the output directory.
+	Path string
+	Data []byte
+}
+
+// Result is the outcome of a conversion.
+type Result struct {
+	Files       []File
+	Diagnostics []Diagnostic
+}
+
+// Options selects the legacy inputs and where the generated files go.
+type Options struct {
+	BundleFile string
+	// ConfigFile is an optional legacy uds-config.yaml. Its options and
+	// variables become config.uds.hcl.
+	ConfigFile string
+	// OutputDir is the directory the generated files are written to. Paths
+	// inside them, such as local package sources, are relative to it.
+	OutputDir string
+}
+
+// legacyConfig holds the sections of a uds-config.yaml that carry over.
+type legacyConfig struct {
+	Options   map[string]any            `yaml:"options"`
+	Shared    map[string]any            `yaml:"shared"`
+	Variables map[string]map[string]any `yaml:"variables"`
+}
+
+// variable is a values file variable declared by a chart override.
+type variable struct {
+	name        string
+	description string
+	sensitive   bool
+	file        bool
+	def         any
+	// pkg is the legacy package that declared the variable first.
+	pkg string
+}
+
+// template returns the values file pipeline that renders the variable.
+func (v *variable) template() string {
+	if v.file {
+		return "file .vars." + v.name + " | toYaml"
+	}
+	return "toYaml .vars." + v.name
+}
+
+type converter struct {
+	opts      Options
+	bundle    types.UDSBundle
+	config    *legacyConfig
+	bundleDir string
+	configDir string
+	outputDir string
+
+	diagnostics []Diagnostic
+	extra       []File
+	variables   []*variable
+	declared    map[string]*variable
+	configVars  map[string]any
+	configFrom  map[string]string
+	// references lists, per legacy package, the variables its static
+	// override values read through ${NAME}.
+	references map[string][]string
+}
+
+// Convert reads the legacy bundle and config named by opts and returns the
+// files that replace them. Nothing is written to disk.
+func Convert(_ context.Context, opts Options) (*Result, error) {
+	c := &converter{
+		opts:       opts,
+		declared:   map[string]*variable{},
+		configVars: map[string]any{},
+		configFrom: map[string]string{},
+		references: map[string][]string{},
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	c.collectConfigVariables()
+
+	bundleFile, err := c.bundleFile()
+	if err != nil {
+		return nil, err
+	}
+	files := []File{{Path: bundleinternal.BundleFileName, Data: bundleFile}}
+	c.checkVariables()
+	if len(c.variables) > 0 {
+		defaults, err := c.defaultsFile()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Path: bundleinternal.BundleDefaultsFileName, Data: defaults})
+	}
+	if c.config != nil {
+		config, err := c.configFile()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, File{Path: bundleinternal.ConfigFileName, Data: config})
+	}
+	return &Result{Files: append(files, c.extra...), Diagnostics: c.diagnostics}, nil
+}
+
+func (c *converter) load() error {
+	src, err := os.ReadFile(c.opts.BundleFile)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadLegacyBundle, c.opts.BundleFile, err)
+	}
+	if err := goyaml.Unmarshal(src, &c.bundle); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrParseLegacyBundle, c.opts.BundleFile, err)
+	}
+	if c.bundle.Kind != "" && c.bundle.Kind != legacyKind {
+		return fmt.Errorf("%w %q: kind is %q, not %s", ErrInvalidLegacyInput, c.opts.BundleFile, c.bundle.Kind, legacyKind)
+	}
+	if c.bundle.Metadata.Name == "" {
+		return fmt.Errorf("%w %q: metadata.name is required", ErrInvalidLegacyInput, c.opts.BundleFile)
+	}
+	if len(c.bundle.Packages) == 0 {
+		return fmt.Errorf("%w %q: no packages", ErrInvalidLegacyInput, c.opts.BundleFile)
+	}
+	if c.bundleDir, err = filepath.Abs(filepath.Dir(c.opts.BundleFile)); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadLegacyBundle, c.opts.BundleFile, err)
+	}
+	c.outputDir = c.bundleDir
+	if c.opts.OutputDir != "" {
+		if c.outputDir, err = filepath.Abs(c.opts.OutputDir); err != nil {
+			return fmt.Errorf("output directory %q: %w", c.opts.OutputDir, err)
+		}
+	}
+
+	if c.opts.ConfigFile == "" {
+		return nil
+	}
+	src, err = os.ReadFile(c.opts.ConfigFile)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadLegacyConfig, c.opts.ConfigFile, err)
+	}
+	var sections map[string]any
+	if err := goyaml.Unmarshal(src, &sections); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrParseLegacyConfig, c.opts.ConfigFile, err)
+	}
+	c.config = &legacyConfig{}
+	if err := goyaml.Unmarshal(src, c.config); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrParseLegacyConfig, c.opts.ConfigFile, err)
+	}
+	for _, section := range slices.Sorted(maps.Keys(sections)) {
+		switch section {
+		case "options", "shared", "variables":
+		default:
+			c.note("", fmt.Sprintf("config section %s has no config.uds.hcl equivalent and was not migrated", section))
+		}
+	}
+	if c.configDir, err = filepath.Abs(filepath.Dir(c.opts.ConfigFile)); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrReadLegacyConfig, c.opts.ConfigFile, err)
+	}
+	return nil
+}
+
+func (c *converter) todo(pkg, message string) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{Severity: SeverityTodo, Package: pkg, Message: message})
+}
+
+func (c *converter) note(pkg, message string) {
+	c.diagnostics = append(c.diagnostics, Diagnostic{Severity: SeverityNote, Package: pkg, Message: message})
+}
+
+// collectConfigVariables flattens the shared and per-package variables of the
+// legacy config into the single variable namespace config.uds.hcl has.
+func (c *converter) collectConfigVariables() {
+	if c.config == nil {
+		return
+	}
+	set := func(pkg, key string, value any) {
+		name := variableName(key)
+		from := cmp.Or(pkg, "shared")
+		if previous, ok := c.configVars[name]; ok {
+			if !reflect.DeepEqual(previous, value) {
+				c.todo(pkg, fmt.Sprintf("config sets %s differently for %s and %s; config.uds.hcl keeps the value for %s and every package reads it", key, c.configFrom[name], from, c.configFrom[name]))
+			}
+			return
+		}
+		c.configVars[name] = value
+		c.configFrom[name] = from
+	}
+	for _, key := range slices.Sorted(maps.Keys(c.config.Shared)) {
+		set("", key, c.config.Shared[key])
+	}
+	packages := map[string]bool{}
+	for _, pkg := range c.bundle.Packages {
+		packages[pkg.Name] = true
+		vars := c.config.Variables[pkg.Name]
+		for _, key := range slices.Sorted(maps.Keys(vars)) {
+			set(pkg.Name, key, vars[key])
+		}
+	}
+	for _, pkg := range slices.Sorted(maps.Keys(c.config.Variables)) {
+		if !packages[pkg] {
+			c.note("", fmt.Sprintf("config variables for package %q were not migrated because the bundle has no such package", pkg))
+		}
+	}
+}
+
+// checkVariables reports variables deploy cannot resolve. Legacy deploys
+// skipped unset overrides; values file templates fail on them instead.
+func (c *converter) checkVariables() {
+	for _, v := range c.variables {
+		if v.def == nil && c.configVars[v.name] == nil {
+			c.todo(v.pkg, fmt.Sprintf("variable %s has no default and is not set in the config; deploy fails until config.uds.hcl sets %s", v.name, v.name))
+		}
+	}
+	for _, pkg := range c.bundle.Packages {
+		for _, name := range c.references[pkg.Name] {
+			if c.declared[name] == nil && c.configVars[name] == nil {
+				c.todo(pkg.Name, fmt.Sprintf("override values read ${%s}, which is not set in the config; deploy fails until config.uds.hcl sets %s", name, name))
+			}
+		}
+	}
+}
+
+// declare registers the values file variable for a legacy chart variable.
+// Legacy names are case-insensitive and scoped to their package; next
+// variables share one namespace, so names are lowercased and shared.
+func (c *converter) declare(pkg string, chartVar types.BundleChartVariable) *variable {
+	name := variableName(chartVar.Name)
+	file := chartVar.Type == chartvariable.File
+	if existing, ok := c.declared[name]; ok {
+		if existing.file != file || !reflect.DeepEqual(existing.def, chartVar.Default) {
+			c.todo(pkg, fmt.Sprintf("variable %s is also declared by package %q with a different type or default; both read .vars.%s", chartVar.Name, existing.pkg, name))
+		}
+		return existing
+	}
+	if name != strings.ToLower(chartVar.Name) {
+		c.note(pkg, fmt.Sprintf("variable %s is read as .vars.%s", chartVar.Name, name))
+	}
+	v := &variable{
+		name:        name,
+		description: chartVar.Description,
+		sensitive:   chartVar.Sensitive,
+		file:        file,
+		def:         chartVar.Default,
+		pkg:         pkg,
+	}
+	if path, ok := v.def.(string); ok && file {
+		v.def = c.filePath(pkg, name, c.bundleDir, path)
+	}
+	c.declared[name] = v
+	c.variables = append(c.variables, v)
+	return v
+}
+
+// relative rewrites path, relative to dir, to be relative to the output
+// directory. Absolute paths are kept.
+func (c *converter) relative(dir, path string) string {
+	if filepath.IsAbs(path) || dir == c.outputDir {
+		return filepath.ToSlash(path)
+	}
+	rel, err := filepath.Rel(c.outputDir, filepath.Join(dir, path))
+	if err != nil {
+		return filepath.ToSlash(filepath.Join(dir, path))
+	}
+	return filepath.ToSlash(rel)
+}
+
+// localSource is a relative package path marked with ./ so it is never
+// mistaken for an OCI reference.
+func (c *converter) localSource(path string) string {
+	rel := c.relative(c.bundleDir, path)
+	if filepath.IsAbs(filepath.FromSlash(rel)) || strings.HasPrefix(rel, ".") {
+		return rel
+	}
+	return "./" + rel
+}
+
+// filePath rewrites the path of a file variable and reports paths the file
+// template helper cannot read, since it is confined to the bundle directory.
+func (c *converter) filePath(pkg, name, dir, path string) string {
+	rewritten := c.relative(dir, path)
+	if !filepath.IsLocal(filepath.FromSlash(rewritten)) {
+		c.todo(pkg, fmt.Sprintf("file variable %s reads %s, outside the bundle directory; values files can only read files inside it", name, rewritten))
+	}
+	return rewritten
+}
+
+func (c *converter) defaultsFile() ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	defaults := map[string]any{}
+	for _, v := range c.variables {
+		if v.def != nil {
+			defaults[v.name] = v.def
+		}
+	}
+	if len(defaults) > 0 {
+		value, err := ctyValue(defaults)
+		if err != nil {
+			return nil, fmt.Errorf("%w: defaults: %w", ErrConvertValue, err)
+		}
+		body.SetAttributeValue("variables", value)
+	}
+	for _, v := range c.variables {
+		body.AppendNewline()
+		block := body.AppendNewBlock("variable", []string{v.name}).Body()
+		if v.description != "" {
+			block.SetAttributeValue("description", cty.StringVal(v.description))
+		}
+		value := v.def
+		if value == nil {
+			value = c.configVars[v.name]
+		}
+		typ, err := typeExpression(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: variable %s: %w", ErrConvertValue, v.name, err)
+		}
+		if typ != "" && !v.file {
+			block.SetAttributeRaw("type", hclwrite.Tokens{{Type: hclsyntax.TokenIdent, Bytes: []byte(typ)}})
+		}
+		if v.sensitive {
+			block.SetAttributeValue("sensitive", cty.True)
+		}
+	}
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+// typeExpression returns the declared type for a value, or "" for string.
+// Declarations need exact types, so lists whose elements share a type are
+// declared as lists and other lists as tuples.
+func typeExpression(value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	v, err := ctyValue(value)
+	if err != nil {
+		return "", err
+	}
+	ty := exactType(v)
+	if ty == cty.String {
+		return "", nil
+	}
+	return typeexpr.TypeString(ty), nil
+}
+
+func exactType(v cty.Value) cty.Type {
+	ty := v.Type()
+	switch {
+	case ty.IsObjectType():
+		attrs := map[string]cty.Type{}
+		for key, attr := range v.AsValueMap() {
+			attrs[key] = exactType(attr)
+		}
+		if len(attrs) == 0 {
+			return cty.Map(cty.String)
+		}
+		return cty.Object(attrs)
+	case ty.IsTupleType():
+		elems := v.AsValueSlice()
+		if len(elems) == 0 {
+			return cty.List(cty.String)
+		}
+		types := make([]cty.Type, len(elems))
+		for i, elem := range elems {
+			types[i] = exactType(elem)
+		}
+		for _, elem := range types[1:] {
+			if !elem.Equals(types[0]) {
+				return cty.Tuple(types)
+			}
+		}
+		return cty.List(types[0])
+	default:
+		return ty
+	}
+}
+
+func (c *converter) configFile() ([]byte, error) {
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	c.writeOptions(body)
+	if len(c.configVars) == 0 {
+		return hclwrite.Format(file.Bytes()), nil
+	}
+	vars := make(map[string]any, len(c.configVars))
+	for _, name := range slices.Sorted(maps.Keys(c.configVars)) {
+		value := c.configVars[name]
+		if path, ok := value.(string); ok && c.declared[name] != nil && c.declared[name].file {
+			// Legacy file variables set in the config are relative to it.
+			value = c.filePath(c.configFrom[name], name, c.configDir, path)
+		}
+		vars[name] = value
+	}
+	value, err := ctyValue(vars)
+	if err != nil {
+		return nil, fmt.Errorf("%w: config variables: %w", ErrConvertValue, err)
+	}
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	body.SetAttributeValue("variables", value)
+	return hclwrite.Format(file.Bytes()), nil
+}
+
+func (c *converter) writeOptions(body *hclwrite.Body) {
+	if len(c.config.Options) == 0 {
+		return
+	}
+	options := body.AppendNewBlock("options", nil).Body()
+	for _, key := range slices.Sorted(maps.Keys(c.config.Options)) {
+		value := c.config.Options[key]
+		switch key {
+		case "log_level", "architecture", "tmp_dir":
+			options.SetAttributeValue(key, cty.StringVal(fmt.Sprint(value)))
+		case "oci_concurrency":
+			n, ok := integer(value)
+			if !ok {
+				c.todo("", fmt.Sprintf("option oci_concurrency %v is not a number and was not migrated", value))
+				continue
+			}
+			options.SetAttributeValue("concurrency", cty.NumberIntVal(n))
+		case "insecure":
+			if insecure, _ := value.(bool); insecure {
+				options.SetAttributeValue("plain_http", cty.True)
+				options.SetAttributeValue("skip_tls_verify", cty.True)
+				c.note("", "option insecure is split into plain_http and skip_tls_verify; keep only the one the registry needs")
+			}
+		case "skip_signature_validation":
+			c.todo("", "option skip_signature_validation has no config equivalent; set signature_verification in each package block instead")
+		default:
+			c.note("", fmt.Sprintf("option %s has no equivalent and was not migrated", key))
+		}
+	}
+}
+
+func integer(value any) (int64, bool) {
+	switch n := value.(type) {
+	case int:
+		return int64(n), true
+	case int64:
+		return n, true
+	case uint64:
+		return int64(n), true //nolint:gosec // concurrency values are small
+	case float64:
+		return int64(n), n == float64(int64(n))
+	default:
+		return 0, false
+	}
+}
+
+// ctyValue converts a value decoded from legacy YAML.
+func ctyValue(value any) (cty.Value, error) {
+	switch v := value.(type) {
+	case nil:
+		return cty.NullVal(cty.DynamicPseudoType), nil
+	case string:
+		return cty.StringVal(v), nil
+	case bool:
+		return cty.BoolVal(v), nil
+	case int:
+		return cty.NumberIntVal(int64(v)), nil
+	case int64:
+		return cty.NumberIntVal(v), nil
+	case uint64:
+		return cty.NumberUIntVal(v), nil
+	case float64:
+		return cty.NumberFloatVal(v), nil
+	case map[string]any:
+		attrs := make(map[string]cty.Value, len(v))
+		for key, child := range v {
+			converted, err := ctyValue(child)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("%q: %w", key, err)
+			}
+			attrs[key] = converted
+		}
+		return cty.ObjectVal(attrs), nil
+	case []any:
+		elems := make([]cty.Value, len(v))
+		for i, child := range v {
+			converted, err := ctyValue(child)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("[%d]: %w", i, err)
+			}
+			elems[i] = converted
+		}
+		return cty.TupleVal(elems), nil
+	default:
+		return cty.NilVal, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+// identifier returns name, or name with the characters HCL identifiers do not
+// allow replaced by underscores.
+func identifier(name string) string {
+	if hclsyntax.ValidIdentifier(name) {
+		return name
+	}
+	var b strings.Builder
+	for i, r := range name {
+		switch {
+		case r == '_' || unicode.IsLetter(r):
+		case i > 0 && (r == '-' || unicode.IsDigit(r)):
+		case i == 0 && unicode.IsDigit(r):
+			b.WriteRune('_')
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// variableName returns the .vars path a legacy variable name is read as:
+// lowercased, with characters other than letters, digits and underscores
+// replaced.
+func variableName(name string) string {
+	var b strings.Builder
+	for i, r := range strings.ToLower(name) {
+		switch {
+		case r == '_' || ('a' <= r && r <= 'z'):
+		case '0' <= r && r <= '9':
+			if i == 0 {
+				b.WriteRune('_')
+			}
+		default:
+			r = '_'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
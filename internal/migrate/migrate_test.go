@@ -0,0 +1,244 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const legacyBundleYAML = `kind: UDSBundle
+metadata:
+  name: platform
+  description: legacy platform bundle
+  version: 1.2.0
+  url: https://example.com
+packages:
+  - name: init
+    repository: ghcr.io/zarf-dev/packages/init
+    ref: v0.60.0
+    keylessVerification:
+      certificateIdentityRegexp: https://github\.com/zarf-dev/.*
+      certificateOIDCIssuer: https://token.actions.githubusercontent.com
+  - name: local-app
+    description: the application
+    path: build
+    ref: 0.1.0
+    flavor: upstream
+    namespace: apps
+    timeout: 10m
+    optionalComponents: [extras]
+    publicKey: |
+      -----BEGIN PUBLIC KEY-----
+      abc
+      -----END PUBLIC KEY-----
+    imports:
+      - name: DOMAIN
+        package: init
+    overrides:
+      app:
+        app-chart:
+          namespace: other
+          valuesFiles: [app-values.yaml]
+          values:
+            - path: "ingress.host"
+              value: "app.${DOMAIN}"
+            - path: "annotations.template"
+              value: "{{ .Release.Name }}"
+            - path: labels\.kubernetes\.io
+              value: x
+          variables:
+            - name: REPLICAS
+              path: replicas
+              description: app replicas
+              default: 2
+            - name: TLS_CERT
+              path: tls.cert
+              type: file
+              sensitive: true
+`
+
+const legacyConfigYAML = `options:
+  log_level: debug
+  insecure: true
+  oci_concurrency: 4
+  no_progress: true
+shared:
+  domain: uds.dev
+variables:
+  local-app:
+    replicas: 3
+    tls_cert: certs/tls.pem
+  missing:
+    foo: bar
+`
+
+func writeLegacy(t *testing.T, dir string) (string, string) {
+	t.Helper()
+	bundleFile := filepath.Join(dir, "uds-bundle.yaml")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(legacyBundleYAML), 0o600))
+	configFile := filepath.Join(dir, LegacyConfigFileName)
+	require.NoError(t, os.WriteFile(configFile, []byte(legacyConfigYAML), 0o600))
+	return bundleFile, configFile
+}
+
+func TestConvert(t *testing.T) {
+	dir := t.TempDir()
+	bundleFile, configFile := writeLegacy(t, dir)
+
+	result, err := Convert(t.Context(), Options{BundleFile: bundleFile, ConfigFile: configFile})
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, f := range result.Files {
+		files[f.Path] = string(f.Data)
+		path := filepath.Join(dir, filepath.FromSlash(f.Path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+		require.NoError(t, os.WriteFile(path, f.Data, 0o600))
+	}
+	require.Len(t, files, 5)
+
+	bundle := files[bundleinternal.BundleFileName]
+	assert.Contains(t, bundle, `source = "oci://ghcr.io/zarf-dev/packages/init:v0.60.0"`)
+	assert.Contains(t, bundle, `source              = "./build/zarf-package-local-app-${sys.arch}-0.1.0-upstream.tar.zst"`)
+	assert.Contains(t, bundle, `# TODO: imports DOMAIN from package "init"`)
+	parsed, err := bundleinternal.NewHCLParser("amd64", iostreams.IOStreams{}).ParseBundleFile(t.Context(), filepath.Join(dir, bundleinternal.BundleFileName))
+	require.NoError(t, err)
+	require.Len(t, parsed.Packages, 2)
+	assert.Equal(t, "platform", parsed.Metadata.Name)
+	assert.Equal(t, "https://github\\.com/zarf-dev/.*", parsed.Packages[0].SignatureVerification.Keyless.CertificateIdentityRegexp)
+	app := parsed.Packages[1]
+	assert.Equal(t, "local-app", app.Name)
+	assert.Equal(t, "./build/zarf-package-local-app-amd64-0.1.0-upstream.tar.zst", app.Source)
+	assert.Equal(t, "apps", app.Namespace)
+	assert.Equal(t, "init", app.DependsOn[0].Name)
+	assert.Equal(t, []string{"extras"}, app.OptionalComponents)
+	assert.Equal(t, []string{"values/local-app.yaml"}, app.ValuesFiles)
+	assert.Contains(t, app.SignatureVerification.PublicKey, "BEGIN PUBLIC KEY")
+	assert.Contains(t, files["keys/local-app.pub"], "BEGIN PUBLIC KEY")
+
+	assert.Equal(t, `app:
+  app-chart:
+    ingress:
+      host: app.{{ .vars.domain }}
+    annotations:
+      template: '{{ "{{" }} .Release.Name }}'
+    labels.kubernetes.io: x
+    replicas:{{ toYaml .vars.replicas | nindent 6 }}
+    tls:
+      cert:{{ file .vars.tls_cert | toYaml | nindent 8 }}
+`, files["values/local-app.yaml"])
+
+	defaultsPath := filepath.Join(dir, bundleinternal.BundleDefaultsFileName)
+	defaults, err := bundleinternal.ParseDefaults(t.Context(), defaultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, bundleinternal.Variables{"replicas": float64(2)}, defaults)
+	declarations, err := bundleinternal.ParseVariableDeclarations(t.Context(), defaultsPath)
+	require.NoError(t, err)
+	assert.Equal(t, []bundleinternal.VariableDeclaration{
+		{Name: "replicas", Description: "app replicas", Type: cty.Number},
+		{Name: "tls_cert", Type: cty.String, Sensitive: true},
+	}, declarations)
+
+	cfg, err := bundleinternal.NewHCLParser("", iostreams.IOStreams{}).ParseBundleConfig(t.Context(), filepath.Join(dir, bundleinternal.ConfigFileName))
+	require.NoError(t, err)
+	assert.Equal(t, "debug", cfg.Options.LogLevel)
+	assert.Equal(t, 4, cfg.Options.Concurrency)
+	assert.True(t, cfg.Options.PlainHTTP)
+	assert.True(t, cfg.Options.SkipTLSVerify)
+	assert.Equal(t, bundleinternal.Variables{"domain": "uds.dev", "replicas": float64(3), "tls_cert": "certs/tls.pem"}, cfg.Variables)
+
+	var todos []string
+	for _, d := range result.Diagnostics {
+		if d.Severity == SeverityTodo {
+			todos = append(todos, d.Message)
+		}
+	}
+	assert.Len(t, todos, 4)
+	assert.Contains(t, todos, `imports DOMAIN from package "init", but packages cannot pass variables to each other; set domain in config.uds.hcl`)
+	assert.Contains(t, todos, `chart app/app-chart was deployed to namespace other, which values cannot change; set the chart namespace in the Zarf package`)
+	assert.Contains(t, todos, `chart app/app-chart values file app-values.yaml is not applied; merge it under app.app-chart in the package values file`)
+	assert.Contains(t, result.Diagnostics, Diagnostic{Severity: SeverityNote, Package: "local-app", Message: "timeout 10m has no equivalent and was not migrated"})
+	assert.Contains(t, result.Diagnostics, Diagnostic{Severity: SeverityNote, Message: "option no_progress has no equivalent and was not migrated"})
+	assert.Contains(t, result.Diagnostics, Diagnostic{Severity: SeverityNote, Message: `config variables for package "missing" were not migrated because the bundle has no such package`})
+}
+
+func TestConvert_OutputDir(t *testing.T) {
+	dir := t.TempDir()
+	bundleFile, configFile := writeLegacy(t, dir)
+
+	result, err := Convert(t.Context(), Options{BundleFile: bundleFile, ConfigFile: configFile, OutputDir: filepath.Join(dir, "next")})
+	require.NoError(t, err)
+	files := map[string]string{}
+	for _, f := range result.Files {
+		files[f.Path] = string(f.Data)
+	}
+	assert.Contains(t, files[bundleinternal.BundleFileName], `"../build/zarf-package-local-app-${sys.arch}-0.1.0-upstream.tar.zst"`)
+	assert.Contains(t, files[bundleinternal.ConfigFileName], `tls_cert = "../certs/tls.pem"`)
+	assert.Contains(t, result.Diagnostics, Diagnostic{
+		Severity: SeverityTodo, Package: "local-app",
+		Message: "file variable tls_cert reads ../certs/tls.pem, outside the bundle directory; values files can only read files inside it",
+	})
+}
+
+func TestConvert_HelmOverrides(t *testing.T) {
+	dir := filepath.Join("..", "..", "testdata", "legacy", "bundles", "07-helm-overrides")
+	result, err := Convert(t.Context(), Options{
+		BundleFile: filepath.Join(dir, "uds-bundle.yaml"),
+		ConfigFile: filepath.Join(dir, "uds-config.yaml"),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Files, 4)
+	assert.Contains(t, string(result.Files[0].Data), `source       = "../../packages/helm/zarf-package-helm-overrides-${sys.arch}-0.0.1.tar.zst"`)
+	assert.Contains(t, string(result.Files[1].Data), "type        = object({ runAsGroup = number, runAsUser = number })")
+	assert.Contains(t, string(result.Files[2].Data), `secret_file_val     = "./variable-files/test.cert"`)
+	assert.Contains(t, string(result.Files[3].Data), "      replicaCount: {{ .vars.numreplicas }}\n")
+	assert.Contains(t, result.Diagnostics, Diagnostic{
+		Severity: SeverityTodo, Package: "helm-overrides",
+		Message: "the legacy bundle did not verify this package's signature, so verify is false; set public_key or keyless to verify it",
+	})
+	assert.Contains(t, result.Diagnostics, Diagnostic{
+		Severity: SeverityTodo, Package: "helm-overrides",
+		Message: "variable secret_val has no default and is not set in the config; deploy fails until config.uds.hcl sets secret_val",
+	})
+}
+
+func TestConvert_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		bundle string
+		err    error
+	}{
+		{name: "invalid yaml", bundle: "packages: [", err: ErrParseLegacyBundle},
+		{name: "wrong kind", bundle: "kind: ZarfPackageConfig\nmetadata: {name: x}\npackages: [{name: a, path: .}]", err: ErrInvalidLegacyInput},
+		{name: "no packages", bundle: "metadata: {name: x}", err: ErrInvalidLegacyInput},
+		{name: "no source", bundle: "metadata: {name: x}\npackages: [{name: a, ref: '1'}]", err: ErrInvalidLegacyInput},
+		{name: "repository without ref", bundle: "metadata: {name: x}\npackages: [{name: a, repository: ghcr.io/a}]", err: ErrInvalidLegacyInput},
+		{name: "renamed packages collide", bundle: "metadata: {name: x}\npackages: [{name: a.b, path: a.tar.zst}, {name: a_b, path: b.tar.zst}]", err: ErrInvalidLegacyInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "uds-bundle.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.bundle), 0o600))
+			_, err := Convert(t.Context(), Options{BundleFile: path})
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	_, err := Convert(t.Context(), Options{BundleFile: filepath.Join(t.TempDir(), "uds-bundle.yaml")})
+	require.ErrorIs(t, err, ErrReadLegacyBundle)
+}
+
+func TestNames(t *testing.T) {
+	assert.Equal(t, "local-app", identifier("local-app"))
+	assert.Equal(t, "_1_app_v2", identifier("1.app v2"))
+	assert.Equal(t, "ui_msg", variableName("UI_MSG"))
+	assert.Equal(t, "_9lives_x", variableName("9lives-x"))
+}
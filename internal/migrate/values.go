@@ -0,0 +1,190 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/pkg/legacy/types"
+	"gopkg.in/yaml.v3"
+)
+
+// legacyReference matches the ${NAME} references legacy override values
+// substituted from package variables.
+var legacyReference = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// valuesNode is a mapping in a generated values file. Leaves hold either a
+// static value or the variable that renders them.
+type valuesNode struct {
+	keys     []string
+	children map[string]*valuesNode
+	leaf     bool
+	value    any
+	variable *variable
+}
+
+func newValuesNode() *valuesNode {
+	return &valuesNode{children: map[string]*valuesNode{}}
+}
+
+// set places leaf at path. Like Helm's --set, a later path replaces an
+// earlier one and a path below a leaf replaces the leaf with a mapping.
+func (n *valuesNode) set(path []string, leaf *valuesNode) {
+	current := n
+	for i, key := range path {
+		child, ok := current.children[key]
+		if !ok {
+			current.keys = append(current.keys, key)
+		}
+		if i == len(path)-1 {
+			current.children[key] = leaf
+			return
+		}
+		if !ok || child.leaf {
+			child = newValuesNode()
+			current.children[key] = child
+		}
+		current = child
+	}
+}
+
+// valuesFile converts the package's chart overrides into a values file that
+// nests each chart's values under <component>.<chart>. Returns nil when the
+// package sets no values.
+func (c *converter) valuesFile(pkg types.Package, block *packageBlock) (*File, error) {
+	root := newValuesNode()
+	for _, component := range slices.Sorted(maps.Keys(pkg.Overrides)) {
+		charts := pkg.Overrides[component]
+		for _, chart := range slices.Sorted(maps.Keys(charts)) {
+			overrides := charts[chart]
+			for _, v := range overrides.Values {
+				root.set(valuesPath(component, chart, v.Path), &valuesNode{leaf: true, value: v.Value})
+			}
+			for _, v := range overrides.Variables {
+				root.set(valuesPath(component, chart, v.Path), &valuesNode{leaf: true, variable: c.declare(pkg.Name, v)})
+			}
+			if overrides.Namespace != "" {
+				block.todo(c, pkg.Name, fmt.Sprintf("chart %s/%s was deployed to namespace %s, which values cannot change; set the chart namespace in the Zarf package", component, chart, overrides.Namespace))
+			}
+			for _, file := range overrides.ValuesFiles {
+				block.todo(c, pkg.Name, fmt.Sprintf("chart %s/%s values file %s is not applied; merge it under %s.%s in the package values file", component, chart, file, component, chart))
+			}
+		}
+	}
+	if len(root.keys) == 0 {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := c.writeValues(&b, pkg.Name, root, 0); err != nil {
+		return nil, fmt.Errorf("%w: package %q values: %w", ErrConvertValue, pkg.Name, err)
+	}
+	path := "values/" + block.name + ".yaml"
+	block.todo(c, pkg.Name, fmt.Sprintf("chart overrides are nested under <component>.<chart> in %s; map them to chart values in the Zarf package's values configuration", path))
+	return &File{Path: path, Data: b.Bytes()}, nil
+}
+
+// valuesPath splits a legacy Helm --set path, in which \. is a literal dot.
+func valuesPath(component, chart, path string) []string {
+	keys := []string{component, chart}
+	var key strings.Builder
+	for i := 0; i < len(path); i++ {
+		switch {
+		case path[i] == '\\' && i+1 < len(path) && path[i+1] == '.':
+			key.WriteByte('.')
+			i++
+		case path[i] == '.':
+			keys = append(keys, key.String())
+			key.Reset()
+		default:
+			key.WriteByte(path[i])
+		}
+	}
+	return append(keys, key.String())
+}
+
+func (c *converter) writeValues(b *bytes.Buffer, pkg string, n *valuesNode, indent int) error {
+	pad := strings.Repeat(" ", indent)
+	for _, key := range n.keys {
+		child := n.children[key]
+		k, err := marshalYAML(key)
+		if err != nil {
+			return err
+		}
+		k = escapeActions(k)
+		switch {
+		case child.variable != nil:
+			fmt.Fprintf(b, "%s%s:{{ %s | nindent %d }}\n", pad, k, child.variable.template(), indent+2)
+		case child.leaf:
+			text, err := marshalYAML(child.value)
+			if err != nil {
+				return err
+			}
+			text = c.templateValue(pkg, text)
+			if isCollection(child.value) {
+				fmt.Fprintf(b, "%s%s:\n%s\n", pad, k, indentLines(indent+2, text))
+			} else {
+				// Continuation lines of block scalars are already indented
+				// relative to the key.
+				fmt.Fprintf(b, "%s%s: %s\n", pad, k, strings.ReplaceAll(text, "\n", "\n"+pad))
+			}
+		default:
+			fmt.Fprintf(b, "%s%s:\n", pad, k)
+			if err := c.writeValues(b, pkg, child, indent+2); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// templateValue escapes template actions in a static value and turns legacy
+// ${NAME} references into .vars lookups.
+func (c *converter) templateValue(pkg, text string) string {
+	return legacyReference.ReplaceAllStringFunc(escapeActions(text), func(match string) string {
+		name := variableName(legacyReference.FindStringSubmatch(match)[1])
+		if !slices.Contains(c.references[pkg], name) {
+			c.references[pkg] = append(c.references[pkg], name)
+		}
+		return "{{ .vars." + name + " }}"
+	})
+}
+
+func escapeActions(text string) string {
+	return strings.ReplaceAll(text, "{{", `{{ "{{" }}`)
+}
+
+func isCollection(value any) bool {
+	switch v := value.(type) {
+	case map[string]any:
+		return len(v) > 0
+	case []any:
+		return len(v) > 0
+	default:
+		return false
+	}
+}
+
+func marshalYAML(value any) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(value); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func indentLines(n int, text string) string {
+	pad := strings.Repeat(" ", n)
+	return pad + strings.ReplaceAll(text, "\n", "\n"+pad)
+}
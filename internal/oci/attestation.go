@@ -0,0 +1,317 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+)
+
+const (
+	// MediaTypeInTotoStatement identifies an in-toto attestation statement.
+	MediaTypeInTotoStatement = "application/vnd.in-toto+json"
+	// MediaTypeCycloneDX identifies a CycloneDX JSON SBOM.
+	MediaTypeCycloneDX = "application/vnd.cyclonedx+json"
+)
+
+const (
+	// BundleProvenanceFileName is the archive-root filename for bundle provenance.
+	BundleProvenanceFileName = "uds.bundle.provenance.json"
+	// BundleSBOMFileName is the archive-root filename for the aggregated bundle SBOM.
+	BundleSBOMFileName = "uds.bundle.sbom.json"
+)
+
+// BundleAttestation is a statement about a child bundle index, stored beside
+// the archive root locally and as an OCI referrer of the child in a registry.
+type BundleAttestation struct {
+	MediaType string
+	Data      []byte
+	// Signature holds optional Sigstore evidence over Data.
+	Signature []byte
+}
+
+// bundleAttestationFiles lists the supported attestations in publish order.
+// tagSuffix follows the cosign convention for tags that locate attestations
+// on registries without the referrers API.
+var bundleAttestationFiles = []struct {
+	mediaType string
+	fileName  string
+	tagSuffix string
+}{
+	{mediaType: MediaTypeInTotoStatement, fileName: BundleProvenanceFileName, tagSuffix: ".att"},
+	{mediaType: MediaTypeCycloneDX, fileName: BundleSBOMFileName, tagSuffix: ".sbom"},
+}
+
+// BundleAttestationFileName returns the archive-root filename for mediaType.
+func BundleAttestationFileName(mediaType string) (string, bool) {
+	for _, f := range bundleAttestationFiles {
+		if f.mediaType == mediaType {
+			return f.fileName, true
+		}
+	}
+	return "", false
+}
+
+// BundleAttestationSignatureFileName returns the archive-root filename for
+// Sigstore evidence over the attestation stored in fileName.
+func BundleAttestationSignatureFileName(fileName string) string {
+	return strings.TrimSuffix(fileName, ".json") + ".sig"
+}
+
+// BundleAttestationFileNames returns every archive-root filename that may hold
+// attestation data or attestation signature evidence.
+func BundleAttestationFileNames() []string {
+	names := make([]string, 0, 2*len(bundleAttestationFiles))
+	for _, f := range bundleAttestationFiles {
+		names = append(names, f.fileName, BundleAttestationSignatureFileName(f.fileName))
+	}
+	return names
+}
+
+// ReadBundleAttestations reads the attestations present at the root of an
+// extracted bundle workspace. Missing attestations are skipped.
+func ReadBundleAttestations(dir string) ([]BundleAttestation, error) {
+	var attestations []BundleAttestation
+	for _, f := range bundleAttestationFiles {
+		data, err := readOptionalEvidence(filepath.Join(dir, f.fileName))
+		if err != nil {
+			return nil, fmt.Errorf("reading bundle attestation %s: %w", f.fileName, err)
+		}
+		if data == nil {
+			continue
+		}
+		signature, err := readOptionalEvidence(filepath.Join(dir, BundleAttestationSignatureFileName(f.fileName)))
+		if err != nil {
+			return nil, fmt.Errorf("reading bundle attestation signature for %s: %w", f.fileName, err)
+		}
+		attestations = append(attestations, BundleAttestation{MediaType: f.mediaType, Data: data, Signature: signature})
+	}
+	return attestations, nil
+}
+
+// WriteBundleAttestations writes attestations to the root of a bundle workspace.
+func WriteBundleAttestations(dir string, attestations []BundleAttestation) error {
+	for _, attestation := range attestations {
+		fileName, ok := BundleAttestationFileName(attestation.MediaType)
+		if !ok {
+			return fmt.Errorf("unsupported bundle attestation media type %q", attestation.MediaType)
+		}
+		if err := os.WriteFile(filepath.Join(dir, fileName), attestation.Data, filesystem.PrivateFileMode); err != nil {
+			return fmt.Errorf("writing bundle attestation %s: %w", fileName, err)
+		}
+		if len(attestation.Signature) == 0 {
+			continue
+		}
+		sigName := BundleAttestationSignatureFileName(fileName)
+		if err := os.WriteFile(filepath.Join(dir, sigName), attestation.Signature, filesystem.PrivateFileMode); err != nil {
+			return fmt.Errorf("writing bundle attestation signature %s: %w", sigName, err)
+		}
+	}
+	return nil
+}
+
+func readOptionalEvidence(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if info.Size() > MaxFetchBytesSize {
+		return nil, fmt.Errorf("%s is %d bytes, larger than the %d byte buffered read limit", filepath.Base(path), info.Size(), MaxFetchBytesSize)
+	}
+	return os.ReadFile(path)
+}
+
+// PublishBundleAttestation publishes attestation as a referrer of a child
+// bundle index. An identical existing attestation is left in place; an
+// existing signed attestation is not replaced by the same unsigned data.
+// Other attestations of the same media type are replaced.
+func PublishBundleAttestation(ctx context.Context, target oras.Target, subject ocispec.Descriptor, attestation BundleAttestation) error {
+	tag, ok := attestationTag(subject, attestation.MediaType)
+	if !ok {
+		return fmt.Errorf("unsupported bundle attestation media type %q", attestation.MediaType)
+	}
+	store, ok := target.(content.ReadOnlyGraphStorage)
+	if !ok {
+		return fmt.Errorf("registry target does not support attestation discovery")
+	}
+	refs, err := attestationReferences(ctx, target, store, subject, attestation.MediaType, tag)
+	if err != nil {
+		return fmt.Errorf("discovering existing bundle attestation: %w", err)
+	}
+	if len(refs) == 1 {
+		existing, err := attestationData(ctx, target, refs[0])
+		if err == nil && bytes.Equal(existing.Data, attestation.Data) &&
+			(len(attestation.Signature) == 0 || bytes.Equal(existing.Signature, attestation.Signature)) {
+			return nil
+		}
+	}
+	var deleter content.Deleter
+	if len(refs) != 0 {
+		deleter, ok = target.(content.Deleter)
+		if !ok {
+			return fmt.Errorf("registry target does not support replacing bundle attestations")
+		}
+	}
+	// Registries without the referrers API track referrers in an index at the
+	// same tag as legacy signature evidence; keep that evidence reachable.
+	restoreSignature, err := preserveLegacySignature(ctx, target, subject)
+	if err != nil {
+		return err
+	}
+
+	dataLayer, err := PushBytes(ctx, target, attestation.MediaType, attestation.Data, nil)
+	if err != nil {
+		return fmt.Errorf("pushing attestation data: %w", err)
+	}
+	layers := []ocispec.Descriptor{dataLayer}
+	if len(attestation.Signature) != 0 {
+		signatureLayer, err := PushBytes(ctx, target, MediaTypeBundleSignature, attestation.Signature, nil)
+		if err != nil {
+			return fmt.Errorf("pushing attestation signature evidence: %w", err)
+		}
+		layers = append(layers, signatureLayer)
+	}
+	replacement, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, attestation.MediaType, oras.PackManifestOptions{
+		Subject: &subject,
+		Layers:  layers,
+	})
+	if err != nil {
+		return fmt.Errorf("publishing attestation artifact: %w", err)
+	}
+	if err := target.Tag(ctx, replacement, tag); err != nil {
+		return fmt.Errorf("tagging attestation artifact: %w", err)
+	}
+	for _, ref := range refs {
+		if ref.Digest == replacement.Digest {
+			continue
+		}
+		if err := deleter.Delete(ctx, ref); err != nil && !errors.Is(err, errdef.ErrNotFound) {
+			return fmt.Errorf("removing replaced attestation artifact: %w", err)
+		}
+	}
+	return restoreSignature()
+}
+
+// FetchBundleAttestations discovers and fetches the supported attestations of
+// subject. Missing attestations are skipped.
+func FetchBundleAttestations(ctx context.Context, source oras.Target, subject ocispec.Descriptor) ([]BundleAttestation, error) {
+	store, ok := source.(content.ReadOnlyGraphStorage)
+	if !ok {
+		return nil, fmt.Errorf("registry target does not support attestation discovery")
+	}
+	var attestations []BundleAttestation
+	for _, f := range bundleAttestationFiles {
+		refs, err := attestationReferences(ctx, source, store, subject, f.mediaType, legacySignatureTag(subject)+f.tagSuffix)
+		if err != nil {
+			return nil, fmt.Errorf("discovering bundle attestation %s: %w", f.mediaType, err)
+		}
+		if len(refs) == 0 {
+			continue
+		}
+		if len(refs) != 1 {
+			return nil, fmt.Errorf("expected at most one %s attestation artifact, found %d", f.mediaType, len(refs))
+		}
+		attestation, err := attestationData(ctx, source, refs[0])
+		if err != nil {
+			return nil, err
+		}
+		attestations = append(attestations, attestation)
+	}
+	return attestations, nil
+}
+
+func attestationReferences(ctx context.Context, target oras.Target, store content.ReadOnlyGraphStorage, subject ocispec.Descriptor, mediaType, tag string) ([]ocispec.Descriptor, error) {
+	refs, err := registry.Referrers(ctx, store, subject, mediaType)
+	if err == nil && len(refs) != 0 {
+		return refs, nil
+	}
+	tagged, tagErr := target.Resolve(ctx, tag)
+	if tagErr == nil {
+		return []ocispec.Descriptor{tagged}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+func attestationTag(subject ocispec.Descriptor, mediaType string) (string, bool) {
+	for _, f := range bundleAttestationFiles {
+		if f.mediaType == mediaType {
+			return legacySignatureTag(subject) + f.tagSuffix, true
+		}
+	}
+	return "", false
+}
+
+// preserveLegacySignature records signature evidence tagged at the legacy
+// signature tag and returns a func that restores it if a referrers index
+// update replaced or removed it.
+func preserveLegacySignature(ctx context.Context, target oras.Target, subject ocispec.Descriptor) (func() error, error) {
+	noop := func() error { return nil }
+	tag := legacySignatureTag(subject)
+	desc, err := target.Resolve(ctx, tag)
+	if err != nil || desc.MediaType != ocispec.MediaTypeImageManifest {
+		return noop, nil
+	}
+	manifestBytes, err := FetchBytes(ctx, target, desc)
+	if err != nil {
+		return nil, fmt.Errorf("fetching bundle signature artifact: %w", err)
+	}
+	return func() error {
+		if current, err := target.Resolve(ctx, tag); err == nil && current.Digest == desc.Digest {
+			return nil
+		}
+		if err := PushDescriptorBytes(ctx, target, desc, manifestBytes); err != nil {
+			return fmt.Errorf("restoring bundle signature artifact: %w", err)
+		}
+		if err := target.Tag(ctx, desc, tag); err != nil {
+			return fmt.Errorf("restoring bundle signature tag: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+func attestationData(ctx context.Context, source oras.Target, ref ocispec.Descriptor) (BundleAttestation, error) {
+	manifestBytes, err := FetchBytes(ctx, source, ref)
+	if err != nil {
+		return BundleAttestation{}, fmt.Errorf("fetching attestation artifact: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return BundleAttestation{}, fmt.Errorf("parsing attestation artifact: %w", err)
+	}
+	if len(manifest.Layers) == 0 || len(manifest.Layers) > 2 || manifest.Layers[0].MediaType != manifest.ArtifactType ||
+		(len(manifest.Layers) == 2 && manifest.Layers[1].MediaType != MediaTypeBundleSignature) {
+		return BundleAttestation{}, fmt.Errorf("attestation artifact has invalid layers")
+	}
+	attestation := BundleAttestation{MediaType: manifest.ArtifactType}
+	attestation.Data, err = FetchBytes(ctx, source, manifest.Layers[0])
+	if err != nil {
+		return BundleAttestation{}, fmt.Errorf("fetching attestation data: %w", err)
+	}
+	if len(manifest.Layers) == 2 {
+		attestation.Signature, err = FetchBytes(ctx, source, manifest.Layers[1])
+		if err != nil {
+			return BundleAttestation{}, fmt.Errorf("fetching attestation signature evidence: %w", err)
+		}
+	}
+	return attestation, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+	oraci "oras.land/oras-go/v2/content/oci"
+	orasregistry "oras.land/oras-go/v2/registry"
+)
+
+func TestPublishBundleAttestation_RoundTrip(t *testing.T) {
+	store, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+
+	subject := pushSignatureSubject(t, store)
+	provenance := BundleAttestation{MediaType: MediaTypeInTotoStatement, Data: []byte(`{"_type":"provenance"}`), Signature: []byte("evidence")}
+	sbom := BundleAttestation{MediaType: MediaTypeCycloneDX, Data: []byte(`{"bomFormat":"CycloneDX"}`)}
+	require.NoError(t, PublishBundleAttestation(t.Context(), store, subject, sbom))
+	require.NoError(t, PublishBundleAttestation(t.Context(), store, subject, provenance))
+
+	attestations, err := FetchBundleAttestations(t.Context(), store, subject)
+	require.NoError(t, err)
+	require.Equal(t, []BundleAttestation{provenance, sbom}, attestations)
+}
+
+func TestPublishBundleAttestation_ReplacesChangedAttestation(t *testing.T) {
+	store, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+
+	subject := pushSignatureSubject(t, store)
+	unsigned := BundleAttestation{MediaType: MediaTypeInTotoStatement, Data: []byte("statement")}
+	signed := BundleAttestation{MediaType: MediaTypeInTotoStatement, Data: []byte("statement"), Signature: []byte("evidence")}
+	require.NoError(t, PublishBundleAttestation(t.Context(), store, subject, unsigned))
+	require.NoError(t, PublishBundleAttestation(t.Context(), store, subject, signed))
+	require.NoError(t, PublishBundleAttestation(t.Context(), store, subject, unsigned), "unsigned data must not replace its signed copy")
+
+	refs, err := orasregistry.Referrers(t.Context(), store, subject, MediaTypeInTotoStatement)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	attestations, err := FetchBundleAttestations(t.Context(), store, subject)
+	require.NoError(t, err)
+	require.Equal(t, []BundleAttestation{signed}, attestations)
+}
+
+func TestPublishBundleAttestation_PreservesSignatureWithoutReferrersAPI(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	ref := strings.TrimPrefix(server.URL, "http://") + "/test/bundle:v1"
+	repo, err := NewRemoteRepository(t.Context(), ref, bundleinternal.ConfigOptions{PlainHTTP: true})
+	require.NoError(t, err)
+
+	subjectData := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`)
+	subject := content.NewDescriptorFromBytes(ocispec.MediaTypeImageIndex, subjectData)
+	require.NoError(t, repo.Push(t.Context(), subject, bytes.NewReader(subjectData)))
+
+	require.NoError(t, PublishBundleSignature(t.Context(), repo, subject, []byte("signature evidence"), false))
+	provenance := BundleAttestation{MediaType: MediaTypeInTotoStatement, Data: []byte("statement")}
+	sbom := BundleAttestation{MediaType: MediaTypeCycloneDX, Data: []byte("sbom"), Signature: []byte("sbom evidence")}
+	require.NoError(t, PublishBundleAttestation(t.Context(), repo, subject, provenance))
+	require.NoError(t, PublishBundleAttestation(t.Context(), repo, subject, sbom))
+
+	evidence, err := FetchBundleSignatures(t.Context(), repo, subject)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("signature evidence")}, evidence)
+	attestations, err := FetchBundleAttestations(t.Context(), repo, subject)
+	require.NoError(t, err)
+	require.Equal(t, []BundleAttestation{provenance, sbom}, attestations)
+}
+
+func TestFetchBundleSignatures_IgnoresReferrersIndexTag(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	ref := strings.TrimPrefix(server.URL, "http://") + "/test/bundle:v1"
+	repo, err := NewRemoteRepository(t.Context(), ref, bundleinternal.ConfigOptions{PlainHTTP: true})
+	require.NoError(t, err)
+
+	subjectData := []byte(`{"schemaVersion":2,"mediaType":"application/vnd.oci.image.index.v1+json","manifests":[]}`)
+	subject := content.NewDescriptorFromBytes(ocispec.MediaTypeImageIndex, subjectData)
+	require.NoError(t, repo.Push(t.Context(), subject, bytes.NewReader(subjectData)))
+	require.NoError(t, PublishBundleAttestation(t.Context(), repo, subject, BundleAttestation{MediaType: MediaTypeInTotoStatement, Data: []byte("statement")}))
+
+	_, err = FetchBundleSignatures(t.Context(), repo, subject)
+	require.ErrorIs(t, err, ErrBundleSignatureNotFound)
+}
+
+func TestBundleAttestationFiles_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	attestations := []BundleAttestation{
+		{MediaType: MediaTypeInTotoStatement, Data: []byte("statement"), Signature: []byte("evidence")},
+		{MediaType: MediaTypeCycloneDX, Data: []byte("sbom")},
+	}
+	require.NoError(t, WriteBundleAttestations(dir, attestations))
+
+	read, err := ReadBundleAttestations(dir)
+	require.NoError(t, err)
+	require.Equal(t, attestations, read)
+	require.FileExists(t, filepath.Join(dir, "uds.bundle.provenance.sig"))
+	require.NoFileExists(t, filepath.Join(dir, "uds.bundle.sbom.sig"))
+
+	require.ErrorContains(t, WriteBundleAttestations(dir, []BundleAttestation{{MediaType: "text/plain"}}), "unsupported bundle attestation media type")
+}
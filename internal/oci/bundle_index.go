@@ -32,6 +32,8 @@ const (
 	AnnotationPackageName = "uds.dev/package.name"
 	// AnnotationPackageSource records the bundle package source for provenance.
 	AnnotationPackageSource = "uds.dev/package.source"
+	// AnnotationPackageDigest records the manifest digest a locked package source was pinned to.
+	AnnotationPackageDigest = "uds.dev/package.digest"
 
 	// AnnotationPackageVerification records a successful package verification during bundle creation.
 	AnnotationPackageVerification = "uds.dev/package-verification"
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	packageoci "github.com/defenseunicorns/pkg/oci"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
@@ -18,7 +19,8 @@ import (
 )
 
 const (
-	mediaTypeDockerManifest = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
 	// MaxFetchBytesSize is the largest descriptor UDS CLI will buffer in memory.
 	MaxFetchBytesSize = 16 << 20
 )
@@ -166,11 +168,13 @@ func PublishBundleRootIndex(ctx context.Context, target oras.Target, tag string,
 }
 
 // PackBundleDefinitionManifest stores the bundle definition artifact manifest.
-func PackBundleDefinitionManifest(ctx context.Context, store content.Storage, layers []ocispec.Descriptor) (ocispec.Descriptor, error) {
+// created is recorded as the manifest's created annotation; callers pin it so
+// identical inputs produce identical manifest digests.
+func PackBundleDefinitionManifest(ctx context.Context, store content.Storage, layers []ocispec.Descriptor, created time.Time) (ocispec.Descriptor, error) {
 	desc, err := oras.PackManifest(ctx, store, oras.PackManifestVersion1_1, MediaTypeBundleDefinition, oras.PackManifestOptions{
 		Layers: layers,
 		ManifestAnnotations: map[string]string{
-			ocispec.AnnotationCreated: "1970-01-01T00:00:00Z",
+			ocispec.AnnotationCreated: created.UTC().Format(time.RFC3339),
 		},
 	})
 	if err != nil {
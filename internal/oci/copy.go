@@ -0,0 +1,209 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// CopyOptions configures a registry-to-registry bundle copy.
+type CopyOptions struct {
+	Config  *bundleinternal.UDSBundleConfig
+	Streams iostreams.IOStreams
+	// AllArchitectures copies every bundle in the source root index rather
+	// than the one for the configured architecture.
+	AllArchitectures bool
+	CopyHooks        CopyHooks
+}
+
+// Validate validates copy options.
+func (o CopyOptions) Validate() error { return bundleinternal.ValidateConfig(o.Config) }
+
+// CopyResult describes a completed bundle copy.
+type CopyResult struct {
+	Source        string   `json:"source" yaml:"source" text:"Source"`
+	OCIReference  string   `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
+	Architectures []string `json:"architectures" yaml:"architectures" text:"Architectures"`
+}
+
+// CopyHooks provides extension points for bundle copies.
+type CopyHooks struct {
+	SourceTarget       func(ctx context.Context, ociReference string, opts *CopyOptions) (oras.Target, error)
+	DestinationTarget  func(ctx context.Context, ociReference string, opts *CopyOptions) (oras.Target, error)
+	ModifyOrasSettings func(ctx context.Context, copyOptions *oras.CopyOptions) error
+	VerifyBundle       func(ctx context.Context, src content.Fetcher, index []byte, signatures [][]byte) error
+}
+
+// copyChild is a bundle child index resolved from the copy source.
+type copyChild struct {
+	desc         ocispec.Descriptor
+	index        []byte
+	signatures   [][]byte
+	attestations []BundleAttestation
+}
+
+// CopyBundle copies the bundle at srcRef to dstRef without staging it on
+// disk: the child index, package manifests and blobs are streamed between the
+// registries, and signature evidence and attestations are republished as
+// referrers at the destination. The destination root index is merged so
+// bundles for other architectures already published at dstRef are preserved.
+//
+// When the VerifyBundle hook is set every selected child is verified before
+// anything is copied.
+func CopyBundle(ctx context.Context, srcRef, dstRef string, opts CopyOptions) (*CopyResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if srcRef == "" {
+		return nil, EmptyParameterError{Name: "srcRef"}
+	}
+	if dstRef == "" {
+		return nil, EmptyParameterError{Name: "dstRef"}
+	}
+
+	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	src, err := resolveCopyTarget(ctx, srcRef, &opts, opts.CopyHooks.SourceTarget)
+	if err != nil {
+		return nil, fmt.Errorf("resolving copy source %s: %w: %w", srcRef, ErrResolveReference, err)
+	}
+	dst, err := resolveCopyTarget(ctx, dstRef, &opts, opts.CopyHooks.DestinationTarget)
+	if err != nil {
+		return nil, fmt.Errorf("resolving copy destination %s: %w: %w", dstRef, ErrResolveReference, err)
+	}
+
+	children, err := resolveCopyChildren(ctx, src, srcRef, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pushOpts := PushOptions{
+		Config:  opts.Config,
+		Streams: opts.Streams,
+		PushHooks: PushHooks{
+			ToOrasTarget:       func(context.Context, string, *PushOptions) (oras.Target, error) { return dst, nil },
+			ModifyOrasSettings: opts.CopyHooks.ModifyOrasSettings,
+		},
+	}
+	result := &CopyResult{Source: srcRef, OCIReference: dstRef}
+	for _, child := range children {
+		arch := child.desc.Platform.Architecture
+		log.Info("copying bundle", "source", srcRef, "destination", dstRef, "arch", arch)
+		if _, err := pushBundleToRemote(ctx, src, child.desc, dstRef, &pushOpts, child.signatures, child.attestations); err != nil {
+			return nil, err
+		}
+		result.Architectures = append(result.Architectures, arch)
+	}
+	log.Info("bundle copied", "source", srcRef, "destination", dstRef, "architectures", result.Architectures)
+	return result, nil
+}
+
+// resolveCopyTarget picks an ORAS target for one end of a copy: hook if set,
+// otherwise a live registry repository.
+func resolveCopyTarget(ctx context.Context, ref string, opts *CopyOptions, hook func(context.Context, string, *CopyOptions) (oras.Target, error)) (oras.Target, error) {
+	ref = TrimScheme(ref)
+	if hook != nil {
+		return hook(ctx, ref, opts)
+	}
+	return NewRemoteRepository(ctx, ref, *opts.Config.Options)
+}
+
+// resolveCopyChildren resolves the bundle children to copy from srcRef with
+// their evidence, verifying each when the VerifyBundle hook is set.
+func resolveCopyChildren(ctx context.Context, src oras.Target, srcRef string, opts *CopyOptions) ([]copyChild, error) {
+	reference, err := ReferenceIdentifier(srcRef)
+	if err != nil {
+		return nil, err
+	}
+	var children []copyChild
+	if opts.AllArchitectures {
+		children, err = allBundleChildren(ctx, src, reference)
+	} else {
+		var child copyChild
+		child.desc, child.index, err = ResolveBundleChild(ctx, src, reference, opts.Config.Options.Architecture)
+		children = []copyChild{child}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolving bundle from %s: %w: %w", srcRef, ErrResolveReference, err)
+	}
+
+	for i := range children {
+		child := &children[i]
+		var idx ocispec.Index
+		if err := json.Unmarshal(child.index, &idx); err != nil {
+			return nil, fmt.Errorf("parsing bundle index %s: %w: %w", child.desc.Digest, ErrParseIndex, err)
+		}
+		arch := idx.Annotations[AnnotationBundleArchitecture]
+		if arch == "" {
+			return nil, fmt.Errorf("bundle %s does not record its architecture: index is missing the %s annotation: %w", child.desc.Digest, AnnotationBundleArchitecture, ErrMissingArchitecture)
+		}
+		// A digest reference resolves to a bare child descriptor; rebuild it
+		// so it can slot into the destination root index.
+		child.desc = BundleChildDescriptor(content.NewDescriptorFromBytes(ocispec.MediaTypeImageIndex, child.index), arch)
+
+		child.signatures, err = FetchBundleSignatures(ctx, src, child.desc)
+		if err != nil && (opts.CopyHooks.VerifyBundle != nil || !errors.Is(err, ErrBundleSignatureNotFound)) {
+			return nil, fmt.Errorf("fetching bundle signature evidence for %s: %w", arch, err)
+		}
+		if opts.CopyHooks.VerifyBundle != nil {
+			if err := opts.CopyHooks.VerifyBundle(ctx, src, child.index, child.signatures); err != nil {
+				return nil, fmt.Errorf("verifying bundle signature for %s: %w", arch, err)
+			}
+		}
+		child.attestations, err = FetchBundleAttestations(ctx, src, child.desc)
+		if err != nil {
+			return nil, fmt.Errorf("fetching bundle attestations for %s: %w", arch, err)
+		}
+	}
+	return children, nil
+}
+
+// allBundleChildren returns every bundle child index reachable from
+// reference: the entries of a root index, or the child it addresses directly.
+func allBundleChildren(ctx context.Context, src oras.Target, reference string) ([]copyChild, error) {
+	desc, err := src.Resolve(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("resolving %s: %w: %w", reference, ErrResolveReference, err)
+	}
+	data, err := fetchIndexBytes(ctx, src, desc)
+	if err != nil {
+		return nil, err
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, fmt.Errorf("%s does not appear to be a UDS bundle: content is not an OCI index: %w", reference, ErrInvalidBundle)
+	}
+	if idx.ArtifactType == MediaTypeBundle {
+		return []copyChild{{desc: desc, index: data}}, nil
+	}
+
+	var children []copyChild
+	for _, m := range idx.Manifests {
+		if m.MediaType != ocispec.MediaTypeImageIndex || m.ArtifactType != MediaTypeBundle || m.Platform == nil {
+			continue
+		}
+		childData, err := fetchIndexBytes(ctx, src, m)
+		if err != nil {
+			return nil, err
+		}
+		var child ocispec.Index
+		if err := json.Unmarshal(childData, &child); err != nil || child.ArtifactType != MediaTypeBundle {
+			return nil, fmt.Errorf("root index entry for %s does not reference a UDS bundle: %w", m.Platform.Architecture, ErrInvalidBundle)
+		}
+		children = append(children, copyChild{desc: m, index: childData})
+	}
+	if len(children) == 0 {
+		return nil, fmt.Errorf("%s does not appear to be a UDS bundle: index does not declare artifactType %s: %w", reference, MediaTypeBundle, ErrInvalidBundle)
+	}
+	return children, nil
+}
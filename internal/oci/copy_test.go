@@ -0,0 +1,159 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	oraci "oras.land/oras-go/v2/content/oci"
+)
+
+// copyBetween returns hooks that copy from src to dst test targets.
+func copyBetween(src, dst oras.Target) CopyHooks {
+	return CopyHooks{
+		SourceTarget:      func(context.Context, string, *CopyOptions) (oras.Target, error) { return src, nil },
+		DestinationTarget: func(context.Context, string, *CopyOptions) (oras.Target, error) { return dst, nil },
+	}
+}
+
+func newCopyStores(t *testing.T) (*oraci.Store, *oraci.Store) {
+	t.Helper()
+	src, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+	dst, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+	return src, dst
+}
+
+func TestCopyBundle_CopiesSelectedArchitectureAndEvidence(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	cfg := newTestConfig()
+	cfg.Options.Architecture = "amd64"
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "amd64"))
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "arm64"))
+	child, _, err := ResolveBundleChild(t.Context(), src, "1.0.0", "amd64")
+	require.NoError(t, err)
+	pushSignatureEvidence(t, src, child, []byte("signature"))
+
+	result, err := CopyBundle(t.Context(), "oci://dev.example.com/test/app:1.0.0", "oci://prod.example.com/test/app:1.0.0", CopyOptions{
+		Config:    cfg,
+		CopyHooks: copyBetween(src, dst),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"amd64"}, result.Architectures)
+
+	root := fetchRootIndex(t, dst, "1.0.0")
+	require.Len(t, root.Manifests, 1)
+	assert.Equal(t, child.Digest, root.Manifests[0].Digest)
+	graph, err := content.Successors(t.Context(), dst, root.Manifests[0])
+	require.NoError(t, err)
+	for _, desc := range graph {
+		exists, err := dst.Exists(t.Context(), desc)
+		require.NoError(t, err)
+		assert.True(t, exists, "copied bundle must include %s", desc.Digest)
+	}
+	signatures, err := FetchBundleSignatures(t.Context(), dst, root.Manifests[0])
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("signature")}, signatures)
+}
+
+func TestCopyBundle_PreservesOtherArchitecturesAtDestination(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	cfg := newTestConfig()
+	cfg.Options.Architecture = "amd64"
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "amd64"))
+	pushArchTestBundle(t, dst, "prod.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "arm64"))
+
+	_, err := CopyBundle(t.Context(), "dev.example.com/test/app:1.0.0", "prod.example.com/test/app:1.0.0", CopyOptions{
+		Config:    cfg,
+		CopyHooks: copyBetween(src, dst),
+	})
+	require.NoError(t, err)
+
+	root := fetchRootIndex(t, dst, "1.0.0")
+	require.Len(t, root.Manifests, 2)
+	assert.Equal(t, "amd64", root.Manifests[0].Platform.Architecture)
+	assert.Equal(t, "arm64", root.Manifests[1].Platform.Architecture)
+}
+
+func TestCopyBundle_AllArchitectures(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "amd64"))
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "arm64"))
+
+	result, err := CopyBundle(t.Context(), "dev.example.com/test/app:1.0.0", "prod.example.com/test/app:2.0.0", CopyOptions{
+		Config:           newTestConfig(),
+		AllArchitectures: true,
+		CopyHooks:        copyBetween(src, dst),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"amd64", "arm64"}, result.Architectures)
+	assert.Equal(t, fetchRootIndex(t, src, "1.0.0"), fetchRootIndex(t, dst, "2.0.0"))
+}
+
+func TestCopyBundle_VerificationFailureCopiesNothing(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "amd64"))
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", "arm64"))
+	for _, arch := range []string{"amd64", "arm64"} {
+		child, _, err := ResolveBundleChild(t.Context(), src, "1.0.0", arch)
+		require.NoError(t, err)
+		pushSignatureEvidence(t, src, child, []byte(arch))
+	}
+	errUntrusted := errors.New("untrusted signer")
+	hooks := copyBetween(src, dst)
+	hooks.VerifyBundle = func(_ context.Context, _ content.Fetcher, _ []byte, signatures [][]byte) error {
+		if string(signatures[0]) == "arm64" {
+			return errUntrusted
+		}
+		return nil
+	}
+
+	_, err := CopyBundle(t.Context(), "dev.example.com/test/app:1.0.0", "prod.example.com/test/app:1.0.0", CopyOptions{
+		Config:           newTestConfig(),
+		AllArchitectures: true,
+		CopyHooks:        hooks,
+	})
+	require.ErrorIs(t, err, errUntrusted)
+	_, err = dst.Resolve(t.Context(), "1.0.0")
+	assert.True(t, IsNotFound(err), "nothing may be copied when any bundle fails verification")
+}
+
+func TestCopyBundle_VerificationRequiresSignature(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	cfg := newTestConfig()
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", cfg.Options.Architecture))
+	hooks := copyBetween(src, dst)
+	hooks.VerifyBundle = func(context.Context, content.Fetcher, []byte, [][]byte) error { return nil }
+
+	_, err := CopyBundle(t.Context(), "dev.example.com/test/app:1.0.0", "prod.example.com/test/app:1.0.0", CopyOptions{
+		Config:    cfg,
+		CopyHooks: hooks,
+	})
+	require.ErrorIs(t, err, ErrBundleSignatureNotFound)
+}
+
+func TestCopyBundle_RequiresTagDestination(t *testing.T) {
+	t.Parallel()
+	src, dst := newCopyStores(t)
+	cfg := newTestConfig()
+	pushArchTestBundle(t, src, "dev.example.com/test/app:1.0.0", createArchTestBundle(t, "app", "1.0.0", cfg.Options.Architecture))
+
+	_, err := CopyBundle(t.Context(), "dev.example.com/test/app:1.0.0", "prod.example.com/test/app@sha256:0000000000000000000000000000000000000000000000000000000000000000", CopyOptions{
+		Config:    cfg,
+		CopyHooks: copyBetween(src, dst),
+	})
+	require.ErrorIs(t, err, ErrPushTagRequired)
+}
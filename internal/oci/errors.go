@@ -6,6 +6,7 @@ package oci
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/opencontainers/go-digest"
 	"oras.land/oras-go/v2/errdef"
@@ -38,6 +39,8 @@ var (
 	ErrTagContent                   = errors.New("tagging OCI content")
 	ErrCreateTemporaryDirectory     = errors.New("creating temporary directory")
 	ErrCreateOCIDirectory           = errors.New("creating OCI directory")
+	ErrUnsafeStagingDirectory       = errors.New("pull staging directory is not private to the current user")
+	ErrLockStagingDirectory         = errors.New("locking pull staging directory")
 	ErrCreateStore                  = errors.New("creating OCI store")
 	ErrWriteIndex                   = errors.New("writing index.json")
 	ErrRemoveDuplicateIndexBlob     = errors.New("removing duplicate index blob")
@@ -53,7 +56,11 @@ var (
 	ErrPushTagRequired              = errors.New("bundles must be pushed to a tag reference")
 	ErrCheckBundleContent           = errors.New("checking bundle content")
 	ErrBundleSignatureNotFound      = errors.New("bundle signature evidence not found")
-	ErrBundleSignatureDuplicate     = errors.New("duplicate bundle signature evidence")
+	ErrBundlePackageNotFound        = errors.New("package not found in bundle")
+	ErrReadPackageMetadata          = errors.New("reading package metadata")
+	ErrListTags                     = errors.New("listing tags")
+	ErrDeleteManifest               = errors.New("deleting manifest")
+	ErrInvalidRetention             = errors.New("invalid retention rule")
 )
 
 var (
@@ -63,6 +70,7 @@ var (
 	_ error = (*ManifestCountError)(nil)
 	_ error = (*InvalidDigestError)(nil)
 	_ error = (*ConflictingDescriptorSizeError)(nil)
+	_ error = (*ConflictingPackageReferenceError)(nil)
 )
 
 type EmptyParameterError struct{ Name string }
@@ -120,3 +128,12 @@ func (e ConflictingDescriptorSizeError) Error() string {
 func IsNotFound(err error) bool {
 	return errors.Is(err, errdef.ErrNotFound)
 }
+
+type ConflictingPackageReferenceError struct {
+	Reference string
+	Packages  []string
+}
+
+func (e ConflictingPackageReferenceError) Error() string {
+	return fmt.Sprintf("bundle packages %s are different packages with the same reference %s", strings.Join(e.Packages, " and "), e.Reference)
+}
@@ -0,0 +1,357 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/mod/semver"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry"
+	orasregistry "oras.land/oras-go/v2/registry/remote"
+)
+
+// GCTarget is a repository garbage collection can inspect and delete from.
+type GCTarget interface {
+	oras.Target
+	content.Deleter
+	content.ReadOnlyGraphStorage
+	registry.TagLister
+}
+
+var _ GCTarget = (*orasregistry.Repository)(nil)
+
+// GCOptions configures registry garbage collection.
+type GCOptions struct {
+	Config  *bundleinternal.UDSBundleConfig
+	Streams iostreams.IOStreams
+	// DryRun reports what would be deleted without deleting anything.
+	DryRun bool
+	// KeepLast, when positive, keeps only the KeepLast highest semantic
+	// version tags. Tags that are not semantic versions are always kept.
+	KeepLast int
+	GCHooks  GCHooks
+}
+
+// Validate validates garbage collection options.
+func (o GCOptions) Validate() error {
+	if err := bundleinternal.ValidateConfig(o.Config); err != nil {
+		return err
+	}
+	if o.KeepLast < 0 {
+		return fmt.Errorf("keep-last must not be negative, got %d: %w", o.KeepLast, ErrInvalidRetention)
+	}
+	return nil
+}
+
+// GCHooks provides extension points for registry garbage collection.
+type GCHooks struct {
+	ToOrasTarget func(ctx context.Context, repository string, opts *GCOptions) (GCTarget, error)
+}
+
+// GCResult describes a completed, or with DryRun planned, garbage collection.
+type GCResult struct {
+	Repository  string       `json:"repository" yaml:"repository" text:"Repository"`
+	DryRun      bool         `json:"dryRun" yaml:"dryRun" text:"Dry Run"`
+	KeptTags    []string     `json:"keptTags" yaml:"keptTags" text:"Kept Tags"`
+	RemovedTags []string     `json:"removedTags,omitempty" yaml:"removedTags,omitempty" text:"Removed Tags,omitempty"`
+	Deleted     []GCManifest `json:"deleted" yaml:"deleted" text:"Deleted"`
+}
+
+// GCManifest is a manifest removed by garbage collection.
+type GCManifest struct {
+	Digest       string `json:"digest" yaml:"digest" text:"Digest"`
+	MediaType    string `json:"mediaType" yaml:"mediaType" text:"Media Type"`
+	ArtifactType string `json:"artifactType,omitempty" yaml:"artifactType,omitempty" text:"Artifact Type,omitempty"`
+}
+
+// evidenceTagPattern matches the tags signature evidence, attestations and
+// referrers indexes are published at for registries without the referrers
+// API: sha256-<subject>, optionally with a suffix such as .att or .sbom.
+var evidenceTagPattern = regexp.MustCompile(`^sha256-([0-9a-f]{64})(\..+)?$`)
+
+// CollectGarbage deletes the manifests in repository that no kept tag
+// reaches. Reachability follows root indexes to child bundle indexes, package
+// and bundle definition manifests, and referrers such as signatures and
+// attestations; blobs are left to the registry's own garbage collection.
+//
+// The distribution API cannot list untagged manifests, so unreachable content
+// is found in two ways: the tags dropped by KeepLast, and the evidence tags
+// published for child indexes that no longer belong to a tag, as left behind
+// when a push replaces an architecture in a root index.
+func CollectGarbage(ctx context.Context, repository string, opts GCOptions) (*GCResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if repository == "" {
+		return nil, EmptyParameterError{Name: "repository"}
+	}
+	repository = TrimScheme(repository)
+	target, err := resolveGCTarget(ctx, repository, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("resolving repository %s: %w: %w", repository, ErrResolveReference, err)
+	}
+	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	var tags []string
+	if err := target.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("%w in %s: %w", ErrListTags, repository, err)
+	}
+	versionTags, evidenceTags := partitionGCTags(tags)
+	keptTags, droppedTags := retainTags(versionTags, opts.KeepLast)
+	log.Debug("listed repository tags", "repository", repository, "tags", len(versionTags), "evidence", len(evidenceTags))
+
+	walker := &gcWalker{target: target, evidence: evidenceTags}
+	reachable := newDescriptorSet()
+	for _, tag := range keptTags {
+		desc, err := target.Resolve(ctx, tag)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w: %w", tag, ErrResolveReference, err)
+		}
+		if err := walker.walk(ctx, desc, reachable, false); err != nil {
+			return nil, err
+		}
+	}
+
+	candidates := newDescriptorSet()
+	result := &GCResult{Repository: repository, DryRun: opts.DryRun, KeptTags: keptTags}
+	for _, tag := range droppedTags {
+		desc, err := target.Resolve(ctx, tag)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w: %w", tag, ErrResolveReference, err)
+		}
+		if reachable.has(desc.Digest) {
+			// Another kept tag points at the same content; deleting it would
+			// remove that tag too.
+			result.KeptTags = append(result.KeptTags, tag)
+			continue
+		}
+		result.RemovedTags = append(result.RemovedTags, tag)
+		if err := walker.walk(ctx, desc, candidates, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, subject := range sortedKeys(evidenceTags) {
+		if reachable.has(subject) {
+			continue
+		}
+		// The subject may already be gone; its evidence is unreachable either way.
+		if desc, err := target.Resolve(ctx, subject.String()); err == nil {
+			if err := walker.walk(ctx, desc, candidates, false); err != nil {
+				return nil, err
+			}
+		} else if !errors.Is(err, errdef.ErrNotFound) {
+			return nil, fmt.Errorf("resolving %s: %w: %w", subject, ErrResolveReference, err)
+		}
+		for _, tag := range evidenceTags[subject] {
+			desc, err := target.Resolve(ctx, tag)
+			if err != nil {
+				if errors.Is(err, errdef.ErrNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("resolving %s: %w: %w", tag, ErrResolveReference, err)
+			}
+			if err := walker.walk(ctx, desc, candidates, true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Referrers go first and indexes before what they reference, so an
+	// interrupted run leaves nothing pointing at a deleted manifest.
+	var deletions []ocispec.Descriptor
+	for _, desc := range candidates.ordered() {
+		if !reachable.has(desc.Digest) {
+			deletions = append(deletions, desc)
+		}
+	}
+	slices.SortStableFunc(deletions, func(a, b ocispec.Descriptor) int {
+		return candidates.rank(a.Digest) - candidates.rank(b.Digest)
+	})
+	for _, desc := range deletions {
+		result.Deleted = append(result.Deleted, GCManifest{Digest: desc.Digest.String(), MediaType: desc.MediaType, ArtifactType: desc.ArtifactType})
+		if opts.DryRun {
+			log.Info("would delete manifest", "digest", desc.Digest.String(), "mediaType", desc.MediaType)
+			continue
+		}
+		log.Info("deleting manifest", "digest", desc.Digest.String(), "mediaType", desc.MediaType)
+		if err := target.Delete(ctx, desc); err != nil && !errors.Is(err, errdef.ErrNotFound) {
+			return result, fmt.Errorf("%w %s from %s: %w", ErrDeleteManifest, desc.Digest, repository, err)
+		}
+	}
+	return result, nil
+}
+
+func resolveGCTarget(ctx context.Context, repository string, opts *GCOptions) (GCTarget, error) {
+	if opts.GCHooks.ToOrasTarget != nil {
+		return opts.GCHooks.ToOrasTarget(ctx, repository, opts)
+	}
+	trimmed := TrimScheme(repository)
+	ref, err := registry.ParseReference(trimmed)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrParseReference, repository, err)
+	}
+	if ref.Reference != "" {
+		return nil, fmt.Errorf("%s names a tag or digest; garbage collection takes a repository: %w", repository, ErrParseReference)
+	}
+	return NewRemoteRepository(ctx, trimmed, *opts.Config.Options)
+}
+
+// partitionGCTags separates evidence tags, keyed by subject digest, from
+// the tags bundles are published at.
+func partitionGCTags(tags []string) ([]string, map[godigest.Digest][]string) {
+	var versions []string
+	evidence := make(map[godigest.Digest][]string)
+	for _, tag := range tags {
+		if m := evidenceTagPattern.FindStringSubmatch(tag); m != nil {
+			subject := godigest.NewDigestFromEncoded(godigest.SHA256, m[1])
+			evidence[subject] = append(evidence[subject], tag)
+			continue
+		}
+		versions = append(versions, tag)
+	}
+	return versions, evidence
+}
+
+// retainTags splits tags into those kept and dropped when keeping the
+// keepLast highest semantic versions. Tags that are not semantic versions are
+// always kept; a keepLast of zero keeps everything.
+func retainTags(tags []string, keepLast int) ([]string, []string) {
+	var versions, kept, dropped []string
+	for _, tag := range tags {
+		if keepLast > 0 && semver.IsValid(semverTag(tag)) {
+			versions = append(versions, tag)
+		} else {
+			kept = append(kept, tag)
+		}
+	}
+	slices.SortStableFunc(versions, func(a, b string) int { return semver.Compare(semverTag(b), semverTag(a)) })
+	for i, tag := range versions {
+		if i < keepLast {
+			kept = append(kept, tag)
+		} else {
+			dropped = append(dropped, tag)
+		}
+	}
+	return kept, dropped
+}
+
+// semverTag returns tag in the v-prefixed form golang.org/x/mod/semver
+// expects. OCI tags cannot contain "+", so build metadata is written as "_".
+func semverTag(tag string) string {
+	return "v" + strings.ReplaceAll(strings.TrimPrefix(tag, "v"), "_", "+")
+}
+
+// gcWalker records the manifests reachable from a descriptor.
+type gcWalker struct {
+	target   GCTarget
+	evidence map[godigest.Digest][]string
+}
+
+// walk adds desc, the manifests it references and their referrers to set;
+// referrer marks desc as a referrer of another manifest. Blobs are not
+// recorded; deleting manifests is all the distribution API allows.
+func (w *gcWalker) walk(ctx context.Context, desc ocispec.Descriptor, set *descriptorSet, referrer bool) error {
+	isIndex := desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == mediaTypeDockerManifestList
+	if set.has(desc.Digest) || (!isIndex && !IsImageManifestMediaType(desc.MediaType)) {
+		return nil
+	}
+	if isIndex {
+		data, err := FetchBytes(ctx, w.target, desc)
+		if err != nil {
+			return fmt.Errorf("fetching index %s: %w: %w", desc.Digest, ErrFetchContent, err)
+		}
+		var idx ocispec.Index
+		if err := json.Unmarshal(data, &idx); err != nil {
+			return fmt.Errorf("parsing index %s: %w: %w", desc.Digest, ErrParseIndex, err)
+		}
+		if desc.ArtifactType == "" {
+			desc.ArtifactType = idx.ArtifactType
+		}
+		set.add(desc, referrer)
+		for _, m := range idx.Manifests {
+			if err := w.walk(ctx, m, set, referrer); err != nil {
+				return err
+			}
+		}
+	} else {
+		set.add(desc, referrer)
+	}
+
+	// Referrers are found through the referrers API where the registry has
+	// one, and through evidence tags where it does not. A lookup that fails
+	// only hides referrers from this walk, which never causes a deletion.
+	referrers, _ := registry.Referrers(ctx, w.target, desc, "")
+	for _, tag := range w.evidence[desc.Digest] {
+		if ref, err := w.target.Resolve(ctx, tag); err == nil {
+			referrers = append(referrers, ref)
+		}
+	}
+	for _, ref := range referrers {
+		if err := w.walk(ctx, ref, set, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// descriptorSet is an insertion-ordered set of descriptors keyed by digest,
+// each ranked for deletion: referrers, then indexes, then manifests.
+type descriptorSet struct {
+	order []ocispec.Descriptor
+	ranks map[godigest.Digest]int
+}
+
+func newDescriptorSet() *descriptorSet {
+	return &descriptorSet{ranks: make(map[godigest.Digest]int)}
+}
+
+func (s *descriptorSet) add(desc ocispec.Descriptor, referrer bool) {
+	if _, ok := s.ranks[desc.Digest]; ok {
+		return
+	}
+	switch {
+	case referrer:
+		s.ranks[desc.Digest] = 0
+	case desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == mediaTypeDockerManifestList:
+		s.ranks[desc.Digest] = 1
+	default:
+		s.ranks[desc.Digest] = 2
+	}
+	s.order = append(s.order, desc)
+}
+
+func (s *descriptorSet) has(d godigest.Digest) bool {
+	_, ok := s.ranks[d]
+	return ok
+}
+
+func (s *descriptorSet) rank(d godigest.Digest) int { return s.ranks[d] }
+
+func (s *descriptorSet) ordered() []ocispec.Descriptor { return s.order }
+
+func sortedKeys(m map[godigest.Digest][]string) []godigest.Digest {
+	keys := make([]godigest.Digest, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/errdef"
+	orasregistry "oras.land/oras-go/v2/registry/remote"
+)
+
+// newGCRepository starts an in-memory registry and returns a repository in it.
+func newGCRepository(t *testing.T) (*orasregistry.Repository, string) {
+	t.Helper()
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	repository := strings.TrimPrefix(server.URL, "http://") + "/test/app"
+	repo, err := NewRemoteRepository(t.Context(), repository, bundleinternal.ConfigOptions{PlainHTTP: true})
+	require.NoError(t, err)
+	return repo, repository
+}
+
+func gcOptions(repo *orasregistry.Repository, dryRun bool, keepLast int) GCOptions {
+	return GCOptions{
+		Config:   newTestConfig(),
+		DryRun:   dryRun,
+		KeepLast: keepLast,
+		GCHooks: GCHooks{ToOrasTarget: func(_ context.Context, _ string, _ *GCOptions) (GCTarget, error) {
+			return repo, nil
+		}},
+	}
+}
+
+func deletedDigests(result *GCResult) []string {
+	digests := make([]string, 0, len(result.Deleted))
+	for _, m := range result.Deleted {
+		digests = append(digests, m.Digest)
+	}
+	return digests
+}
+
+func TestCollectGarbage_RemovesReplacedArchitectureAndItsEvidence(t *testing.T) {
+	t.Parallel()
+	repo, repository := newGCRepository(t)
+	arch := runtime.GOARCH
+
+	pushArchTestBundle(t, repo, repository+":1.0.0", createArchTestBundle(t, "app", "1.0.0", arch))
+	replaced := fetchRootIndex(t, repo, "1.0.0").Manifests[0]
+	require.NoError(t, PublishBundleSignature(t.Context(), repo, replaced, []byte("old-signature"), false))
+	replacedSignature, err := repo.Resolve(t.Context(), legacySignatureTag(replaced))
+	require.NoError(t, err)
+
+	pushArchTestBundle(t, repo, repository+":1.0.0", createArchTestBundle(t, "app", "1.0.1", arch))
+	current := fetchRootIndex(t, repo, "1.0.0").Manifests[0]
+	require.NotEqual(t, replaced.Digest, current.Digest)
+	require.NoError(t, PublishBundleSignature(t.Context(), repo, current, []byte("new-signature"), false))
+
+	planned, err := CollectGarbage(t.Context(), repository, gcOptions(repo, true, 0))
+	require.NoError(t, err)
+	assert.True(t, planned.DryRun)
+	assert.Contains(t, deletedDigests(planned), replaced.Digest.String())
+	assert.Contains(t, deletedDigests(planned), replacedSignature.Digest.String())
+	assert.NotContains(t, deletedDigests(planned), current.Digest.String())
+	_, err = repo.Resolve(t.Context(), replaced.Digest.String())
+	require.NoError(t, err, "a dry run must not delete anything")
+
+	result, err := CollectGarbage(t.Context(), repository, gcOptions(repo, false, 0))
+	require.NoError(t, err)
+	assert.Equal(t, deletedDigests(planned), deletedDigests(result))
+	assert.Equal(t, []string{"1.0.0"}, result.KeptTags)
+	_, err = repo.Resolve(t.Context(), replaced.Digest.String())
+	require.ErrorIs(t, err, errdef.ErrNotFound)
+	_, err = repo.Resolve(t.Context(), replacedSignature.Digest.String())
+	require.ErrorIs(t, err, errdef.ErrNotFound)
+
+	signatures, err := FetchBundleSignatures(t.Context(), repo, current)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("new-signature")}, signatures)
+	_, _, err = ResolveBundleChild(t.Context(), repo, "1.0.0", arch)
+	require.NoError(t, err)
+}
+
+func TestCollectGarbage_KeepsLastVersions(t *testing.T) {
+	t.Parallel()
+	repo, repository := newGCRepository(t)
+	arch := runtime.GOARCH
+
+	for _, version := range []string{"1.0.0", "1.10.0", "1.9.0"} {
+		pushArchTestBundle(t, repo, repository+":"+version, createArchTestBundle(t, "app", version, arch))
+	}
+	pushArchTestBundle(t, repo, repository+":dev", createArchTestBundle(t, "app", "dev", arch))
+	dropped := fetchRootIndex(t, repo, "1.0.0")
+	droppedRoot, err := repo.Resolve(t.Context(), "1.0.0")
+	require.NoError(t, err)
+
+	result, err := CollectGarbage(t.Context(), repository, gcOptions(repo, false, 2))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"dev", "1.10.0", "1.9.0"}, result.KeptTags)
+	assert.Equal(t, []string{"1.0.0"}, result.RemovedTags)
+	assert.Contains(t, deletedDigests(result), droppedRoot.Digest.String())
+	assert.Contains(t, deletedDigests(result), dropped.Manifests[0].Digest.String())
+
+	_, err = repo.Resolve(t.Context(), dropped.Manifests[0].Digest.String())
+	require.ErrorIs(t, err, errdef.ErrNotFound)
+	for _, tag := range []string{"dev", "1.10.0", "1.9.0"} {
+		_, _, err := ResolveBundleChild(t.Context(), repo, tag, arch)
+		require.NoError(t, err, tag)
+	}
+}
+
+func TestRetainTags(t *testing.T) {
+	t.Parallel()
+
+	kept, dropped := retainTags([]string{"1.0.0", "latest", "v2.0.0", "1.2.0-rc.1", "1.2.0"}, 2)
+	assert.Equal(t, []string{"latest", "v2.0.0", "1.2.0"}, kept)
+	assert.Equal(t, []string{"1.2.0-rc.1", "1.0.0"}, dropped)
+
+	kept, dropped = retainTags([]string{"1.0.0", "2.0.0"}, 0)
+	assert.Equal(t, []string{"1.0.0", "2.0.0"}, kept)
+	assert.Empty(t, dropped)
+}
@@ -17,6 +17,7 @@ import (
 
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/mholt/archives"
 	godigest "github.com/opencontainers/go-digest"
@@ -27,6 +28,21 @@ import (
 	oras "oras.land/oras-go/v2"
 )
 
+// TestMain points the UDS home at a temporary directory so pulls staged under
+// it never touch the real one.
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "uds-home-")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Setenv(logger.HomeEnv, home); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(home)
+	os.Exit(code)
+}
+
 // CreateOptions contains inputs for the test bundle creator.
 type CreateOptions struct {
 	Config     *bundleinternal.UDSBundleConfig
@@ -4,19 +4,26 @@
 package oci
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/filesystem"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/gofrs/flock"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	oraci "oras.land/oras-go/v2/content/oci"
 )
 
@@ -24,6 +31,9 @@ import (
 type Puller interface {
 	// PullBundle pulls a bundle from the given OCI reference and writes it to targetDir.
 	PullBundle(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error)
+	// PullBundleLayout pulls the bundle definition and the packages selected by
+	// opts.Packages from the given OCI reference into an OCI layout under targetDir.
+	PullBundleLayout(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error)
 	// PullPackage pulls a single Zarf package from the given OCI reference to targetDir.
 	PullPackage(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error)
 }
@@ -33,7 +43,10 @@ type PullOptions struct {
 	Config                    *bundleinternal.UDSBundleConfig
 	Streams                   iostreams.IOStreams
 	SkipSignatureVerification bool
-	PullHooks                 PullHooks
+	// Packages restricts PullBundleLayout to the named bundle packages; empty
+	// selects every package. PullBundle always pulls every package.
+	Packages  []string
+	PullHooks PullHooks
 }
 
 // Validate validates pull options.
@@ -49,7 +62,7 @@ type PullResult struct {
 type PullHooks struct {
 	ToOrasTarget        func(ctx context.Context, ociReference string, opts *PullOptions) (oras.Target, error)
 	ModifyOrasSettings  func(ctx context.Context, copyOptions *oras.CopyOptions) error
-	VerifyBundle        func(ctx context.Context, index, evidence []byte) error
+	VerifyBundle        func(ctx context.Context, src content.Fetcher, index []byte, signatures [][]byte) error
 	CreateBundleArchive func(ctx context.Context, streams iostreams.IOStreams, ociDir, targetDir string, idx ocispec.Index, arch string) (string, error)
 }
 
@@ -68,7 +81,13 @@ func NewDefaultPuller() Puller { return &defaultPuller{} }
 // referenced blobs from the remote registry into a local OCI layout, then reconstructs index.json
 // from the fetched root descriptor so the layout is identical to what Create
 // produces. The resulting tarball can be pushed without modification.
-func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error) {
+//
+// The layout is staged in a private directory under the UDS home named after
+// the bundle digest, locked for the whole pull, and kept when the pull fails, so pulling the same bundle again skips
+// blobs already downloaded and verified and resumes partially downloaded blobs
+// with range requests. The staging directory is removed once the archive is
+// written.
+func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir string, opts PullOptions) (retResult *PullResult, retErr error) {
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
@@ -80,22 +99,24 @@ func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir
 	}
 
 	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
-	tmp, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-pull-*")
+	src, childDesc, idxBytes, signatures, err := resolveVerifiedBundle(ctx, ociReference, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	staging, err := openPullStaging(ctx, log, childDesc)
 	if err != nil {
-		return nil, fmt.Errorf("creating temp dir: %w: %w", ErrCreateTemporaryDirectory, err)
+		return nil, err
 	}
 	defer func() {
-		err = os.RemoveAll(tmp)
-		if err != nil {
-			log.Warn("failed to remove temporary directory", "path", tmp, "error", err)
+		if retErr != nil {
+			log.Info("keeping partial pull to resume on the next attempt", "path", staging.dir)
 		}
+		staging.release(log, retErr == nil)
 	}()
+	tmp := staging.layout
 
 	ociDir := filepath.Join(tmp, "oci")
-	if err := os.MkdirAll(ociDir, filesystem.PrivateDirectoryMode); err != nil {
-		return nil, fmt.Errorf("creating OCI dir: %w: %w", ErrCreateOCIDirectory, err)
-	}
-
 	// oraci.New writes oci-layout and initialises blobs/sha256/.
 	store, err := oraci.New(ociDir)
 	if err != nil {
@@ -104,33 +125,6 @@ func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir
 	// We write index.json ourselves below; prevent ORAS from clobbering it.
 	store.AutoSaveIndex = false
 
-	src, err := resolvePullTarget(ctx, ociReference, &opts)
-	if err != nil {
-		return nil, fmt.Errorf("resolving pull source %s: %w: %w", ociReference, ErrResolveReference, err)
-	}
-	reference, err := ReferenceIdentifier(ociReference)
-	if err != nil {
-		return nil, err
-	}
-
-	// Resolve to the canonical single-arch bundle (child) index: a tag resolves
-	// to the root index and is platform-selected for the requested architecture;
-	// a digest-pinned reference addresses a child directly (ADR-0015).
-	childDesc, idxBytes, err := ResolveBundleChild(ctx, src, reference, opts.Config.Options.Architecture)
-	if err != nil {
-		return nil, fmt.Errorf("resolving bundle from %s: %w: %w", ociReference, ErrResolveReference, err)
-	}
-	var signature []byte
-	if opts.PullHooks.VerifyBundle != nil {
-		signature, err = FetchBundleSignature(ctx, src, childDesc)
-		if err != nil {
-			return nil, fmt.Errorf("fetching bundle signature evidence: %w", err)
-		}
-		if err := opts.PullHooks.VerifyBundle(ctx, idxBytes, signature); err != nil {
-			return nil, fmt.Errorf("verifying bundle signature: %w", err)
-		}
-	}
-
 	// Copy only the selected architecture's graph — never sibling architectures.
 	copyOpts, err := pullCopyOptions(ctx, &opts)
 	if err != nil {
@@ -138,15 +132,19 @@ func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir
 	}
 	log.Info("pulling bundle content", "ref", ociReference)
 	log.Debug("copying bundle from registry", "ref", ociReference, "digest", childDesc.Digest.String())
-	if err := copyGraph(ctx, src, store, childDesc, copyOpts.CopyGraphOptions); err != nil {
+	resumable := newResumableSource(src, filepath.Join(tmp, "partial"), opts.Config.Options.ChunkConcurrency, log)
+	if err := copyGraph(ctx, resumable, stagingStore{store}, childDesc, copyOpts.CopyGraphOptions); err != nil {
 		return nil, fmt.Errorf("pulling bundle from %s: %w: %w", ociReference, ErrPullContent, err)
 	}
-	if signature == nil {
-		signature, err = FetchBundleSignature(ctx, src, childDesc)
+	if err := os.RemoveAll(filepath.Join(tmp, "partial")); err != nil {
+		return nil, fmt.Errorf("removing partial downloads: %w", err)
+	}
+	if signatures == nil {
+		signatures, err = FetchBundleSignatures(ctx, src, childDesc)
 	}
 	if err == nil {
-		if err := os.WriteFile(filepath.Join(tmp, BundleSignatureFileName), signature, filesystem.PrivateFileMode); err != nil {
-			return nil, fmt.Errorf("writing bundle signature evidence: %w", err)
+		if err := WriteBundleSignatures(tmp, signatures); err != nil {
+			return nil, err
 		}
 	} else if errors.Is(err, ErrBundleSignatureNotFound) {
 		log.Debug("bundle signature evidence unavailable", "error", err)
@@ -156,6 +154,14 @@ func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir
 		return nil, fmt.Errorf("fetching bundle signature evidence: %w", err)
 	}
 
+	attestations, err := FetchBundleAttestations(ctx, src, childDesc)
+	if err != nil {
+		return nil, fmt.Errorf("fetching bundle attestations: %w", err)
+	}
+	if err := WriteBundleAttestations(tmp, attestations); err != nil {
+		return nil, err
+	}
+
 	// Write the child index bytes verbatim as index.json to restore the layout
 	// format produced by Create (round-trips byte-identically).
 	indexPath := filepath.Join(ociDir, "index.json")
@@ -198,6 +204,313 @@ func (p *defaultPuller) PullBundle(ctx context.Context, ociReference, targetDir
 	}, nil
 }
 
+// PullBundleLayout pulls a UDS bundle from an OCI registry into an OCI layout
+// directory at <targetDir>/oci without creating an archive. Only the bundle
+// definition and the package manifests selected by opts.Packages are copied,
+// so unselected package layers are never downloaded. index.json holds the
+// child index bytes verbatim, as in a pulled archive, and still lists every
+// package; callers must only load the selected ones.
+//
+// Content is digest-verified as it is copied. The bundle is verified with
+// opts.PullHooks.VerifyBundle before any content is copied.
+func (p *defaultPuller) PullBundleLayout(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if ociReference == "" {
+		return nil, EmptyParameterError{Name: "ociReference"}
+	}
+	if targetDir == "" {
+		return nil, EmptyParameterError{Name: "targetDir"}
+	}
+
+	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	src, _, idxBytes, _, err := resolveVerifiedBundle(ctx, ociReference, &opts)
+	if err != nil {
+		return nil, err
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(idxBytes, &idx); err != nil {
+		return nil, fmt.Errorf("parsing bundle index: %w: %w", ErrParseIndex, err)
+	}
+	if !IsBundleIndex(idx) {
+		return nil, fmt.Errorf("%s: %w", ociReference, ErrInvalidBundle)
+	}
+	selected, err := selectBundleManifests(idx, opts.Packages)
+	if err != nil {
+		return nil, fmt.Errorf("selecting packages from %s: %w", ociReference, err)
+	}
+
+	ociDir := filepath.Join(targetDir, "oci")
+	if err := os.MkdirAll(ociDir, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("creating OCI dir: %w: %w", ErrCreateOCIDirectory, err)
+	}
+	store, err := oraci.New(ociDir)
+	if err != nil {
+		return nil, fmt.Errorf("creating OCI store: %w: %w", ErrCreateStore, err)
+	}
+	store.AutoSaveIndex = false
+
+	copyOpts, err := pullCopyOptions(ctx, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("configuring pull: %w: %w", ErrConfigureTransfer, err)
+	}
+	log.Info("pulling bundle content", "ref", ociReference, "manifests", len(selected))
+	partialDir := filepath.Join(targetDir, "partial")
+	resumable := newResumableSource(src, partialDir, opts.Config.Options.ChunkConcurrency, log)
+	for _, desc := range selected {
+		log.Debug("copying bundle manifest from registry", "digest", desc.Digest.String(), "package", desc.Annotations[AnnotationPackageName])
+		if err := copyGraph(ctx, resumable, store, desc, copyOpts.CopyGraphOptions); err != nil {
+			return nil, fmt.Errorf("pulling bundle from %s: %w: %w", ociReference, ErrPullContent, err)
+		}
+	}
+	if err := os.RemoveAll(partialDir); err != nil {
+		return nil, fmt.Errorf("removing partial downloads: %w", err)
+	}
+
+	indexPath := filepath.Join(ociDir, "index.json")
+	if err := os.WriteFile(indexPath, idxBytes, filesystem.PrivateFileMode); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrWriteIndex, indexPath, err)
+	}
+
+	log.Debug("bundle layout pulled", "output", ociDir)
+	return &PullResult{OCIReference: ociReference, OutputPath: ociDir}, nil
+}
+
+// pullStagingDir is the directory under the UDS home that holds resumable
+// pulls, one subdirectory per bundle digest.
+const pullStagingDir = "pulls"
+
+// pullLockFile is held for the whole pull so concurrent pulls of the same
+// bundle do not write into each other's staging directory.
+const pullLockFile = "pull.lock"
+
+// pullStaging is a locked staging directory for one bundle pull. The bundle
+// layout is staged in layout, beside the lock file, so the lock never ends up
+// in the archive.
+type pullStaging struct {
+	dir    string
+	layout string
+	lock   *flock.Flock
+}
+
+// openPullStaging locks the staging directory for child under the UDS home,
+// waiting for any other pull of the same bundle to finish. An existing
+// staging directory from an earlier failed pull is kept so the pull resumes;
+// blobs that no longer match their digest are removed first.
+func openPullStaging(ctx context.Context, log iostreams.IOStreams, child ocispec.Descriptor) (*pullStaging, error) {
+	if err := child.Digest.Validate(); err != nil {
+		return nil, InvalidDigestError{Digest: child.Digest.String(), Err: err}
+	}
+	home, err := logger.Home()
+	if err != nil {
+		return nil, fmt.Errorf("locating UDS home: %w: %w", ErrCreateTemporaryDirectory, err)
+	}
+	root := filepath.Join(home, pullStagingDir)
+	if err := os.MkdirAll(root, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("creating pull staging directory: %w: %w", ErrCreateTemporaryDirectory, err)
+	}
+	if err := checkStagingDir(root); err != nil {
+		return nil, err
+	}
+	dir := filepath.Join(root, child.Digest.Encoded())
+	for {
+		if err := os.Mkdir(dir, filesystem.PrivateDirectoryMode); err != nil && !errors.Is(err, fs.ErrExist) {
+			return nil, fmt.Errorf("creating pull staging directory: %w: %w", ErrCreateTemporaryDirectory, err)
+		}
+		if err := checkStagingDir(dir); err != nil {
+			return nil, err
+		}
+		lock, err := lockStagingDir(ctx, log, dir)
+		if err != nil {
+			return nil, err
+		}
+		if lock == nil {
+			// The pull we waited for finished and removed the directory.
+			continue
+		}
+		staging := &pullStaging{dir: dir, layout: filepath.Join(dir, "bundle"), lock: lock}
+		if err := staging.prepare(ctx, log); err != nil {
+			staging.release(log, false)
+			return nil, err
+		}
+		return staging, nil
+	}
+}
+
+// lockStagingDir locks the lock file in dir. It returns nil when the lock was
+// acquired on a file that another pull has since removed, in which case the
+// caller recreates the directory and tries again.
+func lockStagingDir(ctx context.Context, log iostreams.IOStreams, dir string) (*flock.Flock, error) {
+	lock := flock.New(filepath.Join(dir, pullLockFile), flock.SetPermissions(filesystem.PrivateFileMode))
+	locked, err := lock.TryLock()
+	if err == nil && !locked {
+		log.Info("waiting for another pull of this bundle to finish", "path", dir)
+		locked, err = lock.TryLockContext(ctx, time.Second)
+	}
+	if err != nil || !locked {
+		_ = lock.Close()
+		return nil, fmt.Errorf("locking %q: %w: %w", lock.Path(), ErrLockStagingDirectory, cmp.Or(err, ctx.Err()))
+	}
+	held, err := lock.Stat()
+	if err != nil {
+		_ = lock.Unlock()
+		return nil, fmt.Errorf("locking %q: %w: %w", lock.Path(), ErrLockStagingDirectory, err)
+	}
+	current, err := os.Stat(lock.Path())
+	if err != nil || !os.SameFile(held, current) {
+		_ = lock.Unlock()
+		return nil, nil
+	}
+	return lock, nil
+}
+
+// prepare readies a locked staging directory for a pull. Only blobs and
+// partial downloads carry over from an earlier attempt; evidence and
+// index.json are rewritten by this pull.
+func (s *pullStaging) prepare(ctx context.Context, log iostreams.IOStreams) error {
+	ociDir := filepath.Join(s.layout, "oci")
+	if _, err := os.Stat(ociDir); os.IsNotExist(err) {
+		if err := os.MkdirAll(ociDir, filesystem.PrivateDirectoryMode); err != nil {
+			return fmt.Errorf("creating OCI dir: %w: %w", ErrCreateOCIDirectory, err)
+		}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("checking staged pull %q: %w: %w", s.dir, ErrCreateTemporaryDirectory, err)
+	}
+	if err := removeStaleStagingFiles(s.layout); err != nil {
+		return err
+	}
+	kept, err := removeCorruptBlobs(ctx, ociDir)
+	if err != nil {
+		return fmt.Errorf("verifying staged pull %q: %w", s.dir, err)
+	}
+	log.Info("resuming bundle pull", "path", s.dir, "blobs", kept)
+	return nil
+}
+
+// release unlocks the staging directory, first removing its content when
+// remove is set. The lock file is removed while still held so a waiting pull
+// notices and starts over in a fresh directory.
+func (s *pullStaging) release(log iostreams.IOStreams, remove bool) {
+	if remove {
+		if err := os.RemoveAll(s.layout); err != nil {
+			log.Warn("failed to remove pull staging directory", "path", s.dir, "error", err)
+		}
+		// Windows refuses to remove the open lock file; the empty directory
+		// is then left for the next pull of this bundle.
+		_ = os.Remove(s.lock.Path())
+	}
+	if err := s.lock.Unlock(); err != nil {
+		log.Warn("failed to unlock pull staging directory", "path", s.dir, "error", err)
+	}
+	if remove {
+		_ = os.Remove(s.dir)
+	}
+}
+
+// checkStagingDir refuses a staging directory that is a symlink or that
+// other users could write to.
+func checkStagingDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return fmt.Errorf("checking pull staging directory %q: %w: %w", dir, ErrCreateTemporaryDirectory, err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%w: %q is not a directory", ErrUnsafeStagingDirectory, dir)
+	}
+	return checkStagingDirOwner(dir, info)
+}
+
+// removeStaleStagingFiles removes everything in a staged pull except the
+// OCI blobs and partial downloads.
+func removeStaleStagingFiles(dir string) error {
+	for _, stale := range []string{dir, filepath.Join(dir, "oci")} {
+		entries, err := os.ReadDir(stale)
+		if err != nil {
+			return fmt.Errorf("reading staged pull %q: %w", stale, err)
+		}
+		for _, entry := range entries {
+			switch entry.Name() {
+			case "oci", "partial", ocispec.ImageBlobsDir, ocispec.ImageLayoutFile:
+				continue
+			}
+			if err := os.RemoveAll(filepath.Join(stale, entry.Name())); err != nil {
+				return fmt.Errorf("removing stale staged file: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveVerifiedBundle resolves ociReference to its single-arch bundle
+// (child) index and runs opts.PullHooks.VerifyBundle on it when set. The
+// signatures are returned only when they were fetched for verification.
+func resolveVerifiedBundle(ctx context.Context, ociReference string, opts *PullOptions) (oras.Target, ocispec.Descriptor, []byte, [][]byte, error) {
+	src, err := resolvePullTarget(ctx, ociReference, opts)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, nil, fmt.Errorf("resolving pull source %s: %w: %w", ociReference, ErrResolveReference, err)
+	}
+	reference, err := ReferenceIdentifier(ociReference)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, nil, err
+	}
+
+	// Resolve to the canonical single-arch bundle (child) index: a tag resolves
+	// to the root index and is platform-selected for the requested architecture;
+	// a digest-pinned reference addresses a child directly (ADR-0015).
+	childDesc, idxBytes, err := ResolveBundleChild(ctx, src, reference, opts.Config.Options.Architecture)
+	if err != nil {
+		return nil, ocispec.Descriptor{}, nil, nil, fmt.Errorf("resolving bundle from %s: %w: %w", ociReference, ErrResolveReference, err)
+	}
+	var signatures [][]byte
+	if opts.PullHooks.VerifyBundle != nil {
+		signatures, err = FetchBundleSignatures(ctx, src, childDesc)
+		if err != nil {
+			return nil, ocispec.Descriptor{}, nil, nil, fmt.Errorf("fetching bundle signature evidence: %w", err)
+		}
+		if err := opts.PullHooks.VerifyBundle(ctx, src, idxBytes, signatures); err != nil {
+			return nil, ocispec.Descriptor{}, nil, nil, fmt.Errorf("verifying bundle signature: %w", err)
+		}
+	}
+	return src, childDesc, idxBytes, signatures, nil
+}
+
+// selectBundleManifests returns the bundle definition manifest followed by the
+// package manifests named in packages, or every package when packages is empty.
+func selectBundleManifests(idx ocispec.Index, packages []string) ([]ocispec.Descriptor, error) {
+	def, defIdx, err := FindBundleDefinition(idx)
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[string]bool, len(packages))
+	for _, name := range packages {
+		wanted[name] = true
+	}
+	selected := []ocispec.Descriptor{def}
+	for i, desc := range idx.Manifests {
+		if i == defIdx {
+			continue
+		}
+		name := desc.Annotations[AnnotationPackageName]
+		if len(packages) != 0 && !wanted[name] {
+			continue
+		}
+		delete(wanted, name)
+		selected = append(selected, desc)
+	}
+	if len(wanted) != 0 {
+		missing := make([]string, 0, len(wanted))
+		for name := range wanted {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("%w: %s", ErrBundlePackageNotFound, strings.Join(missing, ", "))
+	}
+	return selected, nil
+}
+
 // PullPackage pulls a single Zarf package from an OCI registry into an OCI layout
 // directory at <targetDir>/oci. The layout is left on disk for cross-mount use.
 func (p *defaultPuller) PullPackage(ctx context.Context, ociReference, targetDir string, opts PullOptions) (*PullResult, error) {
@@ -0,0 +1,25 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+//go:build !windows
+
+package oci
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"syscall"
+)
+
+// checkStagingDirOwner refuses a staging directory owned by another user or
+// readable or writable by anyone but its owner.
+func checkStagingDirOwner(dir string, info fs.FileInfo) error {
+	if stat, ok := info.Sys().(*syscall.Stat_t); ok && int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("%w: %q is owned by uid %d", ErrUnsafeStagingDirectory, dir, stat.Uid)
+	}
+	if perm := info.Mode().Perm(); perm&0o077 != 0 {
+		return fmt.Errorf("%w: %q has mode %s", ErrUnsafeStagingDirectory, dir, perm)
+	}
+	return nil
+}
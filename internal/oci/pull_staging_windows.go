@@ -0,0 +1,12 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import "io/fs"
+
+// checkStagingDirOwner accepts any directory on Windows, where access to the
+// UDS home is governed by its ACL rather than by mode bits.
+func checkStagingDirOwner(string, fs.FileInfo) error {
+	return nil
+}
@@ -7,12 +7,16 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	godigest "github.com/opencontainers/go-digest"
 	"github.com/opencontainers/image-spec/specs-go"
@@ -22,6 +26,7 @@ import (
 	oras "oras.land/oras-go/v2"
 	"oras.land/oras-go/v2/content"
 	oraci "oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/errdef"
 )
 
 // pullFrom returns PullHooks that inject src as the pull source via the
@@ -391,13 +396,13 @@ func TestPull_RoundTripsChildIndexBytes(t *testing.T) {
 		"pulled bundle index must round-trip byte-identically")
 }
 
-func TestPull_RejectsDuplicateSignatureEvidence(t *testing.T) {
+func TestPull_WritesEverySignature(t *testing.T) {
 	t.Parallel()
 
 	store, err := oraci.New(t.TempDir())
 	require.NoError(t, err)
-	ref := "example.com/test/duplicate-signature:1.0.0"
-	pushArchTestBundle(t, store, ref, createArchTestBundle(t, "duplicate-signature", "1.0.0", runtime.GOARCH))
+	ref := "example.com/test/countersigned:1.0.0"
+	pushArchTestBundle(t, store, ref, createArchTestBundle(t, "countersigned", "1.0.0", runtime.GOARCH))
 
 	child, _, err := ResolveBundleChild(t.Context(), store, "1.0.0", runtime.GOARCH)
 	require.NoError(t, err)
@@ -406,10 +411,128 @@ func TestPull_RejectsDuplicateSignatureEvidence(t *testing.T) {
 
 	cfg := newTestConfig()
 	cfg.Options.TmpDir = t.TempDir()
-	_, err = Pull(t.Context(), ref, t.TempDir(), PullOptions{
+	result, err := Pull(t.Context(), ref, t.TempDir(), PullOptions{
 		Config:    cfg,
 		PullHooks: pullFrom(store),
 	})
-	require.ErrorContains(t, err, "fetching bundle signature evidence")
-	require.ErrorContains(t, err, "expected exactly one bundle signature artifact, found 2")
+	require.NoError(t, err)
+	entries := readTarZstEntries(t, result.OutputPath)
+	assert.ElementsMatch(t, [][]byte{[]byte("first"), []byte("second")},
+		[][]byte{entries[BundleSignatureFileName], entries[BundleSignatureFileNameAt(1)]})
+}
+
+// flakyFetchTarget fails the first fetch of a non-manifest blob while fail
+// is set, after giving the rest of the graph time to be copied, and records
+// the digest of every fetch.
+type flakyFetchTarget struct {
+	*oraci.Store
+	fail bool
+
+	mu      sync.Mutex
+	failed  bool
+	fetched []godigest.Digest
+}
+
+func (f *flakyFetchTarget) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	f.mu.Lock()
+	f.fetched = append(f.fetched, desc.Digest)
+	failNow := f.fail && !f.failed && !IsImageManifestMediaType(desc.MediaType) && desc.MediaType != ocispec.MediaTypeImageIndex
+	if failNow {
+		f.failed = true
+	}
+	f.mu.Unlock()
+	if failNow {
+		time.Sleep(100 * time.Millisecond)
+		return nil, fmt.Errorf("fetching %s: %w", desc.Digest, errdef.ErrNotFound)
+	}
+	return f.Store.Fetch(ctx, desc)
+}
+
+func TestPull_ResumesFromStagedLayout(t *testing.T) {
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "localpkg"))
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "resume"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+  signature_verification { verify = false }
+}
+`), filesystem.PrivateFileMode))
+	tarball, err := Create(t.Context(), CreateOptions{Config: newTestConfig(), BundleFile: bundleFile, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+	store, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+	pushCfg := newTestConfig()
+	pushCfg.Options.TmpDir = t.TempDir()
+	const ref = "example.com/test/resume:1.0.0"
+	_, err = Push(t.Context(), tarball.OutputPath, ref, PushOptions{Config: pushCfg, PushHooks: pushTo(store)})
+	require.NoError(t, err)
+
+	home := t.TempDir()
+	t.Setenv(logger.HomeEnv, home)
+	src := &flakyFetchTarget{Store: store, fail: true}
+	pullCfg := newTestConfig()
+	pullCfg.Options.TmpDir = t.TempDir()
+	_, err = Pull(t.Context(), ref, t.TempDir(), PullOptions{Config: pullCfg, PullHooks: pullFrom(src)})
+	require.ErrorIs(t, err, ErrPullContent)
+
+	staged, err := filepath.Glob(filepath.Join(home, pullStagingDir, "*", "bundle"))
+	require.NoError(t, err)
+	require.Len(t, staged, 1, "a failed pull must keep its staging layout")
+	blobs, err := os.ReadDir(filepath.Join(staged[0], "oci", "blobs", "sha256"))
+	require.NoError(t, err)
+	require.NotEmpty(t, blobs)
+	corrupt := godigest.NewDigestFromEncoded(godigest.SHA256, blobs[0].Name())
+	require.NoError(t, os.WriteFile(filepath.Join(staged[0], "oci", "blobs", "sha256", blobs[0].Name()), []byte("corrupt"), filesystem.PrivateFileMode))
+	kept := make(map[godigest.Digest]bool)
+	for _, blob := range blobs[1:] {
+		kept[godigest.NewDigestFromEncoded(godigest.SHA256, blob.Name())] = true
+	}
+
+	src.fail = false
+	src.fetched = nil
+	result, err := Pull(t.Context(), ref, t.TempDir(), PullOptions{Config: pullCfg, PullHooks: pullFrom(src)})
+	require.NoError(t, err)
+	require.FileExists(t, result.OutputPath)
+	assert.Contains(t, src.fetched, corrupt, "a staged blob that fails verification must be fetched again")
+	for _, digest := range src.fetched {
+		if kept[digest] {
+			// Manifests are re-read so their successors are checked; only
+			// leaf blobs are skipped outright.
+			desc, err := store.Resolve(t.Context(), digest.String())
+			require.NoError(t, err)
+			assert.True(t, IsImageManifestMediaType(desc.MediaType) || desc.MediaType == ocispec.MediaTypeImageIndex, "staged blob %s must not be fetched again", digest)
+		}
+	}
+	staged, err = filepath.Glob(filepath.Join(home, pullStagingDir, "*"))
+	require.NoError(t, err)
+	assert.Empty(t, staged, "a successful pull must remove its staging layout")
+}
+
+func TestOpenPullStaging_RefusesSharedDirectory(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("staging directory permissions are governed by ACLs on Windows")
+	}
+	home := t.TempDir()
+	t.Setenv(logger.HomeEnv, home)
+	child := ocispec.Descriptor{MediaType: ocispec.MediaTypeImageIndex, Digest: godigest.FromString("bundle")}
+	dir := filepath.Join(home, pullStagingDir, child.Digest.Encoded())
+	require.NoError(t, os.MkdirAll(dir, filesystem.PrivateDirectoryMode))
+	require.NoError(t, os.Chmod(dir, 0o777))
+
+	_, err := openPullStaging(t.Context(), iostreams.New(nil, nil, io.Discard), child)
+	require.ErrorIs(t, err, ErrUnsafeStagingDirectory)
+
+	require.NoError(t, os.Chmod(dir, filesystem.PrivateDirectoryMode))
+	staging, err := openPullStaging(t.Context(), iostreams.New(nil, nil, io.Discard), child)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, pullLockFile))
+	staging.release(iostreams.New(nil, nil, io.Discard), true)
+	assert.NoDirExists(t, dir)
 }
@@ -25,6 +25,9 @@ type Pusher interface {
 	PushBundle(ctx context.Context, bundleDir, ociReference string, opts PushOptions) (*PushResult, error)
 	// PushPackage pushes a single Zarf package from packageDir to the given OCI reference.
 	PushPackage(ctx context.Context, packageDir, ociReference string, opts PushOptions) (*PushResult, error)
+	// PushBundlePackages pushes each package in the bundle at bundleDir as a
+	// standalone Zarf package under the registry prefix.
+	PushBundlePackages(ctx context.Context, bundleDir, prefix string, opts PushOptions) ([]PackagePushResult, error)
 }
 
 // PushOptions configures an OCI push operation.
@@ -103,10 +106,13 @@ func (p *defaultPusher) PushBundle(ctx context.Context, bundleDir, ociReference
 	// can slot into the root index, artifact-typed so it is identifiable from
 	// the root without a fetch (ADR-0015).
 	childDesc := BundleChildDescriptor(content.NewDescriptorFromBytes(ocispec.MediaTypeImageIndex, idxBytes), arch)
-	signature, readErr := os.ReadFile(filepath.Join(bundleDir, BundleSignatureFileName))
-	hasSignature := readErr == nil
-	if readErr != nil && !os.IsNotExist(readErr) {
-		return nil, fmt.Errorf("reading bundle signature evidence: %w", readErr)
+	signatures, err := ReadBundleSignatures(bundleDir)
+	if err != nil {
+		return nil, err
+	}
+	attestations, err := ReadBundleAttestations(bundleDir)
+	if err != nil {
+		return nil, err
 	}
 
 	// Stage the index bytes as a blob so graph copy can push the child index and
@@ -118,7 +124,7 @@ func (p *defaultPusher) PushBundle(ctx context.Context, bundleDir, ociReference
 	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
 	log.Info("pushing bundle content", "ref", ociReference, "arch", arch)
 	log.Debug("copying bundle to registry", "ref", ociReference, "arch", arch)
-	result, err := pushBundleToRemote(ctx, store.Store, childDesc, ociReference, &opts, signature, hasSignature)
+	result, err := pushBundleToRemote(ctx, store.Store, childDesc, ociReference, &opts, signatures, attestations)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,341 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"golang.org/x/sync/errgroup"
+	"oras.land/oras-go/v2/content"
+)
+
+// Blob sizes governing resumable downloads. They are variables so tests can
+// exercise chunking with small blobs.
+var (
+	// resumableBlobSize is the smallest blob downloaded through partial files;
+	// smaller content, including every manifest, is fetched directly.
+	resumableBlobSize int64 = 4 << 20
+	// minChunkSize is the smallest range requested when a blob is split
+	// across parallel requests.
+	minChunkSize int64 = 32 << 20
+)
+
+// resumableSource wraps a pull source so large blobs are downloaded into
+// partial files under dir before being handed to the copy. A transfer that
+// fails keeps the bytes already received, and the next attempt, in this run or
+// a later one, requests only the remainder with an HTTP range request.
+//
+// When chunks is greater than one, a blob is split into up to chunks ranges
+// downloaded in parallel, each resumed independently. Ranged requests need a
+// registry that advertises Accept-Ranges; otherwise blobs are downloaded in
+// one stream and partial bytes are skipped rather than re-requested.
+type resumableSource struct {
+	content.ReadOnlyStorage
+	dir    string
+	chunks int
+	log    iostreams.IOStreams
+}
+
+func newResumableSource(src content.ReadOnlyStorage, dir string, chunks int, log iostreams.IOStreams) *resumableSource {
+	return &resumableSource{ReadOnlyStorage: src, dir: dir, chunks: max(chunks, 1), log: log}
+}
+
+// byteRange is a half-open range [start, start+length) of a blob.
+type byteRange struct {
+	start, length int64
+}
+
+// Fetch downloads desc into partial files and returns a reader over them. The
+// partial files are removed once the reader has been read to the end; the
+// copy verifies the digest as it reads, so a corrupt download is discarded
+// rather than resumed.
+func (s *resumableSource) Fetch(ctx context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+	if desc.Size < resumableBlobSize {
+		return s.ReadOnlyStorage.Fetch(ctx, desc)
+	}
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, InvalidDigestError{Digest: desc.Digest.String(), Err: err}
+	}
+	if err := os.MkdirAll(s.dir, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("creating partial download directory: %w", err)
+	}
+
+	// The first response tells whether the registry serves ranges; without
+	// them the blob cannot be split.
+	var first io.ReadCloser
+	err := retryTransfer(ctx, s.log, desc, func() error {
+		rc, err := s.ReadOnlyStorage.Fetch(ctx, desc)
+		first = rc
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	chunks := s.chunks
+	if _, ok := first.(io.Seeker); !ok {
+		chunks = 1
+	}
+	ranges := chunkRanges(desc.Size, chunks)
+	paths := make([]string, len(ranges))
+	for i := range ranges {
+		paths[i] = s.partialPath(desc, len(ranges), i)
+	}
+	if err := s.removeStalePartials(desc, paths); err != nil {
+		_ = first.Close()
+		return nil, err
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	for i, r := range ranges {
+		var initial io.ReadCloser
+		if i == 0 {
+			initial = first
+		}
+		g.Go(func() error {
+			return s.fetchRange(gctx, desc, r, paths[i], initial)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return openPartials(paths)
+}
+
+// fetchRange downloads r of desc into path, appending to the bytes already
+// there. initial, when set, is an open response for desc to read first.
+func (s *resumableSource) fetchRange(ctx context.Context, desc ocispec.Descriptor, r byteRange, path string, initial io.ReadCloser) (retErr error) {
+	rc := initial
+	defer func() {
+		if rc != nil {
+			_ = rc.Close()
+		}
+	}()
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, filesystem.PrivateFileMode)
+	if err != nil {
+		return fmt.Errorf("opening partial download: %w", err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = fmt.Errorf("closing partial download: %w", err)
+		}
+	}()
+	have, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("reading partial download: %w", err)
+	}
+	if have > r.length {
+		if err := f.Truncate(0); err != nil {
+			return fmt.Errorf("resetting partial download: %w", err)
+		}
+		if have, err = f.Seek(0, io.SeekStart); err != nil {
+			return fmt.Errorf("resetting partial download: %w", err)
+		}
+	}
+	if have > 0 && have < r.length {
+		s.log.Info("resuming blob download", "digest", desc.Digest.String(), "offset", r.start+have, "remaining", r.length-have)
+	}
+
+	return retryTransfer(ctx, s.log, desc, func() error {
+		if have == r.length {
+			return nil
+		}
+		if rc == nil {
+			var err error
+			if rc, err = s.ReadOnlyStorage.Fetch(ctx, desc); err != nil {
+				return err
+			}
+		}
+		defer func() {
+			_ = rc.Close()
+			rc = nil
+		}()
+		offset := r.start + have
+		if seeker, ok := rc.(io.Seeker); ok {
+			if _, err := seeker.Seek(offset, io.SeekStart); err != nil {
+				return err
+			}
+		} else if _, err := io.CopyN(io.Discard, rc, offset); err != nil {
+			return err
+		}
+		n, err := io.CopyN(f, rc, r.length-have)
+		have += n
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	})
+}
+
+// partialPath names the file holding range i of the n ranges of desc. The
+// range count is part of the name so a change in chunk concurrency does not
+// resume from ranges with different boundaries.
+func (s *resumableSource) partialPath(desc ocispec.Descriptor, n, i int) string {
+	return filepath.Join(s.dir, partialPrefix(desc.Digest)+strconv.Itoa(n)+"-"+strconv.Itoa(i)+".part")
+}
+
+// removeStalePartials removes partial files of desc left by a download split
+// into a different number of ranges.
+func (s *resumableSource) removeStalePartials(desc ocispec.Descriptor, keep []string) error {
+	matches, err := filepath.Glob(filepath.Join(s.dir, partialPrefix(desc.Digest)+"*.part"))
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		if !slices.Contains(keep, match) {
+			if err := os.Remove(match); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				return fmt.Errorf("removing stale partial download: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func partialPrefix(d godigest.Digest) string {
+	return d.Algorithm().String() + "-" + d.Encoded() + "."
+}
+
+// chunkRanges splits size bytes into at most chunks ranges of at least
+// minChunkSize bytes.
+func chunkRanges(size int64, chunks int) []byteRange {
+	n := int64(max(chunks, 1))
+	if limit := size / minChunkSize; n > limit {
+		n = max(limit, 1)
+	}
+	ranges := make([]byteRange, 0, n)
+	for i := range n {
+		start := size * i / n
+		end := size * (i + 1) / n
+		ranges = append(ranges, byteRange{start: start, length: end - start})
+	}
+	return ranges
+}
+
+// partialReader reads a completed download from its partial files and
+// removes them once it has been read to the end.
+type partialReader struct {
+	io.Reader
+	files []*os.File
+	done  bool
+}
+
+func openPartials(paths []string) (io.ReadCloser, error) {
+	p := &partialReader{}
+	readers := make([]io.Reader, 0, len(paths))
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			_ = p.Close()
+			return nil, fmt.Errorf("opening partial download: %w", err)
+		}
+		p.files = append(p.files, f)
+		readers = append(readers, f)
+	}
+	p.Reader = io.MultiReader(readers...)
+	return p, nil
+}
+
+func (p *partialReader) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if errors.Is(err, io.EOF) {
+		p.done = true
+	}
+	return n, err
+}
+
+func (p *partialReader) Close() error {
+	var errs []error
+	for _, f := range p.files {
+		errs = append(errs, f.Close())
+		if p.done {
+			if err := os.Remove(f.Name()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stagingStore is the destination of a resumed pull. ORAS skips the whole
+// subgraph of a manifest the destination already has, so manifests are
+// reported missing to make the copy walk their successors and fetch any blob
+// that verification removed; re-pushing a manifest already stored is a no-op.
+type stagingStore struct {
+	content.Storage
+}
+
+func (s stagingStore) Exists(ctx context.Context, desc ocispec.Descriptor) (bool, error) {
+	if IsImageManifestMediaType(desc.MediaType) || desc.MediaType == ocispec.MediaTypeImageIndex || desc.MediaType == mediaTypeDockerManifestList {
+		return false, nil
+	}
+	return s.Storage.Exists(ctx, desc)
+}
+
+// removeCorruptBlobs digest-checks every blob in the OCI layout at ociDir and
+// removes those whose content does not match their name, so a resumed pull
+// downloads them again. It returns the number of blobs kept.
+func removeCorruptBlobs(ctx context.Context, ociDir string) (int, error) {
+	blobDir := filepath.Join(ociDir, ocispec.ImageBlobsDir)
+	kept := 0
+	err := filepath.WalkDir(blobDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if entry.IsDir() || !entry.Type().IsRegular() {
+			return nil
+		}
+		algorithm := godigest.Algorithm(filepath.Base(filepath.Dir(path)))
+		digest := godigest.NewDigestFromEncoded(algorithm, entry.Name())
+		if digest.Validate() == nil {
+			ok, err := fileMatchesDigest(path, digest)
+			if err != nil {
+				return err
+			}
+			if ok {
+				kept++
+				return nil
+			}
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("removing corrupt blob %s: %w", strings.TrimPrefix(path, blobDir), err)
+		}
+		return nil
+	})
+	return kept, err
+}
+
+func fileMatchesDigest(path string, digest godigest.Digest) (_ bool, retErr error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	verifier := digest.Verifier()
+	if _, err := io.Copy(verifier, f); err != nil {
+		return false, err
+	}
+	return verifier.Verified(), nil
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+)
+
+var errConnectionDropped = errors.New("connection dropped")
+
+// rangeSource serves one blob, through seekable readers when seekable is set.
+// The first failures responses fail with errConnectionDropped on reaching
+// offset failAfter. It records the offset every response was read from.
+type rangeSource struct {
+	data      []byte
+	seekable  bool
+	failAfter int
+	failures  int
+
+	mu      sync.Mutex
+	offsets []int64
+}
+
+func (s *rangeSource) Fetch(_ context.Context, _ ocispec.Descriptor) (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	fail := s.failures > 0
+	if fail {
+		s.failures--
+	}
+	r := &rangeReader{source: s, fail: fail}
+	s.offsets = append(s.offsets, 0)
+	r.index = len(s.offsets) - 1
+	if s.seekable {
+		return &seekableRangeReader{r}, nil
+	}
+	return r, nil
+}
+
+func (s *rangeSource) Exists(context.Context, ocispec.Descriptor) (bool, error) { return true, nil }
+
+type rangeReader struct {
+	source *rangeSource
+	index  int
+	offset int64
+	fail   bool
+}
+
+func (r *rangeReader) Read(p []byte) (int, error) {
+	end := int64(len(r.source.data))
+	if r.fail {
+		end = min(end, int64(r.source.failAfter))
+	}
+	if r.offset >= end {
+		if r.fail {
+			return 0, errConnectionDropped
+		}
+		return 0, io.EOF
+	}
+	n := copy(p, r.source.data[r.offset:end])
+	r.offset += int64(n)
+	return n, nil
+}
+
+func (r *rangeReader) Close() error { return nil }
+
+type seekableRangeReader struct{ *rangeReader }
+
+func (r *seekableRangeReader) Seek(offset int64, _ int) (int64, error) {
+	r.offset = offset
+	r.source.mu.Lock()
+	r.source.offsets[r.index] = offset
+	r.source.mu.Unlock()
+	return offset, nil
+}
+
+func withSmallBlobs(t *testing.T) {
+	t.Helper()
+	size, chunk, backoff := resumableBlobSize, minChunkSize, transferBackoff
+	resumableBlobSize, minChunkSize = 64, 16
+	transferBackoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { resumableBlobSize, minChunkSize, transferBackoff = size, chunk, backoff })
+}
+
+func testBlob() ([]byte, ocispec.Descriptor) {
+	data := bytes.Repeat([]byte("0123456789abcdef"), 16)
+	return data, content.NewDescriptorFromBytes("application/octet-stream", data)
+}
+
+func readAllAndClose(t *testing.T, rc io.ReadCloser) []byte {
+	t.Helper()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	return data
+}
+
+func TestResumableSource_RetriesFromReceivedOffset(t *testing.T) {
+	withSmallBlobs(t)
+	data, desc := testBlob()
+	src := &rangeSource{data: data, seekable: true, failAfter: 100, failures: 1}
+	dir := t.TempDir()
+
+	rc, err := newResumableSource(src, dir, 1, iostreams.IOStreams{}).Fetch(t.Context(), desc)
+	require.NoError(t, err)
+	assert.Equal(t, data, readAllAndClose(t, rc))
+	assert.Equal(t, []int64{0, 100}, src.offsets)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "partial downloads must be removed once read")
+}
+
+func TestResumableSource_ResumesAcrossRuns(t *testing.T) {
+	withSmallBlobs(t)
+	data, desc := testBlob()
+	dir := t.TempDir()
+
+	failing := &rangeSource{data: data, seekable: true, failAfter: 100, failures: transferAttempts}
+	_, err := newResumableSource(failing, dir, 1, iostreams.IOStreams{}).Fetch(t.Context(), desc)
+	require.ErrorIs(t, err, errConnectionDropped)
+	partial, err := os.ReadFile(filepath.Join(dir, partialPrefix(desc.Digest)+"1-0.part"))
+	require.NoError(t, err)
+	assert.Equal(t, data[:100], partial)
+
+	healthy := &rangeSource{data: data, seekable: true}
+	rc, err := newResumableSource(healthy, dir, 1, iostreams.IOStreams{}).Fetch(t.Context(), desc)
+	require.NoError(t, err)
+	assert.Equal(t, data, readAllAndClose(t, rc))
+	assert.Equal(t, []int64{100}, healthy.offsets, "the resumed download must request only the remainder")
+}
+
+func TestResumableSource_DownloadsChunksInParallel(t *testing.T) {
+	withSmallBlobs(t)
+	data, desc := testBlob()
+	src := &rangeSource{data: data, seekable: true}
+
+	rc, err := newResumableSource(src, t.TempDir(), 4, iostreams.IOStreams{}).Fetch(t.Context(), desc)
+	require.NoError(t, err)
+	assert.Equal(t, data, readAllAndClose(t, rc))
+	assert.ElementsMatch(t, []int64{0, 64, 128, 192}, src.offsets)
+}
+
+func TestResumableSource_WithoutRangeSupportDownloadsInOneStream(t *testing.T) {
+	withSmallBlobs(t)
+	data, desc := testBlob()
+	src := &rangeSource{data: data, failAfter: 100, failures: 1}
+
+	rc, err := newResumableSource(src, t.TempDir(), 4, iostreams.IOStreams{}).Fetch(t.Context(), desc)
+	require.NoError(t, err)
+	assert.Equal(t, data, readAllAndClose(t, rc))
+	assert.Len(t, src.offsets, 2, "an unseekable source is fetched once per attempt")
+}
+
+func TestChunkRanges(t *testing.T) {
+	withSmallBlobs(t)
+	assert.Equal(t, []byteRange{{start: 0, length: 40}}, chunkRanges(40, 1))
+	assert.Equal(t, []byteRange{{start: 0, length: 20}, {start: 20, length: 20}}, chunkRanges(40, 8))
+	assert.Equal(t, []byteRange{{start: 0, length: 10}}, chunkRanges(10, 4))
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// transferAttempts is the number of times a single blob transfer is attempted
+// before the pull or push fails.
+const transferAttempts = 5
+
+// transferBackoff returns the delay before the given retry of a blob
+// transfer, doubling from one second up to thirty seconds. It is a variable so
+// tests can remove the delay.
+var transferBackoff = func(retry int) time.Duration {
+	delay := time.Second << (retry - 1)
+	if delay > 30*time.Second || delay <= 0 {
+		return 30 * time.Second
+	}
+	return delay
+}
+
+// retryTransfer calls fn until it succeeds, fails with an error that retrying
+// cannot fix, the context is cancelled, or transferAttempts is reached.
+func retryTransfer(ctx context.Context, log iostreams.IOStreams, desc ocispec.Descriptor, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil || attempt == transferAttempts || !isRetryableTransferError(err) {
+			return err
+		}
+		delay := transferBackoff(attempt)
+		log.Warn("blob transfer failed; retrying", "digest", desc.Digest.String(), "attempt", attempt, "delay", delay.String(), "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// isRetryableTransferError reports whether a failed blob transfer may succeed
+// when attempted again. Content and client errors are permanent; everything
+// else, including dropped connections and server errors, is retried.
+func isRetryableTransferError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, content.ErrMismatchedDigest) || errors.Is(err, content.ErrTrailingData) ||
+		errors.Is(err, errdef.ErrNotFound) || errors.Is(err, errdef.ErrSizeExceedsLimit) {
+		return false
+	}
+	var resp *errcode.ErrorResponse
+	if errors.As(err, &resp) {
+		switch resp.StatusCode {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		}
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
+
+// retryingTarget is a push destination that retries individual blob pushes
+// with backoff. Each retry first checks whether the registry committed the
+// blob before the failure, then re-reads the blob from src.
+type retryingTarget struct {
+	oras.Target
+	src content.Fetcher
+	log iostreams.IOStreams
+}
+
+func (t *retryingTarget) Push(ctx context.Context, desc ocispec.Descriptor, r io.Reader) error {
+	first := true
+	return retryTransfer(ctx, t.log, desc, func() error {
+		if first {
+			first = false
+			return t.Target.Push(ctx, desc, r)
+		}
+		if exists, err := t.Target.Exists(ctx, desc); err == nil && exists {
+			return nil
+		}
+		rc, err := t.src.Fetch(ctx, desc)
+		if err != nil {
+			return fmt.Errorf("re-reading %s: %w", desc.Digest, err)
+		}
+		defer func() { _ = rc.Close() }()
+		return t.Target.Push(ctx, desc, rc)
+	})
+}
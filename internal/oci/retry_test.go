@@ -0,0 +1,116 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/content"
+	oraci "oras.land/oras-go/v2/content/oci"
+	"oras.land/oras-go/v2/errdef"
+	"oras.land/oras-go/v2/registry/remote/errcode"
+)
+
+// flakyPushTarget drops the connection part way through the first push of
+// every blob; manifests and indexes are pushed normally.
+type flakyPushTarget struct {
+	*oraci.Store
+
+	mu     sync.Mutex
+	failed map[godigest.Digest]bool
+}
+
+func (f *flakyPushTarget) Push(ctx context.Context, desc ocispec.Descriptor, r io.Reader) error {
+	if IsImageManifestMediaType(desc.MediaType) || desc.MediaType == ocispec.MediaTypeImageIndex {
+		return f.Store.Push(ctx, desc, r)
+	}
+	f.mu.Lock()
+	fail := !f.failed[desc.Digest]
+	f.failed[desc.Digest] = true
+	f.mu.Unlock()
+	if fail {
+		_, _ = io.CopyN(io.Discard, r, desc.Size/2)
+		return errConnectionDropped
+	}
+	return f.Store.Push(ctx, desc, r)
+}
+
+func TestPush_RetriesFailedBlobs(t *testing.T) {
+	backoff := transferBackoff
+	transferBackoff = func(int) time.Duration { return 0 }
+	t.Cleanup(func() { transferBackoff = backoff })
+
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "localpkg"))
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "retry"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+  signature_verification { verify = false }
+}
+`), filesystem.PrivateFileMode))
+	tarball, err := Create(t.Context(), CreateOptions{Config: newTestConfig(), BundleFile: bundleFile, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+	store, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+	dst := &flakyPushTarget{Store: store, failed: make(map[godigest.Digest]bool)}
+
+	cfg := newTestConfig()
+	cfg.Options.TmpDir = t.TempDir()
+	const ref = "example.com/test/retry:1.0.0"
+	_, err = Push(t.Context(), tarball.OutputPath, ref, PushOptions{Config: cfg, PushHooks: pushTo(dst)})
+	require.NoError(t, err)
+
+	assert.NotEmpty(t, dst.failed)
+	for digest := range dst.failed {
+		exists, err := store.Exists(t.Context(), ocispec.Descriptor{Digest: digest})
+		require.NoError(t, err)
+		assert.True(t, exists, "blob %s must be pushed after a retry", digest)
+	}
+	_, err = store.Resolve(t.Context(), "1.0.0")
+	require.NoError(t, err)
+}
+
+func TestIsRetryableTransferError(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "dropped connection", err: io.ErrUnexpectedEOF, want: true},
+		{name: "server error", err: &errcode.ErrorResponse{StatusCode: http.StatusBadGateway}, want: true},
+		{name: "rate limited", err: &errcode.ErrorResponse{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "unauthorized", err: &errcode.ErrorResponse{StatusCode: http.StatusUnauthorized}, want: false},
+		{name: "not found", err: fmt.Errorf("fetching: %w", errdef.ErrNotFound), want: false},
+		{name: "digest mismatch", err: content.ErrMismatchedDigest, want: false},
+		{name: "cancelled", err: context.Canceled, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, isRetryableTransferError(tt.err))
+		})
+	}
+}
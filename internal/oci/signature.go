@@ -9,7 +9,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
 
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	cosignbundle "github.com/sigstore/cosign/v3/pkg/cosign/bundle"
 	oras "oras.land/oras-go/v2"
@@ -21,11 +28,98 @@ import (
 // MediaTypeBundleSignature identifies standard Sigstore bundle evidence.
 const MediaTypeBundleSignature = cosignbundle.BundleV03MediaType
 
-// BundleSignatureFileName is the archive-root filename for bundle signature evidence.
+// BundleSignatureFileName is the archive-root filename for the first bundle
+// signature. Countersignatures are stored beside it as uds.bundle.1.sig,
+// uds.bundle.2.sig, and so on.
 const BundleSignatureFileName = "uds.bundle.sig"
 
-// PublishBundleSignature publishes singleton Sigstore evidence for a child bundle index.
+var bundleCountersignatureFileName = regexp.MustCompile(`^uds\.bundle\.([1-9][0-9]*)\.sig$`)
+
+// BundleSignatureFileNameAt returns the archive-root filename for the
+// signature at position i, starting at zero.
+func BundleSignatureFileNameAt(i int) string {
+	if i == 0 {
+		return BundleSignatureFileName
+	}
+	return fmt.Sprintf("uds.bundle.%d.sig", i)
+}
+
+// IsBundleSignatureFileName reports whether name is an archive-root
+// filename for bundle signature evidence.
+func IsBundleSignatureFileName(name string) bool {
+	return name == BundleSignatureFileName || bundleCountersignatureFileName.MatchString(name)
+}
+
+// ReadBundleSignatures reads every signature at the root of an extracted
+// bundle workspace in signing order. It returns nil when the bundle is unsigned.
+func ReadBundleSignatures(dir string) ([][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("listing bundle signature evidence: %w", err)
+	}
+	positions := map[int]string{}
+	for _, entry := range entries {
+		name := entry.Name()
+		if name == BundleSignatureFileName {
+			positions[0] = name
+		} else if match := bundleCountersignatureFileName.FindStringSubmatch(name); match != nil {
+			i, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("parsing bundle signature filename %s: %w", name, err)
+			}
+			positions[i] = name
+		}
+	}
+	if len(positions) != 0 && positions[0] == "" {
+		return nil, fmt.Errorf("bundle countersignature evidence found without %s", BundleSignatureFileName)
+	}
+	var signatures [][]byte
+	for _, i := range slices.Sorted(maps.Keys(positions)) {
+		data, err := readOptionalEvidence(filepath.Join(dir, positions[i]))
+		if err != nil {
+			return nil, fmt.Errorf("reading bundle signature evidence %s: %w", positions[i], err)
+		}
+		signatures = append(signatures, data)
+	}
+	return signatures, nil
+}
+
+// WriteBundleSignatures writes signatures to the root of a bundle workspace,
+// replacing any signature evidence already there.
+func WriteBundleSignatures(dir string, signatures [][]byte) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("listing bundle signature evidence: %w", err)
+	}
+	for _, entry := range entries {
+		if IsBundleSignatureFileName(entry.Name()) {
+			if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+				return fmt.Errorf("removing bundle signature evidence %s: %w", entry.Name(), err)
+			}
+		}
+	}
+	for i, signature := range signatures {
+		name := BundleSignatureFileNameAt(i)
+		if err := os.WriteFile(filepath.Join(dir, name), signature, filesystem.PrivateFileMode); err != nil {
+			return fmt.Errorf("writing bundle signature evidence %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// PublishBundleSignature adds Sigstore evidence for a child bundle index.
+// Existing signatures are kept so data countersigns the bundle; with
+// overwrite, data replaces every existing signature.
 func PublishBundleSignature(ctx context.Context, target oras.Target, subject ocispec.Descriptor, data []byte, overwrite bool) error {
+	return PublishBundleSignatures(ctx, target, subject, [][]byte{data}, overwrite)
+}
+
+// PublishBundleSignatures adds signatures to the evidence published for a
+// child bundle index. All evidence is stored as layers of one signature
+// artifact so registries without the referrers API can locate every
+// signature through the legacy tag. Signatures already published are not
+// duplicated; with overwrite, signatures replace every existing signature.
+func PublishBundleSignatures(ctx context.Context, target oras.Target, subject ocispec.Descriptor, signatures [][]byte, overwrite bool) error {
 	store, ok := target.(content.ReadOnlyGraphStorage)
 	if !ok {
 		return fmt.Errorf("registry target does not support signature discovery")
@@ -34,33 +128,41 @@ func PublishBundleSignature(ctx context.Context, target oras.Target, subject oci
 	if err != nil {
 		return fmt.Errorf("discovering existing bundle signature: %w", err)
 	}
-	if len(refs) == 1 {
-		existing, err := signatureData(ctx, target, refs[0])
-		if err != nil && !overwrite {
+	var existing [][]byte
+	for _, ref := range refs {
+		data, err := signatureData(ctx, target, ref)
+		if err != nil {
+			if overwrite {
+				continue
+			}
 			return err
 		}
-		if err == nil && bytes.Equal(existing, data) {
-			return nil
-		}
+		existing = appendSignatures(existing, data...)
+	}
+	published := appendSignatures(nil, signatures...)
+	if !overwrite {
+		published = appendSignatures(existing, signatures...)
+	}
+	if len(refs) == 1 && slices.EqualFunc(existing, published, bytes.Equal) {
+		return nil
 	}
 	var deleter content.Deleter
 	if len(refs) != 0 {
-		if !overwrite {
-			return fmt.Errorf("bundle signature evidence already exists; set overwrite to replace it")
-		}
-		var ok bool
 		deleter, ok = target.(content.Deleter)
 		if !ok {
 			return fmt.Errorf("registry target does not support replacing signature evidence")
 		}
 	}
-	layer, err := PushBytes(ctx, target, MediaTypeBundleSignature, data, nil)
-	if err != nil {
-		return fmt.Errorf("pushing signature evidence: %w", err)
+	layers := make([]ocispec.Descriptor, len(published))
+	for i, data := range published {
+		layers[i], err = PushBytes(ctx, target, MediaTypeBundleSignature, data, nil)
+		if err != nil {
+			return fmt.Errorf("pushing signature evidence: %w", err)
+		}
 	}
 	replacement, err := oras.PackManifest(ctx, target, oras.PackManifestVersion1_1, MediaTypeBundleSignature, oras.PackManifestOptions{
 		Subject: &subject,
-		Layers:  []ocispec.Descriptor{layer},
+		Layers:  layers,
 	})
 	if err != nil {
 		return fmt.Errorf("publishing signature artifact: %w", err)
@@ -69,6 +171,9 @@ func PublishBundleSignature(ctx context.Context, target oras.Target, subject oci
 		return fmt.Errorf("tagging signature artifact: %w", err)
 	}
 	for _, ref := range refs {
+		if ref.Digest == replacement.Digest {
+			continue
+		}
 		if err := deleter.Delete(ctx, ref); err != nil && !errors.Is(err, errdef.ErrNotFound) {
 			if rollbackErr := deleter.Delete(ctx, replacement); rollbackErr != nil && !errors.Is(rollbackErr, errdef.ErrNotFound) {
 				return fmt.Errorf("removing replaced signature artifact: %w", errors.Join(err, fmt.Errorf("rolling back replacement signature artifact: %w", rollbackErr)))
@@ -79,7 +184,18 @@ func PublishBundleSignature(ctx context.Context, target oras.Target, subject oci
 	return nil
 }
 
-func signatureData(ctx context.Context, source oras.Target, ref ocispec.Descriptor) ([]byte, error) {
+// appendSignatures appends each signature not already present in signatures.
+func appendSignatures(signatures [][]byte, additions ...[]byte) [][]byte {
+	for _, addition := range additions {
+		if !slices.ContainsFunc(signatures, func(existing []byte) bool { return bytes.Equal(existing, addition) }) {
+			signatures = append(signatures, addition)
+		}
+	}
+	return signatures
+}
+
+// signatureData fetches every signature layer of a signature artifact.
+func signatureData(ctx context.Context, source oras.Target, ref ocispec.Descriptor) ([][]byte, error) {
 	manifestBytes, err := FetchBytes(ctx, source, ref)
 	if err != nil {
 		return nil, fmt.Errorf("fetching signature artifact: %w", err)
@@ -88,18 +204,26 @@ func signatureData(ctx context.Context, source oras.Target, ref ocispec.Descript
 	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
 		return nil, fmt.Errorf("parsing signature artifact: %w", err)
 	}
-	if len(manifest.Layers) != 1 || manifest.Layers[0].MediaType != MediaTypeBundleSignature {
+	if len(manifest.Layers) == 0 {
 		return nil, fmt.Errorf("signature artifact has invalid layers")
 	}
-	data, err := FetchBytes(ctx, source, manifest.Layers[0])
-	if err != nil {
-		return nil, fmt.Errorf("fetching signature evidence: %w", err)
+	signatures := make([][]byte, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != MediaTypeBundleSignature {
+			return nil, fmt.Errorf("signature artifact has invalid layers")
+		}
+		data, err := FetchBytes(ctx, source, layer)
+		if err != nil {
+			return nil, fmt.Errorf("fetching signature evidence: %w", err)
+		}
+		signatures = append(signatures, data)
 	}
-	return data, nil
+	return signatures, nil
 }
 
-// FetchBundleSignature discovers and fetches Sigstore evidence for subject.
-func FetchBundleSignature(ctx context.Context, source oras.Target, subject ocispec.Descriptor) ([]byte, error) {
+// FetchBundleSignatures discovers and fetches every Sigstore signature for
+// subject, whether published as layers of one artifact or as separate referrers.
+func FetchBundleSignatures(ctx context.Context, source oras.Target, subject ocispec.Descriptor) ([][]byte, error) {
 	store, ok := source.(content.ReadOnlyGraphStorage)
 	if !ok {
 		return nil, fmt.Errorf("registry target does not support signature discovery")
@@ -111,10 +235,15 @@ func FetchBundleSignature(ctx context.Context, source oras.Target, subject ocisp
 	if len(refs) == 0 {
 		return nil, ErrBundleSignatureNotFound
 	}
-	if len(refs) != 1 {
-		return nil, fmt.Errorf("%w: expected exactly one bundle signature artifact, found %d", ErrBundleSignatureDuplicate, len(refs))
+	var signatures [][]byte
+	for _, ref := range refs {
+		data, err := signatureData(ctx, source, ref)
+		if err != nil {
+			return nil, err
+		}
+		signatures = appendSignatures(signatures, data...)
 	}
-	return signatureData(ctx, source, refs[0])
+	return signatures, nil
 }
 
 func signatureReferences(ctx context.Context, target oras.Target, store content.ReadOnlyGraphStorage, subject ocispec.Descriptor) ([]ocispec.Descriptor, error) {
@@ -123,7 +252,9 @@ func signatureReferences(ctx context.Context, target oras.Target, store content.
 		return refs, nil
 	}
 	legacy, legacyErr := target.Resolve(ctx, legacySignatureTag(subject))
-	if legacyErr == nil {
+	// Registries without the referrers API keep a referrers index at the same
+	// tag; only a manifest there is legacy signature evidence.
+	if legacyErr == nil && legacy.MediaType != ocispec.MediaTypeImageIndex {
 		return []ocispec.Descriptor{legacy}, nil
 	}
 	if err != nil {
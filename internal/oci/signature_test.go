@@ -5,6 +5,8 @@ package oci
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
@@ -35,17 +37,37 @@ func TestPublishBundleSignature_OverwriteReplacesEvidence(t *testing.T) {
 
 	subject := pushSignatureSubject(t, store)
 	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("old evidence"), false))
+	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("countersignature"), false))
 	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("new evidence"), true))
 
 	refs, err := registry.Referrers(t.Context(), store, subject, MediaTypeBundleSignature)
 	require.NoError(t, err)
 	require.Len(t, refs, 1)
-	evidence, err := FetchBundleSignature(t.Context(), store, subject)
+	evidence, err := FetchBundleSignatures(t.Context(), store, subject)
 	require.NoError(t, err)
-	require.Equal(t, []byte("new evidence"), evidence)
+	require.Equal(t, [][]byte{[]byte("new evidence")}, evidence)
 }
 
-func TestPublishBundleSignature_RejectsDuplicateExistingEvidence(t *testing.T) {
+func TestPublishBundleSignature_CountersignsExistingEvidence(t *testing.T) {
+	store, err := oraci.New(t.TempDir())
+	require.NoError(t, err)
+
+	subject := pushSignatureSubject(t, store)
+	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("dev evidence"), false))
+	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("security evidence"), false))
+
+	refs, err := registry.Referrers(t.Context(), store, subject, MediaTypeBundleSignature)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	legacy, err := store.Resolve(t.Context(), legacySignatureTag(subject))
+	require.NoError(t, err)
+	require.Equal(t, refs[0].Digest, legacy.Digest)
+	evidence, err := FetchBundleSignatures(t.Context(), store, subject)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{[]byte("dev evidence"), []byte("security evidence")}, evidence)
+}
+
+func TestPublishBundleSignature_ConsolidatesSeparateReferrers(t *testing.T) {
 	store, err := oraci.New(t.TempDir())
 	require.NoError(t, err)
 
@@ -53,19 +75,25 @@ func TestPublishBundleSignature_RejectsDuplicateExistingEvidence(t *testing.T) {
 	pushSignatureEvidence(t, store, subject, []byte("first evidence"))
 	pushSignatureEvidence(t, store, subject, []byte("second evidence"))
 
-	err = PublishBundleSignature(t.Context(), store, subject, []byte("first evidence"), false)
-	require.ErrorContains(t, err, "bundle signature evidence already exists")
+	require.NoError(t, PublishBundleSignature(t.Context(), store, subject, []byte("first evidence"), false))
+
+	refs, err := registry.Referrers(t.Context(), store, subject, MediaTypeBundleSignature)
+	require.NoError(t, err)
+	require.Len(t, refs, 1)
+	evidence, err := FetchBundleSignatures(t.Context(), store, subject)
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{[]byte("first evidence"), []byte("second evidence")}, evidence)
 }
 
-func TestFetchBundleSignature_MissingEvidence(t *testing.T) {
+func TestFetchBundleSignatures_MissingEvidence(t *testing.T) {
 	store, err := oraci.New(t.TempDir())
 	require.NoError(t, err)
 
-	_, err = FetchBundleSignature(t.Context(), store, pushSignatureSubject(t, store))
+	_, err = FetchBundleSignatures(t.Context(), store, pushSignatureSubject(t, store))
 	require.ErrorIs(t, err, ErrBundleSignatureNotFound)
 }
 
-func TestFetchBundleSignature_RejectsDuplicateEvidence(t *testing.T) {
+func TestFetchBundleSignatures_MergesSeparateReferrers(t *testing.T) {
 	store, err := oraci.New(t.TempDir())
 	require.NoError(t, err)
 
@@ -73,9 +101,30 @@ func TestFetchBundleSignature_RejectsDuplicateEvidence(t *testing.T) {
 	pushSignatureEvidence(t, store, subject, []byte("first"))
 	pushSignatureEvidence(t, store, subject, []byte("second"))
 
-	_, err = FetchBundleSignature(t.Context(), store, subject)
-	require.ErrorContains(t, err, "expected exactly one bundle signature artifact, found 2")
-	require.NotErrorIs(t, err, ErrBundleSignatureNotFound)
+	evidence, err := FetchBundleSignatures(t.Context(), store, subject)
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]byte{[]byte("first"), []byte("second")}, evidence)
+}
+
+func TestBundleSignatureFiles_RoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, BundleSignatureFileNameAt(4)), []byte("stale"), 0o600))
+
+	signatures := [][]byte{[]byte("first"), []byte("second"), []byte("third")}
+	require.NoError(t, WriteBundleSignatures(dir, signatures))
+	read, err := ReadBundleSignatures(dir)
+	require.NoError(t, err)
+	require.Equal(t, signatures, read)
+	require.NoFileExists(t, filepath.Join(dir, BundleSignatureFileNameAt(4)))
+
+	require.NoError(t, os.Remove(filepath.Join(dir, BundleSignatureFileName)))
+	_, err = ReadBundleSignatures(dir)
+	require.ErrorContains(t, err, "countersignature evidence found without uds.bundle.sig")
+
+	require.NoError(t, WriteBundleSignatures(dir, nil))
+	read, err = ReadBundleSignatures(dir)
+	require.NoError(t, err)
+	require.Empty(t, read)
 }
 
 func pushSignatureSubject(t *testing.T, store *oraci.Store) ocispec.Descriptor {
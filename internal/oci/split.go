@@ -0,0 +1,180 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/goccy/go-yaml"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"oras.land/oras-go/v2/content"
+)
+
+// PackagePushResult describes a bundle package published as a standalone Zarf
+// package.
+type PackagePushResult struct {
+	Package      string `json:"package" yaml:"package" text:"Package"`
+	OCIReference string `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
+	Digest       string `json:"digest" yaml:"digest" text:"Digest"`
+}
+
+// zarfPackageMetadata is the part of a package's zarf.yaml that names its
+// standalone reference.
+type zarfPackageMetadata struct {
+	Metadata struct {
+		Name    string `yaml:"name"`
+		Version string `yaml:"version"`
+	} `yaml:"metadata"`
+	Build struct {
+		Flavor string `yaml:"flavor"`
+	} `yaml:"build"`
+}
+
+// splitPackage is a bundle package and the reference it is published at.
+type splitPackage struct {
+	name string
+	desc ocispec.Descriptor
+	ref  string
+}
+
+// PushBundlePackages publishes every package in the bundle workspace at
+// bundleDir as a standalone Zarf package. Each package manifest is pushed
+// unchanged, with the layers it references, to
+// <prefix>/<package-name>:<version>, with -<flavor> appended to the tag for
+// flavored packages, the reference Zarf publishes the package at. Package
+// names and versions are read from each package's zarf.yaml; signatures
+// carried in the package layers are preserved.
+func (p *defaultPusher) PushBundlePackages(ctx context.Context, bundleDir, prefix string, opts PushOptions) ([]PackagePushResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleDir == "" {
+		return nil, EmptyParameterError{Name: "bundleDir"}
+	}
+	if prefix == "" {
+		return nil, EmptyParameterError{Name: "prefix"}
+	}
+
+	ociDir := filepath.Join(bundleDir, "oci")
+	indexPath := filepath.Join(ociDir, ocispec.ImageIndexFile)
+	idxBytes, err := os.ReadFile(indexPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrReadIndex, indexPath, err)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(idxBytes, &idx); err != nil {
+		return nil, fmt.Errorf("parsing bundle index: %w: %w", ErrParseIndex, err)
+	}
+	if !IsBundleIndex(idx) {
+		return nil, fmt.Errorf("%s does not appear to be a UDS bundle: index does not declare artifactType %s: %w", bundleDir, MediaTypeBundle, ErrInvalidBundle)
+	}
+	store, err := OpenStore(ociDir)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := splitPackages(ctx, store, idx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	results := make([]PackagePushResult, 0, len(packages))
+	pushed := make(map[string]bool)
+	for _, pkg := range packages {
+		if !pushed[pkg.ref] {
+			log.Info("pushing bundle package", "package", pkg.name, "ref", pkg.ref)
+			if _, err := pushToRemote(ctx, store.Store, pkg.desc, pkg.ref, &opts); err != nil {
+				return nil, fmt.Errorf("pushing package %q: %w", pkg.name, err)
+			}
+			pushed[pkg.ref] = true
+		}
+		results = append(results, PackagePushResult{Package: pkg.name, OCIReference: pkg.ref, Digest: pkg.desc.Digest.String()})
+	}
+	return results, nil
+}
+
+// splitPackages resolves the standalone reference of every package manifest
+// in idx. Bundle packages built from the same Zarf package share a reference;
+// different packages that would share one are rejected.
+func splitPackages(ctx context.Context, store content.Fetcher, idx ocispec.Index, prefix string) ([]splitPackage, error) {
+	var packages []splitPackage
+	byRef := make(map[string]splitPackage)
+	for _, desc := range idx.Manifests {
+		name := desc.Annotations[AnnotationPackageName]
+		if name == "" || desc.ArtifactType == MediaTypeBundleDefinition || !IsImageManifestMediaType(desc.MediaType) {
+			continue
+		}
+		metadata, err := readZarfPackageMetadata(ctx, store, desc)
+		if err != nil {
+			return nil, fmt.Errorf("%w for package %q: %w", ErrReadPackageMetadata, name, err)
+		}
+		ref, err := standalonePackageReference(prefix, metadata)
+		if err != nil {
+			return nil, fmt.Errorf("%w for package %q: %w", ErrReadPackageMetadata, name, err)
+		}
+		pkg := splitPackage{name: name, desc: desc, ref: ref}
+		if existing, ok := byRef[ref]; ok && existing.desc.Digest != desc.Digest {
+			return nil, ConflictingPackageReferenceError{Reference: ref, Packages: []string{existing.name, name}}
+		}
+		byRef[ref] = pkg
+		packages = append(packages, pkg)
+	}
+	if len(packages) == 0 {
+		return nil, fmt.Errorf("bundle has no package manifests: %w", ErrBundlePackageNotFound)
+	}
+	return packages, nil
+}
+
+// readZarfPackageMetadata reads the zarf.yaml layer of the package manifest desc.
+func readZarfPackageMetadata(ctx context.Context, store content.Fetcher, desc ocispec.Descriptor) (zarfPackageMetadata, error) {
+	var metadata zarfPackageMetadata
+	manifestBytes, err := FetchBytes(ctx, store, desc)
+	if err != nil {
+		return metadata, err
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return metadata, fmt.Errorf("parsing package manifest %s: %w", desc.Digest, err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.Annotations[ocispec.AnnotationTitle] != "zarf.yaml" {
+			continue
+		}
+		data, err := FetchBytes(ctx, store, layer)
+		if err != nil {
+			return metadata, err
+		}
+		if err := yaml.Unmarshal(data, &metadata); err != nil {
+			return metadata, fmt.Errorf("parsing zarf.yaml: %w", err)
+		}
+		return metadata, nil
+	}
+	return metadata, fmt.Errorf("package manifest %s has no zarf.yaml layer", desc.Digest)
+}
+
+// standalonePackageReference returns <prefix>/<name>:<version>[-<flavor>],
+// keeping any oci:// scheme on prefix.
+func standalonePackageReference(prefix string, metadata zarfPackageMetadata) (string, error) {
+	if metadata.Metadata.Name == "" {
+		return "", fmt.Errorf("zarf.yaml has no metadata.name")
+	}
+	if metadata.Metadata.Version == "" {
+		return "", fmt.Errorf("package %q has no metadata.version to tag it with", metadata.Metadata.Name)
+	}
+	tag := metadata.Metadata.Version
+	if metadata.Build.Flavor != "" {
+		tag += "-" + metadata.Build.Flavor
+	}
+	ref := strings.TrimSuffix(prefix, "/") + "/" + metadata.Metadata.Name + ":" + tag
+	if _, err := ReferenceIdentifier(ref); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
@@ -0,0 +1,32 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package oci
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandalonePackageReference(t *testing.T) {
+	t.Parallel()
+
+	var metadata zarfPackageMetadata
+	metadata.Metadata.Name = "podinfo"
+	metadata.Metadata.Version = "6.4.0"
+
+	ref, err := standalonePackageReference("oci://registry.example.com/packages/", metadata)
+	require.NoError(t, err)
+	assert.Equal(t, "oci://registry.example.com/packages/podinfo:6.4.0", ref)
+
+	metadata.Build.Flavor = "upstream"
+	ref, err = standalonePackageReference("registry.example.com/packages", metadata)
+	require.NoError(t, err)
+	assert.Equal(t, "registry.example.com/packages/podinfo:6.4.0-upstream", ref)
+
+	metadata.Metadata.Version = ""
+	_, err = standalonePackageReference("registry.example.com/packages", metadata)
+	require.ErrorContains(t, err, "no metadata.version")
+}
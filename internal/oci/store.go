@@ -148,7 +148,7 @@ func (s *Store) PruneUnreferencedBlobs(ctx context.Context, streams iostreams.IO
 		if err := digest.Validate(); err != nil {
 			return fmt.Errorf("%w %s/%s: %w", ErrParseBlobDigest, algorithm, encoded, err)
 		}
-		if keep[digest] {
+		if _, ok := keep[digest]; ok {
 			return nil
 		}
 		if err := s.Delete(ctx, ocispec.Descriptor{Digest: digest}); err != nil {
@@ -181,9 +181,25 @@ func VerifyLocalLayoutGraph(ctx context.Context, root string, index []byte) erro
 	return store.VerifyGraph(ctx, parsed.Manifests)
 }
 
-func reachableDigests(ctx context.Context, store content.Fetcher, roots []ocispec.Descriptor, verifyLeafContent bool) (map[godigest.Digest]bool, error) {
+// ReachableBlobs returns the size of every blob reachable from roots, keyed by
+// digest. Manifest-like nodes are fetched and verified to discover their
+// successors; leaf blobs are never read, so fetcher only needs to hold the
+// manifests of the graph.
+func ReachableBlobs(ctx context.Context, fetcher content.Fetcher, roots []ocispec.Descriptor) (map[godigest.Digest]int64, error) {
+	seen, err := reachableDigests(ctx, fetcher, roots, false)
+	if err != nil {
+		return nil, err
+	}
+	sizes := make(map[godigest.Digest]int64, len(seen))
+	for _, desc := range seen {
+		sizes[desc.Digest] = desc.Size
+	}
+	return sizes, nil
+}
+
+func reachableDigests(ctx context.Context, store content.Fetcher, roots []ocispec.Descriptor, verifyLeafContent bool) (map[godigest.Digest]ocispec.Descriptor, error) {
 	queue := append([]ocispec.Descriptor(nil), roots...)
-	seen := make(map[godigest.Digest]bool)
+	seen := make(map[godigest.Digest]ocispec.Descriptor)
 	seenSizes := make(map[godigest.Digest]int64)
 	for len(queue) > 0 {
 		desc := queue[0]
@@ -192,10 +208,10 @@ func reachableDigests(ctx context.Context, store content.Fetcher, roots []ocispe
 			return nil, ConflictingDescriptorSizeError{Digest: desc.Digest, RecordedSize: size, ActualSize: desc.Size}
 		}
 		seenSizes[desc.Digest] = desc.Size
-		if seen[desc.Digest] {
+		if _, ok := seen[desc.Digest]; ok {
 			continue
 		}
-		seen[desc.Digest] = true
+		seen[desc.Digest] = desc
 		successors, err := content.Successors(ctx, store, desc)
 		if err != nil {
 			return nil, fmt.Errorf("%w of %s: %w", ErrReadSuccessors, desc.Digest, err)
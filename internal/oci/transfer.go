@@ -6,10 +6,13 @@ package oci
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
 
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/google/go-containerregistry/pkg/name"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	oraci "oras.land/oras-go/v2/content/oci"
 )
 
@@ -61,7 +64,9 @@ func pullCopyOptions(ctx context.Context, opts *PullOptions) (oras.CopyOptions,
 // repository, then publishes the root index at the tag: this architecture's
 // entry is inserted or replaced and other-arch bundle entries already present
 // are preserved (ADR-0015). child must carry Platform and ArtifactType.
-func pushBundleToRemote(ctx context.Context, store *oraci.Store, child ocispec.Descriptor, ref string, opts *PushOptions, signature []byte, hasSignature bool) (*PushResult, error) {
+// Signature evidence and attestations are published as referrers of child.
+// store is the local layout being pushed, or the source registry of a copy.
+func pushBundleToRemote(ctx context.Context, store content.ReadOnlyStorage, child ocispec.Descriptor, ref string, opts *PushOptions, signatures [][]byte, attestations []BundleAttestation) (*PushResult, error) {
 	dst, err := resolvePushTarget(ctx, ref, opts)
 	if err != nil {
 		return nil, fmt.Errorf("resolving push target %s: %w: %w", ref, ErrResolveReference, err)
@@ -85,10 +90,8 @@ func pushBundleToRemote(ctx context.Context, store *oraci.Store, child ocispec.D
 			return nil, fmt.Errorf("checking bundle content at %s: %w: %w", ref, ErrCheckBundleContent, err)
 		}
 		if exists {
-			if hasSignature {
-				if err := PublishBundleSignature(ctx, dst, child, signature, false); err != nil {
-					return nil, fmt.Errorf("publishing bundle signature: %w", err)
-				}
+			if err := publishBundleEvidence(ctx, dst, child, signatures, attestations); err != nil {
+				return nil, err
 			}
 			return &PushResult{OCIReference: ref}, nil
 		}
@@ -100,14 +103,28 @@ func pushBundleToRemote(ctx context.Context, store *oraci.Store, child ocispec.D
 	}
 
 	// Copy the child graph without tagging it; the root index published below
-	// is the only tagged object.
-	if err := copyGraph(ctx, store, dst, child, copyOpts.CopyGraphOptions); err != nil {
+	// is the only tagged object. Blobs the registry already has are skipped and
+	// failed blob pushes are retried individually.
+	log := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	var skipped atomic.Int64
+	onCopySkipped := copyOpts.OnCopySkipped
+	copyOpts.OnCopySkipped = func(ctx context.Context, desc ocispec.Descriptor) error {
+		skipped.Add(1)
+		log.Debug("registry already has content", "digest", desc.Digest.String())
+		if onCopySkipped != nil {
+			return onCopySkipped(ctx, desc)
+		}
+		return nil
+	}
+	retrying := &retryingTarget{Target: dst, src: store, log: log}
+	if err := copyGraph(ctx, store, retrying, child, copyOpts.CopyGraphOptions); err != nil {
 		return nil, fmt.Errorf("pushing bundle content to %s: %w: %w", ref, ErrPushContent, err)
 	}
-	if hasSignature {
-		if err := PublishBundleSignature(ctx, dst, child, signature, false); err != nil {
-			return nil, fmt.Errorf("publishing bundle signature: %w", err)
-		}
+	if n := skipped.Load(); n > 0 {
+		log.Info("skipped content already in the registry", "ref", ref, "count", n)
+	}
+	if err := publishBundleEvidence(ctx, dst, child, signatures, attestations); err != nil {
+		return nil, err
 	}
 	if err := PushReferenceBytes(ctx, dst, rootDesc, rootBytes, tag); err != nil {
 		return nil, fmt.Errorf("pushing root index to %s: %w: %w", ref, ErrPushRootIndex, err)
@@ -115,6 +132,21 @@ func pushBundleToRemote(ctx context.Context, store *oraci.Store, child ocispec.D
 	return &PushResult{OCIReference: ref}, nil
 }
 
+// publishBundleEvidence publishes signature evidence and attestations for child.
+func publishBundleEvidence(ctx context.Context, dst oras.Target, child ocispec.Descriptor, signatures [][]byte, attestations []BundleAttestation) error {
+	for _, attestation := range attestations {
+		if err := PublishBundleAttestation(ctx, dst, child, attestation); err != nil {
+			return fmt.Errorf("publishing bundle attestation %s: %w", attestation.MediaType, err)
+		}
+	}
+	if len(signatures) != 0 {
+		if err := PublishBundleSignatures(ctx, dst, child, signatures, false); err != nil {
+			return fmt.Errorf("publishing bundle signature: %w", err)
+		}
+	}
+	return nil
+}
+
 // pushToRemote tags root in store and copies it (and all it references) to ref.
 func pushToRemote(ctx context.Context, store *oraci.Store, root ocispec.Descriptor, ref string, opts *PushOptions) (*PushResult, error) {
 	if err := store.Tag(ctx, root, "push-root"); err != nil {
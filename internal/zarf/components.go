@@ -0,0 +1,94 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+	"github.com/mholt/archives"
+	"github.com/zarf-dev/zarf/src/pkg/packager/layout"
+)
+
+// zarfComponents is the part of zarf.yaml that lists component names.
+type zarfComponents struct {
+	Components []struct {
+		Name string `yaml:"name"`
+	} `yaml:"components"`
+}
+
+// LocalPackageComponents returns the component names declared in the
+// zarf.yaml of a local package directory or .tar.zst archive, resolved
+// against bundleDir. ok is false for OCI sources, whose zarf.yaml cannot be
+// read without the registry.
+func LocalPackageComponents(source, bundleDir string) (components []string, ok bool, err error) {
+	if IsRemoteSource(source) {
+		return nil, false, nil
+	}
+	if strings.TrimSpace(source) == "" {
+		return nil, false, ErrLocalSourcePathRequired
+	}
+	path := (&localSource{path: source, bundleDir: bundleDir}).resolvedPath()
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, false, fmt.Errorf("stat %q: %w: %w", path, ErrStatLocalPackage, err)
+	}
+	var data []byte
+	switch {
+	case info.IsDir():
+		data, err = os.ReadFile(filepath.Join(path, layout.ZarfYAML))
+	case strings.HasSuffix(path, ".tar.zst"):
+		data, err = readArchiveZarfYAML(path)
+	default:
+		return nil, false, fmt.Errorf("unsupported local package source %q: %w", source, ErrInvalidLocalPackageSource)
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("%w from %q: %w", ErrFetchPackageMetadata, source, err)
+	}
+	var pkg zarfComponents
+	if err := yaml.Unmarshal(data, &pkg); err != nil {
+		return nil, false, fmt.Errorf("%w from %q: parsing zarf.yaml: %w", ErrFetchPackageMetadata, source, err)
+	}
+	for _, c := range pkg.Components {
+		components = append(components, c.Name)
+	}
+	return components, true, nil
+}
+
+// readArchiveZarfYAML streams a Zarf package archive until its zarf.yaml.
+func readArchiveZarfYAML(path string) (_ []byte, retErr error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	zr, err := (archives.Zstd{}).OpenReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = zr.Close() }()
+	tr := tar.NewReader(zr)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("archive has no %s", layout.ZarfYAML)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if filepath.Clean(hdr.Name) == layout.ZarfYAML {
+			return io.ReadAll(tr)
+		}
+	}
+}
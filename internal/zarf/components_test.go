@@ -0,0 +1,38 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarf-dev/zarf/src/pkg/packager/layout"
+)
+
+func TestLocalPackageComponents(t *testing.T) {
+	bundleDir := t.TempDir()
+	pkgDir := filepath.Join(bundleDir, "pkg")
+	require.NoError(t, os.MkdirAll(pkgDir, 0o755))
+	zarfYAML := "kind: ZarfPackageConfig\ncomponents:\n  - name: core\n  - name: extra\n"
+	require.NoError(t, os.WriteFile(filepath.Join(pkgDir, layout.ZarfYAML), []byte(zarfYAML), filesystem.PrivateFileMode))
+	require.NoError(t, writeTestTarZst(t, filepath.Join(bundleDir, "zarf-package-pkg-amd64-1.0.0.tar.zst"), pkgDir))
+
+	for _, source := range []string{"./pkg", "zarf-package-pkg-amd64-1.0.0.tar.zst"} {
+		components, ok, err := LocalPackageComponents(source, bundleDir)
+		require.NoError(t, err, source)
+		assert.True(t, ok, source)
+		assert.Equal(t, []string{"core", "extra"}, components, source)
+	}
+
+	_, ok, err := LocalPackageComponents("oci://ghcr.io/uds/core:1.0.0", bundleDir)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = LocalPackageComponents("./missing", bundleDir)
+	require.ErrorIs(t, err, ErrStatLocalPackage)
+}
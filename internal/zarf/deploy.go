@@ -87,6 +87,9 @@ type DeployPackageOptions struct {
 	// Streams carries operation diagnostics.
 	Streams    iostreams.IOStreams
 	bundlePath string
+	// bundleMetadata is recorded on the deployed package when the package is
+	// deployed as part of a bundle.
+	bundleMetadata spec.Metadata
 }
 
 // ZarfDeployer implements Deployer using the Zarf Go library.
@@ -210,6 +213,7 @@ func (d *ZarfDeployer) DeployBundle(ctx context.Context, b *spec.UDSBundle, opts
 		PackageDeployHooks: opts.PackageDeployHooks,
 		Streams:            s,
 		bundlePath:         opts.BundlePath,
+		bundleMetadata:     b.Metadata,
 	}
 
 	s.Info("deploying bundle", "packages", deployCount, "levels", len(levels), "concurrency", concurrency)
@@ -316,6 +320,10 @@ func (d *ZarfDeployer) DeployPackage(ctx context.Context, pkg *spec.Package, opt
 		NamespaceOverride: pkg.Namespace, // empty string is fine - Zarf ignores it
 	}
 
+	if opts.bundleMetadata.Name != "" {
+		annotateBundlePackage(&pkgLayout.Pkg, opts.bundleMetadata, pkg)
+	}
+
 	log.Info("deploying zarf package to cluster", "name", pkg.Name)
 
 	hooks := opts.PackageDeployHooks.withDefaults()
@@ -363,7 +371,7 @@ func (d *ZarfDeployer) prepareValuesAndVariables(ctx context.Context, streams io
 
 		// 2. Template {{ .vars.* }} placeholders using config variables
 		var filesToParse []string
-		filesToParse, err = templateValuesFiles(ctx, resolved, configVars, opts.Config.Options.TmpDir)
+		filesToParse, err = templateValuesFiles(ctx, resolved, configVars, opts.BundleDir, opts.Config.Options.TmpDir)
 		// Temp files are fully consumed by ParseFiles below or any subsequent error; clean up on return.
 		if configVars != nil {
 			defer cleanupTempFiles(ctx, streams, filesToParse)
@@ -415,12 +423,13 @@ func resolveValuesFiles(files []string, bundleDir string) []string {
 // Access: {{ .vars.domain }}, {{ .vars.logging.vectorEnabled }}, etc.
 //
 // Templates are rendered by Go's stdlib text/template — authors get range, if,
-// with, index, printf, dot-access, pipe, and whitespace-trim markers.
-// Lists and maps are rendered with explicit range loops.
+// with, index, printf, dot-access, pipe, and whitespace-trim markers — plus the
+// sandboxed helpers from valuesTemplateFuncs, whose file helper reads relative
+// to bundleDir.
 //
-// Missing keys produce a clear error (template.Option("missingkey=error")).
+// Missing keys produce an error naming the file and line (template.Option("missingkey=error")).
 // If vars is nil, the original file paths are returned unchanged (no temp copies created).
-func templateValuesFiles(_ context.Context, files []string, vars bundleinternal.Variables, tmpDir string) ([]string, error) {
+func templateValuesFiles(_ context.Context, files []string, vars bundleinternal.Variables, bundleDir, tmpDir string) ([]string, error) {
 	if vars == nil {
 		return files, nil
 	}
@@ -428,6 +437,7 @@ func templateValuesFiles(_ context.Context, files []string, vars bundleinternal.
 	// bundleinternal.Variables is map[string]any underneath, and Go templates traverse named map
 	// types via reflection at any depth, so no conversion of nested levels is needed.
 	data := map[string]any{"vars": vars}
+	funcs := valuesTemplateFuncs(vars, bundleDir)
 	result := make([]string, 0, len(files))
 
 	for _, f := range files {
@@ -436,14 +446,15 @@ func templateValuesFiles(_ context.Context, files []string, vars bundleinternal.
 			return result, fmt.Errorf("%w %q: %w", ErrReadValuesFile, f, err)
 		}
 
-		tmpl, err := template.New(filepath.Base(f)).Option("missingkey=error").Parse(string(src))
+		name := filepath.Base(f)
+		tmpl, err := template.New(name).Option("missingkey=error").Funcs(funcs).Parse(string(src))
 		if err != nil {
-			return result, fmt.Errorf("%w %q: %w", ErrParseValuesTemplate, f, err)
+			return result, fmt.Errorf("%w: %w", ErrParseValuesTemplate, newValuesTemplateError(f, name, err))
 		}
 
 		var buf bytes.Buffer
 		if err := tmpl.Execute(&buf, data); err != nil {
-			return result, fmt.Errorf("%w %q: %w", ErrRenderValues, f, err)
+			return result, fmt.Errorf("%w: %w", ErrRenderValues, newValuesTemplateError(f, name, err))
 		}
 
 		tmp, err := os.CreateTemp(tmpDir, "uds-values-*.yaml")
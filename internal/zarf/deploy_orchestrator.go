@@ -13,7 +13,6 @@ import (
 	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/hashicorp/hcl/v2"
-	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -166,7 +165,7 @@ func packageSourceRange(bundlePath, packageName string) (hcl.Range, bool) {
 	if err != nil {
 		return hcl.Range{}, false
 	}
-	file, diags := hclsyntax.ParseConfig(src, bundlePath, hcl.Pos{Line: 1, Column: 1})
+	file, diags := bundleinternal.ParseHCL(src, bundlePath)
 	if diags.HasErrors() {
 		return hcl.Range{}, false
 	}
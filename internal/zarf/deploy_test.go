@@ -330,16 +330,40 @@ func TestTemplateValuesFiles(t *testing.T) {
 			wantErr:      "function \"env\" not defined",
 		},
 		{
-			name:         "default helper is undefined",
+			name:         "default keeps present value",
 			fileContents: []string{"x: {{ default \"fallback\" .vars.present }}"},
 			vars:         bundleinternal.Variables{"present": "yes"},
-			wantErr:      "function \"default\" not defined",
+			wantOutputs:  []string{"x: yes"},
 		},
 		{
-			name:         "toYaml helper is undefined",
-			fileContents: []string{"x: {{ toYaml .vars.x }}"},
-			vars:         bundleinternal.Variables{"x": "y"},
-			wantErr:      "function \"toYaml\" not defined",
+			name:         "lookup with default for unset variable",
+			fileContents: []string{"level: {{ lookup \"logging.level\" | default \"info\" }}\nenabled: {{ lookup \"logging.enabled\" | default true }}"},
+			vars:         bundleinternal.Variables{"logging": bundleinternal.Variables{"enabled": false}},
+			wantOutputs:  []string{"level: info\nenabled: false"},
+		},
+		{
+			name:         "toYaml with nindent",
+			fileContents: []string{"config:{{ toYaml .vars.config | nindent 2 }}"},
+			vars:         bundleinternal.Variables{"config": bundleinternal.Variables{"b": []any{"p", "q"}, "a": float64(1)}},
+			wantOutputs:  []string{"config:\n  a: 1\n  b:\n    - p\n    - q"},
+		},
+		{
+			name:         "b64enc and quote",
+			fileContents: []string{"secret: {{ b64enc .vars.password }}\nname: {{ quote .vars.name }}"},
+			vars:         bundleinternal.Variables{"password": "hunter2", "name": "a \"b\""},
+			wantOutputs:  []string{"secret: aHVudGVyMg==\nname: \"a \\\"b\\\"\""},
+		},
+		{
+			name:         "required fails on empty value",
+			fileContents: []string{"x: ok\ny: {{ required \"domain must be set\" .vars.domain }}"},
+			vars:         bundleinternal.Variables{"domain": ""},
+			wantErr:      ":2: executing",
+		},
+		{
+			name:         "missing variable reports line",
+			fileContents: []string{"a: 1\nb: 2\nc: {{ .vars.missing }}"},
+			vars:         bundleinternal.Variables{"x": "ok"},
+			wantErr:      ":3: executing",
 		},
 	}
 
@@ -350,7 +374,7 @@ func TestTemplateValuesFiles(t *testing.T) {
 				inputPaths = append(inputPaths, writeTempYAML(t, content))
 			}
 
-			outPaths, err := templateValuesFiles(t.Context(), inputPaths, tt.vars, t.TempDir())
+			outPaths, err := templateValuesFiles(t.Context(), inputPaths, tt.vars, t.TempDir(), t.TempDir())
 
 			if tt.wantErr != "" {
 				require.ErrorContains(t, err, tt.wantErr)
@@ -380,6 +404,71 @@ func TestTemplateValuesFiles(t *testing.T) {
 	}
 }
 
+func TestTemplateValuesFiles_FileHelperAndErrorLocation(t *testing.T) {
+	bundleDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(bundleDir, "certs"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(bundleDir, "certs", "ca.pem"), []byte("line1\nline2"), 0o600))
+	outside := filepath.Join(t.TempDir(), "secret.txt")
+	require.NoError(t, os.WriteFile(outside, []byte("secret"), 0o600))
+	vars := bundleinternal.Variables{"k": "v"}
+
+	valuesPath := filepath.Join(bundleDir, "values.yaml")
+	require.NoError(t, os.WriteFile(valuesPath, []byte("ca: |{{ file \"certs/ca.pem\" | nindent 2 }}"), 0o600))
+	outPaths, err := templateValuesFiles(t.Context(), []string{valuesPath}, vars, bundleDir, t.TempDir())
+	require.NoError(t, err)
+	got, err := os.ReadFile(outPaths[0])
+	require.NoError(t, err)
+	assert.Equal(t, "ca: |\n  line1\n  line2", string(got))
+
+	for _, path := range []string{outside, "../" + filepath.Base(filepath.Dir(outside)) + "/secret.txt"} {
+		require.NoError(t, os.WriteFile(valuesPath, []byte(fmt.Sprintf("x: {{ file %q }}", path)), 0o600))
+		_, err = templateValuesFiles(t.Context(), []string{valuesPath}, vars, bundleDir, t.TempDir())
+		require.ErrorIs(t, err, ErrRenderValues, path)
+	}
+
+	require.NoError(t, os.WriteFile(valuesPath, []byte("a: 1\nb: {{ .vars.missing }}"), 0o600))
+	_, err = templateValuesFiles(t.Context(), []string{valuesPath}, vars, bundleDir, t.TempDir())
+	var templateErr ValuesTemplateError
+	require.ErrorAs(t, err, &templateErr)
+	assert.Equal(t, valuesPath, templateErr.File)
+	assert.Equal(t, 2, templateErr.Line)
+	require.ErrorContains(t, err, valuesPath+`:2: executing "values.yaml" at <.vars.missing>: map has no entry for key "missing"`)
+}
+
+func TestInlineValuesTemplateFiles(t *testing.T) {
+	bundleDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(bundleDir, "ca.pem"), []byte("line1\n\"quoted\""), 0o600))
+	vars := bundleinternal.Variables{"tls": true}
+
+	src := "ca: |{{ file \"ca.pem\" | nindent 2 }}\n{{ if .vars.tls }}b64: {{ b64enc (file \"ca.pem\") }}{{ end }}\n"
+	inlined, err := InlineValuesTemplateFiles("values.yaml", []byte(src), bundleDir)
+	require.NoError(t, err)
+	assert.NotContains(t, string(inlined), "file")
+
+	// The inlined template renders as the original did from bundleDir.
+	render := func(src []byte) string {
+		path := filepath.Join(t.TempDir(), "values.yaml")
+		require.NoError(t, os.WriteFile(path, src, 0o600))
+		out, err := templateValuesFiles(t.Context(), []string{path}, vars, bundleDir, t.TempDir())
+		require.NoError(t, err)
+		got, err := os.ReadFile(out[0])
+		require.NoError(t, err)
+		return string(got)
+	}
+	assert.Equal(t, render([]byte(src)), render(inlined))
+
+	_, err = InlineValuesTemplateFiles("values.yaml", []byte("a: 1\nb: {{ file .vars.path }}"), bundleDir)
+	var templateErr ValuesTemplateError
+	require.ErrorIs(t, err, ErrInlineValuesTemplateFile)
+	require.ErrorAs(t, err, &templateErr)
+	assert.Equal(t, 2, templateErr.Line)
+
+	_, err = InlineValuesTemplateFiles("values.yaml", []byte("b: {{ file \"../secret.txt\" }}"), bundleDir)
+	require.ErrorIs(t, err, ErrInlineValuesTemplateFile)
+	_, err = InlineValuesTemplateFiles("values.yaml", []byte("b: {{ file }"), bundleDir)
+	require.ErrorIs(t, err, ErrParseValuesTemplate)
+}
+
 func TestPrepareValuesAndVariables(t *testing.T) {
 	t.Run("scalars produce flattened SetVariables", func(t *testing.T) {
 		dir := t.TempDir()
@@ -683,3 +772,24 @@ func TestDeployBundleRebindsLoggerAfterPreDeployHook(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, errOut.String(), "deploying bundle")
 }
+
+func TestDeployBundlePassesBundleMetadataToPackages(t *testing.T) {
+	d := NewZarfDeployer(iostreams.IOStreams{}, nil)
+	b := &spec.UDSBundle{
+		UDS:      spec.UDSBlock{BundleAPIVersion: "uds.dev/v1alpha1"},
+		Metadata: spec.Metadata{Name: "test", Version: "1.0.0"},
+		Packages: []spec.Package{{Name: "alpha", Source: "oci://example/alpha:v1"}},
+	}
+
+	var got spec.Metadata
+	_, err := d.DeployBundle(t.Context(), b, DeployOptions{
+		Config: newDeployTestConfig(1),
+		PackageDeployFn: func(_ context.Context, _ *spec.Package, opts DeployPackageOptions) error {
+			got = opts.bundleMetadata
+			return nil
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, b.Metadata, got)
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"runtime"
+	"slices"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/zarf-dev/zarf/src/api/v1alpha1"
+	"github.com/zarf-dev/zarf/src/pkg/packager"
+	"github.com/zarf-dev/zarf/src/pkg/packager/filters"
+	"github.com/zarf-dev/zarf/src/pkg/state"
+)
+
+// Annotations recorded on each Zarf package deployed from a bundle. Zarf keeps
+// them in the deployed package state, so a bundle can be rebuilt from the
+// cluster without its definition. The name and version keys match those
+// written by earlier UDS releases.
+const (
+	AnnotationBundleName      = "dev.uds.bundle.name"
+	AnnotationBundleVersion   = "dev.uds.bundle.version"
+	AnnotationBundlePackage   = "dev.uds.bundle.package"
+	AnnotationBundleDependsOn = "dev.uds.bundle.depends-on"
+)
+
+// annotateBundlePackage records the bundle, package name and dependencies
+// on a Zarf package before it is deployed. Existing package annotations are
+// kept; bundle annotations take precedence.
+func annotateBundlePackage(zarfPkg *v1alpha1.ZarfPackage, metadata spec.Metadata, pkg *spec.Package) {
+	annotations := make(map[string]string, len(zarfPkg.Metadata.Annotations)+4)
+	maps.Copy(annotations, zarfPkg.Metadata.Annotations)
+	annotations[AnnotationBundleName] = metadata.Name
+	annotations[AnnotationBundleVersion] = metadata.Version
+	annotations[AnnotationBundlePackage] = pkg.Name
+	deps := make([]string, len(pkg.DependsOn))
+	for i, dep := range pkg.DependsOn {
+		deps[i] = dep.Name
+	}
+	annotations[AnnotationBundleDependsOn] = strings.Join(deps, ",")
+	zarfPkg.Metadata.Annotations = annotations
+}
+
+// DeployedBundle rebuilds the named bundle from the annotations on its
+// deployed Zarf packages. Package sources are not recorded and are left
+// empty; RemoveDeployedBundle removes packages from their cluster state.
+func (r *ZarfRemover) DeployedBundle(ctx context.Context, name string) (*spec.UDSBundle, error) {
+	records, err := r.deployedRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, _, err := bundleFromDeployed(name, records)
+	return b, err
+}
+
+// RemoveDeployedBundle removes the named bundle's deployed packages in reverse
+// dependency order without the bundle definition or package sources. When
+// packages is non-empty, only those package names are removed.
+func (r *ZarfRemover) RemoveDeployedBundle(ctx context.Context, name string, packages []string, opts RemovePackageOptions) (*RemoveResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	s := logger.Bind(r.streams, opts.Config.Options.LogLevel)
+
+	deployed, err := r.deployedRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b, records, err := bundleFromDeployed(name, deployed)
+	if err != nil {
+		return nil, err
+	}
+	s.Debug("deployed bundle rebuilt from cluster state", "name", name, "packages", len(b.Packages))
+
+	dag, err := bundleinternal.BuildDependencyGraph(ctx, s, b)
+	if err != nil {
+		return nil, fmt.Errorf("%w for deployed bundle %q: %w", ErrBuildDependencyGraph, name, err)
+	}
+	levels, err := dag.TopologicalLevels()
+	if err != nil {
+		return nil, fmt.Errorf("%w for deployed bundle %q: %w", ErrComputeDeploymentLevels, name, err)
+	}
+	if err := bundleinternal.ValidatePackageNames(packages, b.Packages); err != nil {
+		return nil, err
+	}
+	if levels, err = bundleinternal.FilterLevels(levels, packages); err != nil {
+		return nil, err
+	}
+
+	results, err := removeLevels(ctx, s, levels, r.recordRemover(records), opts)
+	if err != nil {
+		return nil, err
+	}
+	return &RemoveResult{BundleName: name, Packages: results}, nil
+}
+
+// DeployedOrphans returns the packages the cluster records as deployed by the
+// bundle named b.Metadata.Name that b no longer declares, sorted by name.
+// Packages deployed before package names were recorded cannot be matched to
+// b and are never reported. A bundle with no deployed packages has no orphans.
+func (r *ZarfRemover) DeployedOrphans(ctx context.Context, b *spec.UDSBundle) ([]string, error) {
+	if b == nil {
+		return nil, NilParameterError{Name: "bundle"}
+	}
+	deployed, err := r.deployedRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current, records, err := bundleFromDeployed(b.Metadata.Name, deployed)
+	if errors.Is(err, ErrDeployedBundleNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make(map[string]bool, len(b.Packages))
+	for _, pkg := range b.Packages {
+		declared[pkg.Name] = true
+	}
+	var orphans []string
+	for _, pkg := range current.Packages {
+		if declared[pkg.Name] {
+			continue
+		}
+		if records[pkg.Name].Data.Metadata.Annotations[AnnotationBundlePackage] == "" {
+			r.streams.Warn("deployed package has no recorded bundle package name and is not pruned", "bundle", b.Metadata.Name, "zarf_package", records[pkg.Name].Name)
+			continue
+		}
+		orphans = append(orphans, pkg.Name)
+	}
+	return orphans, nil
+}
+
+// PruneDeployedBundle removes the orphans reported by DeployedOrphans in
+// reverse dependency order, using the Zarf packages stored in cluster state.
+func (r *ZarfRemover) PruneDeployedBundle(ctx context.Context, b *spec.UDSBundle, opts RemovePackageOptions) (*RemoveResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	orphans, err := r.DeployedOrphans(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	if len(orphans) == 0 {
+		return &RemoveResult{BundleName: b.Metadata.Name}, nil
+	}
+	return r.RemoveDeployedBundle(ctx, b.Metadata.Name, orphans, opts)
+}
+
+// bundleFromDeployed selects the deployed packages annotated with the bundle
+// name and rebuilds their package set and dependency edges. Dependencies on
+// packages that are no longer deployed are dropped, since they impose no
+// removal order.
+func bundleFromDeployed(name string, deployed []state.DeployedPackage) (*spec.UDSBundle, map[string]state.DeployedPackage, error) {
+	b := &spec.UDSBundle{Metadata: spec.Metadata{Name: name}}
+	records := map[string]state.DeployedPackage{}
+	deps := map[string][]string{}
+	for _, record := range deployed {
+		annotations := record.Data.Metadata.Annotations
+		if annotations[AnnotationBundleName] != name {
+			continue
+		}
+		pkgName := annotations[AnnotationBundlePackage]
+		if pkgName == "" {
+			// Deployed before package names were recorded.
+			pkgName = record.Name
+		}
+		if existing, ok := records[pkgName]; ok {
+			return nil, nil, DuplicateDeployedPackageError{Bundle: name, Package: pkgName, Deployed: []string{existing.Name, record.Name}}
+		}
+		records[pkgName] = record
+		if b.Metadata.Version == "" {
+			b.Metadata.Version = annotations[AnnotationBundleVersion]
+		}
+		if dependsOn := annotations[AnnotationBundleDependsOn]; dependsOn != "" {
+			deps[pkgName] = strings.Split(dependsOn, ",")
+		}
+	}
+	if len(records) == 0 {
+		return nil, nil, fmt.Errorf("%w: %q", ErrDeployedBundleNotFound, name)
+	}
+
+	for _, pkgName := range slices.Sorted(maps.Keys(records)) {
+		pkg := spec.Package{Name: pkgName, Namespace: records[pkgName].NamespaceOverride}
+		for _, dep := range deps[pkgName] {
+			if _, ok := records[dep]; ok {
+				pkg.DependsOn = append(pkg.DependsOn, spec.PackageRef{Name: dep})
+			}
+		}
+		b.Packages = append(b.Packages, pkg)
+	}
+	return b, records, nil
+}
+
+// deployedRecordRemover removes packages using the Zarf package stored in
+// their cluster state instead of loading it from the package source.
+type deployedRecordRemover struct {
+	remover *ZarfRemover
+	records map[string]state.DeployedPackage
+}
+
+// RemovePackage removes the deployed package recorded for pkg.Name.
+func (d *deployedRecordRemover) RemovePackage(ctx context.Context, pkg *spec.Package, opts RemovePackageOptions) error {
+	record, ok := d.records[pkg.Name]
+	if !ok {
+		return ErrPackageNotDeployed
+	}
+	s := logger.Bind(d.remover.streams, opts.Config.Options.LogLevel)
+	s.Debug("preparing deployed package removal", "name", pkg.Name, "zarf_package", record.Name, "namespace", record.NamespaceOverride)
+	ctx = newZarfLoggerContext(ctx, s)
+
+	c, err := d.remover.getCluster(ctx)
+	if err != nil {
+		return err
+	}
+	zarfPkg := record.Data
+	zarfPkg.Components, err = filters.ByLocalOS(runtime.GOOS).Apply(zarfPkg)
+	if err != nil {
+		return fmt.Errorf("package %q: %w: %w", pkg.Name, ErrApplyComponentFilter, err)
+	}
+	removeOpts := packager.RemoveOptions{
+		Cluster:           c,
+		Timeout:           helmTimeout,
+		NamespaceOverride: record.NamespaceOverride,
+	}
+	if err := packager.Remove(ctx, zarfPkg, removeOpts); err != nil {
+		return fmt.Errorf("package %q: %w: %w", pkg.Name, ErrRemovePackage, err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zarf-dev/zarf/src/api/v1alpha1"
+	"github.com/zarf-dev/zarf/src/pkg/state"
+)
+
+// deployedRecord returns deployed package state annotated as a package of
+// bundle "app", the way DeployPackage records it.
+func deployedRecord(zarfName, namespace, pkgName string, dependsOn ...string) state.DeployedPackage {
+	zarfPkg := v1alpha1.ZarfPackage{Metadata: v1alpha1.ZarfMetadata{Name: zarfName, Annotations: map[string]string{"owner": "platform"}}}
+	pkg := &spec.Package{Name: pkgName}
+	for _, dep := range dependsOn {
+		pkg.DependsOn = append(pkg.DependsOn, spec.PackageRef{Name: dep})
+	}
+	annotateBundlePackage(&zarfPkg, spec.Metadata{Name: "app", Version: "1.2.0"}, pkg)
+	return state.DeployedPackage{Name: zarfName, Data: zarfPkg, NamespaceOverride: namespace}
+}
+
+func TestAnnotateBundlePackage(t *testing.T) {
+	record := deployedRecord("podinfo", "", "frontend", "core", "db")
+	assert.Equal(t, map[string]string{
+		"owner":                   "platform",
+		AnnotationBundleName:      "app",
+		AnnotationBundleVersion:   "1.2.0",
+		AnnotationBundlePackage:   "frontend",
+		AnnotationBundleDependsOn: "core,db",
+	}, record.Data.Metadata.Annotations)
+}
+
+func TestBundleFromDeployed(t *testing.T) {
+	legacy := state.DeployedPackage{Name: "legacy", Data: v1alpha1.ZarfPackage{Metadata: v1alpha1.ZarfMetadata{
+		Name:        "legacy",
+		Annotations: map[string]string{AnnotationBundleName: "app", AnnotationBundleVersion: "1.0.0"},
+	}}}
+	other := deployedRecord("other", "", "other")
+	other.Data.Metadata.Annotations[AnnotationBundleName] = "other-bundle"
+	deployed := []state.DeployedPackage{
+		deployedRecord("podinfo", "web", "frontend", "core", "removed"),
+		deployedRecord("uds-core", "", "core"),
+		legacy,
+		other,
+	}
+
+	b, records, err := bundleFromDeployed("app", deployed)
+	require.NoError(t, err)
+	assert.Equal(t, spec.Metadata{Name: "app", Version: "1.2.0"}, b.Metadata)
+	assert.Equal(t, []spec.Package{
+		{Name: "core"},
+		{Name: "frontend", Namespace: "web", DependsOn: []spec.PackageRef{{Name: "core"}}},
+		{Name: "legacy"},
+	}, b.Packages)
+	assert.Equal(t, "podinfo", records["frontend"].Name)
+
+	_, _, err = bundleFromDeployed("missing", deployed)
+	require.ErrorIs(t, err, ErrDeployedBundleNotFound)
+
+	_, _, err = bundleFromDeployed("app", append(deployed, deployedRecord("podinfo", "other-ns", "frontend")))
+	var duplicate DuplicateDeployedPackageError
+	require.ErrorAs(t, err, &duplicate)
+	assert.Equal(t, []string{"podinfo", "podinfo"}, duplicate.Deployed)
+}
+
+func TestZarfRemover_RemoveDeployedBundle(t *testing.T) {
+	mock := &mockRemover{}
+	opts := RemovePackageOptions{Config: newDeployTestConfig(1)}
+	var gotRecords map[string]state.DeployedPackage
+	r := &ZarfRemover{
+		deployedLoaded: true,
+		deployedList: []state.DeployedPackage{
+			deployedRecord("uds-core", "", "core"),
+			deployedRecord("podinfo", "web", "frontend", "core"),
+			deployedRecord("api", "", "backend", "core"),
+			deployedRecord("gateway", "", "edge", "frontend", "backend"),
+		},
+		recordRemover: func(records map[string]state.DeployedPackage) packageRemover {
+			gotRecords = records
+			return mock
+		},
+	}
+
+	result, err := r.RemoveDeployedBundle(t.Context(), "app", nil, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "app", result.BundleName)
+	assert.Equal(t, []string{"edge", "backend", "frontend", "core"}, mock.removedNames())
+	assert.Len(t, gotRecords, 4)
+	for _, pkg := range result.Packages {
+		assert.Equal(t, RemovePackageStatusRemoved, pkg.Status)
+	}
+
+	mock.RemovedPackages = nil
+	result, err = r.RemoveDeployedBundle(t.Context(), "app", []string{"edge"}, opts)
+	require.NoError(t, err)
+	assert.Equal(t, []RemovePackageResult{{Name: "edge", Status: RemovePackageStatusRemoved}}, result.Packages)
+
+	_, err = r.RemoveDeployedBundle(t.Context(), "app", []string{"nope"}, opts)
+	require.Error(t, err)
+	_, err = r.RemoveDeployedBundle(t.Context(), "app", nil, RemovePackageOptions{})
+	require.Error(t, err)
+}
+
+func TestZarfRemover_PruneDeployedBundle(t *testing.T) {
+	mock := &mockRemover{}
+	opts := RemovePackageOptions{Config: newDeployTestConfig(1)}
+	legacy := state.DeployedPackage{Name: "legacy", Data: v1alpha1.ZarfPackage{Metadata: v1alpha1.ZarfMetadata{
+		Name:        "legacy",
+		Annotations: map[string]string{AnnotationBundleName: "app"},
+	}}}
+	r := &ZarfRemover{
+		deployedLoaded: true,
+		deployedList: []state.DeployedPackage{
+			deployedRecord("uds-core", "", "core"),
+			deployedRecord("podinfo", "web", "frontend", "core"),
+			deployedRecord("api", "", "backend", "core"),
+			deployedRecord("gateway", "", "edge", "frontend", "backend"),
+			legacy,
+		},
+		recordRemover: func(map[string]state.DeployedPackage) packageRemover { return mock },
+	}
+	b := &spec.UDSBundle{
+		Metadata: spec.Metadata{Name: "app"},
+		Packages: []spec.Package{{Name: "core"}, {Name: "backend"}},
+	}
+
+	orphans, err := r.DeployedOrphans(t.Context(), b)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"edge", "frontend"}, orphans)
+
+	result, err := r.PruneDeployedBundle(t.Context(), b, opts)
+	require.NoError(t, err)
+	assert.Equal(t, "app", result.BundleName)
+	assert.Equal(t, []string{"edge", "frontend"}, mock.removedNames())
+
+	mock.RemovedPackages = nil
+	result, err = r.PruneDeployedBundle(t.Context(), &spec.UDSBundle{Metadata: spec.Metadata{Name: "new"}}, opts)
+	require.NoError(t, err)
+	assert.Empty(t, result.Packages)
+	assert.Empty(t, mock.removedNames())
+
+	_, err = r.DeployedOrphans(t.Context(), nil)
+	require.Error(t, err)
+}
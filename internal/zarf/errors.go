@@ -6,6 +6,7 @@ package zarf
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -67,14 +68,20 @@ var (
 	ErrCreateTemporaryValuesFile    = errors.New("creating temporary values file")
 	ErrWriteTemporaryValuesFile     = errors.New("writing temporary values file")
 	ErrCloseTemporaryValuesFile     = errors.New("closing temporary values file")
+	ErrInlineValuesTemplateFile     = errors.New("inlining file into values template")
 	ErrBundleDirRequired            = errors.New("bundle directory is required")
 	ErrStatPackageManifest          = errors.New("stating package manifest")
 	ErrOpenOCILayout                = errors.New("opening OCI layout")
+	ErrResolvePackageVersion        = errors.New("resolving package version")
+	ErrDeployedBundleNotFound       = errors.New("no deployed packages found for bundle")
 )
 
 var (
 	_ error = (*NilParameterError)(nil)
 	_ error = (*LayerPathEscapeError)(nil)
+	_ error = (*ValuesTemplateError)(nil)
+	_ error = (*NoMatchingVersionError)(nil)
+	_ error = (*DuplicateDeployedPackageError)(nil)
 )
 
 type NilParameterError struct{ Name string }
@@ -88,3 +95,41 @@ type LayerPathEscapeError struct{ Title string }
 func (e LayerPathEscapeError) Error() string {
 	return fmt.Sprintf("layer title %q escapes destination directory", e.Title)
 }
+
+// ValuesTemplateError locates a values file template failure.
+type ValuesTemplateError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e ValuesTemplateError) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %v", e.File, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e ValuesTemplateError) Unwrap() error { return e.Err }
+
+// NoMatchingVersionError reports a version constraint no repository tag satisfies.
+type NoMatchingVersionError struct {
+	Repository string
+	Constraint string
+}
+
+func (e NoMatchingVersionError) Error() string {
+	return fmt.Sprintf("%s: no tag satisfies version constraint %q", e.Repository, e.Constraint)
+}
+
+// DuplicateDeployedPackageError reports two deployed Zarf packages that claim
+// the same package of a bundle.
+type DuplicateDeployedPackageError struct {
+	Bundle   string
+	Package  string
+	Deployed []string
+}
+
+func (e DuplicateDeployedPackageError) Error() string {
+	return fmt.Sprintf("bundle %q package %q is recorded on more than one deployed package: %s", e.Bundle, e.Package, strings.Join(e.Deployed, ", "))
+}
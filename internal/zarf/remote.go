@@ -36,6 +36,13 @@ func (s *remoteSource) newZociRemoteForRef(ctx context.Context, ref string) (*zo
 }
 
 func (s *remoteSource) resolveFilteredLayers(ctx context.Context, filter filters.ComponentFilterStrategy) (*resolvedLayers, error) {
+	if _, _, ok := SourceConstraint(s.ref); ok {
+		version, err := s.resolveVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.ref = PinnedSource(s.ref, version)
+	}
 	remote, err := s.newZociRemote(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("creating OCI remote for %q: %w: %w", s.ref, ErrCreateOCIRemote, err)
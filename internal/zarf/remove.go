@@ -18,6 +18,7 @@ import (
 	"github.com/zarf-dev/zarf/src/pkg/cluster"
 	"github.com/zarf-dev/zarf/src/pkg/packager"
 	"github.com/zarf-dev/zarf/src/pkg/packager/filters"
+	"github.com/zarf-dev/zarf/src/pkg/state"
 )
 
 // RemoveResult represents the result of removing a bundle.
@@ -65,8 +66,12 @@ type ZarfRemover struct {
 	cluster        *cluster.Cluster
 	deployedMu     sync.Mutex
 	deployed       map[string]struct{}
+	deployedList   []state.DeployedPackage
 	deployedLoaded bool
 	pkgRemover     packageRemover
+	// recordRemover removes packages rebuilt from cluster state by
+	// RemoveDeployedBundle, keyed by bundle package name.
+	recordRemover func(map[string]state.DeployedPackage) packageRemover
 }
 
 // helmTimeout is the timeout for Helm operations during package removal.
@@ -89,6 +94,9 @@ func deployedKey(zarfName, namespaceOverride string) string {
 func NewZarfRemover(streams iostreams.IOStreams) *ZarfRemover {
 	r := &ZarfRemover{streams: streams}
 	r.pkgRemover = r
+	r.recordRemover = func(records map[string]state.DeployedPackage) packageRemover {
+		return &deployedRecordRemover{remover: r, records: records}
+	}
 	return r
 }
 
@@ -111,18 +119,37 @@ func (r *ZarfRemover) getCluster(ctx context.Context) (*cluster.Cluster, error)
 func (r *ZarfRemover) deployedPackages(ctx context.Context) (map[string]struct{}, error) {
 	r.deployedMu.Lock()
 	defer r.deployedMu.Unlock()
+	if err := r.loadDeployed(ctx); err != nil {
+		return nil, err
+	}
+	return r.deployed, nil
+}
+
+// deployedRecords returns the state of every deployed Zarf package, fetching
+// from the cluster on first call and caching the result.
+func (r *ZarfRemover) deployedRecords(ctx context.Context) ([]state.DeployedPackage, error) {
+	r.deployedMu.Lock()
+	defer r.deployedMu.Unlock()
+	if err := r.loadDeployed(ctx); err != nil {
+		return nil, err
+	}
+	return r.deployedList, nil
+}
+
+// loadDeployed fills the deployed-package caches. The caller holds deployedMu.
+func (r *ZarfRemover) loadDeployed(ctx context.Context) error {
 	if r.deployedLoaded {
-		return r.deployed, nil
+		return nil
 	}
 
 	c, err := r.getCluster(ctx)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	pkgs, err := c.GetDeployedZarfPackages(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("%w from cluster: %w", ErrReadDeployedPackages, err)
+		return fmt.Errorf("%w from cluster: %w", ErrReadDeployedPackages, err)
 	}
 
 	set := make(map[string]struct{}, len(pkgs))
@@ -130,8 +157,9 @@ func (r *ZarfRemover) deployedPackages(ctx context.Context) (map[string]struct{}
 		set[deployedKey(p.Name, p.NamespaceOverride)] = struct{}{}
 	}
 	r.deployed = set
+	r.deployedList = pkgs
 	r.deployedLoaded = true
-	return set, nil
+	return nil
 }
 
 // RemoveBundle removes the bundle's packages from the cluster, calling
@@ -184,6 +212,11 @@ func (r *ZarfRemover) RemoveBundle(ctx context.Context, b *spec.UDSBundle, packa
 // Removal is sequential to keep teardown predictable. Packages that signal
 // ErrPackageNotDeployed are counted as skipped rather than failed.
 func (r *ZarfRemover) removePackages(ctx context.Context, log iostreams.IOStreams, levels [][]*spec.Package, opts RemovePackageOptions) ([]RemovePackageResult, error) {
+	return removeLevels(ctx, log, levels, r.pkgRemover, opts)
+}
+
+// removeLevels removes levels in reverse order through remover.
+func removeLevels(ctx context.Context, log iostreams.IOStreams, levels [][]*spec.Package, remover packageRemover, opts RemovePackageOptions) ([]RemovePackageResult, error) {
 	totalPkgs := 0
 	for _, level := range levels {
 		totalPkgs += len(level)
@@ -199,7 +232,7 @@ func (r *ZarfRemover) removePackages(ctx context.Context, log iostreams.IOStream
 			pkgNum++
 			log.Info("removing package", "name", pkg.Name, "package", pkgNum, "total", totalPkgs)
 
-			if err := r.pkgRemover.RemovePackage(ctx, pkg, opts); err != nil {
+			if err := remover.RemovePackage(ctx, pkg, opts); err != nil {
 				if errors.Is(err, ErrPackageNotDeployed) {
 					log.Warn("skipping removal, package not deployed", "name", pkg.Name)
 					results = append(results, RemovePackageResult{Name: pkg.Name, Status: RemovePackageStatusSkipped})
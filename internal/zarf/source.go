@@ -54,12 +54,21 @@ type layerIdentity struct {
 	title  string
 }
 
+// IsRemoteSource reports whether source names a package in an OCI registry,
+// either by reference or by version constraint.
+func IsRemoteSource(source string) bool {
+	if _, _, ok := SourceConstraint(source); ok {
+		return true
+	}
+	return udsoci.IsOCIReference(source)
+}
+
 // NewPackageSource returns a PackageSource for the given source string.
-// OCI references (detected by IsOCIReference) use zoci.NewRemote;
+// OCI references and version constraints (see IsRemoteSource) use zoci.NewRemote;
 // everything else is treated as a local path resolved against bundleDir.
 // streams carries the leveled logger used for ingest/pull diagnostics.
 func NewPackageSource(source string, opts bundleinternal.ConfigOptions, bundleDir string, streams iostreams.IOStreams) PackageSource {
-	if udsoci.IsOCIReference(source) {
+	if IsRemoteSource(source) {
 		return &remoteSource{
 			ref:     udsoci.TrimScheme(source),
 			arch:    opts.Architecture,
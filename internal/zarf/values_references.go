@@ -0,0 +1,126 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"text/template/parse"
+)
+
+// VariableReference is a config variable read by a values file template,
+// either as {{ .vars.a.b }} or through the lookup helper.
+type VariableReference struct {
+	// Path is the dotted variable path below vars, for example "logging.level".
+	Path string
+	// Lookup reports a read through lookup, which tolerates unset variables.
+	Lookup bool
+	Line   int
+	Column int
+}
+
+// ValuesTemplateReferences parses a values file template and returns the
+// config variables it reads. Field accesses inside range and with blocks,
+// where dot no longer refers to the template data, are not reported unless
+// they start from $.
+func ValuesTemplateReferences(name string, src []byte) ([]VariableReference, error) {
+	tmpl, err := template.New(name).Funcs(valuesTemplateFuncs(nil, "")).Parse(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseValuesTemplate, newValuesTemplateError(name, name, err))
+	}
+	w := &referenceWalker{src: string(src)}
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil && t.Tree.Root != nil {
+			w.walk(t.Tree.Root, true)
+		}
+	}
+	return w.refs, nil
+}
+
+type referenceWalker struct {
+	src  string
+	refs []VariableReference
+}
+
+// walk records variable references below node. rootDot reports whether dot
+// still refers to the template data.
+func (w *referenceWalker) walk(node parse.Node, rootDot bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			w.walk(child, rootDot)
+		}
+	case *parse.ActionNode:
+		w.walk(n.Pipe, rootDot)
+	case *parse.IfNode:
+		w.walkBranch(&n.BranchNode, rootDot, rootDot)
+	case *parse.RangeNode:
+		w.walkBranch(&n.BranchNode, rootDot, false)
+	case *parse.WithNode:
+		w.walkBranch(&n.BranchNode, rootDot, false)
+	case *parse.TemplateNode:
+		w.walk(n.Pipe, rootDot)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			w.walk(cmd, rootDot)
+		}
+	case *parse.CommandNode:
+		if len(n.Args) == 2 {
+			if fn, ok := n.Args[0].(*parse.IdentifierNode); ok && fn.Ident == "lookup" {
+				if path, ok := n.Args[1].(*parse.StringNode); ok {
+					w.add(path.Text, true, path.Pos)
+				}
+			}
+		}
+		for _, arg := range n.Args {
+			w.walk(arg, rootDot)
+		}
+	case *parse.ChainNode:
+		w.walk(n.Node, rootDot)
+	case *parse.FieldNode:
+		if rootDot {
+			w.addFields(n.Ident, n.Pos)
+		}
+	case *parse.VariableNode:
+		if len(n.Ident) > 1 && n.Ident[0] == "$" {
+			w.addFields(n.Ident[1:], n.Pos)
+		}
+	}
+}
+
+func (w *referenceWalker) walkBranch(n *parse.BranchNode, rootDot, bodyRootDot bool) {
+	w.walk(n.Pipe, rootDot)
+	w.walk(n.List, bodyRootDot)
+	w.walk(n.ElseList, rootDot)
+}
+
+func (w *referenceWalker) addFields(ident []string, pos parse.Pos) {
+	if len(ident) < 2 || ident[0] != "vars" {
+		return
+	}
+	// Chained field nodes are positioned at a later segment; report the
+	// reference from the start of .vars instead.
+	end := min(int(pos)+len(".vars"), len(w.src))
+	if start := strings.LastIndex(w.src[:end], ".vars"); start >= 0 {
+		if start > 0 && w.src[start-1] == '$' {
+			start--
+		}
+		pos = parse.Pos(start)
+	}
+	w.add(strings.Join(ident[1:], "."), false, pos)
+}
+
+func (w *referenceWalker) add(path string, lookup bool, pos parse.Pos) {
+	offset := min(int(pos), len(w.src))
+	line := 1 + strings.Count(w.src[:offset], "\n")
+	column := offset - strings.LastIndex(w.src[:offset], "\n")
+	w.refs = append(w.refs, VariableReference{Path: path, Lookup: lookup, Line: line, Column: column})
+}
@@ -0,0 +1,35 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuesTemplateReferences(t *testing.T) {
+	src := `domain: {{ .vars.domain }}
+level: {{ .vars.logging.level | default "info" }}
+{{- range .vars.hosts }}
+- {{ .name }}.{{ $.vars.domain }}
+{{- end }}
+{{- with .vars.tls }}{{ .cert }}{{ end }}
+class: {{ lookup "ingress.class" }}
+`
+	refs, err := ValuesTemplateReferences("values.yaml", []byte(src))
+	require.NoError(t, err)
+	assert.Equal(t, []VariableReference{
+		{Path: "domain", Line: 1, Column: 12},
+		{Path: "logging.level", Line: 2, Column: 11},
+		{Path: "hosts", Line: 3, Column: 11},
+		{Path: "domain", Line: 4, Column: 18},
+		{Path: "tls", Line: 6, Column: 10},
+		{Path: "ingress.class", Lookup: true, Line: 7, Column: 18},
+	}, refs)
+
+	_, err = ValuesTemplateReferences("values.yaml", []byte("{{ .vars.domain"))
+	require.ErrorIs(t, err, ErrParseValuesTemplate)
+}
@@ -0,0 +1,271 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode/utf8"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"gopkg.in/yaml.v3"
+)
+
+// valuesTemplateFuncs returns the helpers available to values file templates.
+// The set is curated so rendering is deterministic and sandboxed: no helper
+// reads the environment, the clock, or the network, and file reads are
+// confined to bundleDir.
+//
+//	b64enc VALUE          base64-encodes VALUE
+//	toYaml VALUE          marshals VALUE as YAML without a trailing newline
+//	required MSG VALUE    fails with MSG when VALUE is missing or ""
+//	default DEF VALUE     returns DEF when VALUE is nil, "", or an empty list or map
+//	indent N TEXT         prefixes every line of TEXT with N spaces
+//	nindent N TEXT        like indent, preceded by a newline
+//	quote VALUE           renders VALUE as a double-quoted string
+//	lookup PATH           returns the variable at a dotted PATH, or nil when unset
+//	file PATH             returns the contents of PATH relative to the bundle directory
+//
+// Because missing keys are errors, optional variables are read with lookup:
+// {{ lookup "logging.level" | default "info" }}. Bundle artifacts store the
+// contents read by file when they are created; see InlineValuesTemplateFiles.
+func valuesTemplateFuncs(vars bundleinternal.Variables, bundleDir string) template.FuncMap {
+	return template.FuncMap{
+		"b64enc": func(v any) string {
+			return base64.StdEncoding.EncodeToString([]byte(templateString(v)))
+		},
+		"toYaml":   toYAML,
+		"required": required,
+		"default": func(def, v any) any {
+			if isEmptyTemplateValue(v) {
+				return def
+			}
+			return v
+		},
+		"indent": indent,
+		"nindent": func(n int, text string) string {
+			return "\n" + indent(n, text)
+		},
+		"quote": func(v any) string {
+			return strconv.Quote(templateString(v))
+		},
+		"lookup": func(path string) any {
+			return lookupVariable(vars, path)
+		},
+		"file": func(path string) (string, error) {
+			return readBundleFile(bundleDir, path)
+		},
+	}
+}
+
+func templateString(v any) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if v == nil {
+		return ""
+	}
+	return fmt.Sprint(v)
+}
+
+func toYAML(v any) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func required(msg string, v any) (any, error) {
+	if v == nil {
+		return nil, errors.New(msg)
+	}
+	if s, ok := v.(string); ok && s == "" {
+		return nil, errors.New(msg)
+	}
+	return v, nil
+}
+
+// isEmptyTemplateValue reports whether default should replace v. Unlike
+// Helm's default, false and 0 are kept since they are deliberate settings.
+func isEmptyTemplateValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	default:
+		return false
+	}
+}
+
+func indent(n int, text string) string {
+	pad := strings.Repeat(" ", n)
+	return pad + strings.ReplaceAll(text, "\n", "\n"+pad)
+}
+
+// lookupVariable returns the variable at a dot-separated path, or nil when
+// any segment is unset.
+func lookupVariable(vars bundleinternal.Variables, path string) any {
+	var current any = map[string]any(vars)
+	for _, key := range strings.Split(path, ".") {
+		switch m := current.(type) {
+		case map[string]any:
+			current = m[key]
+		case bundleinternal.Variables:
+			current = m[key]
+		default:
+			return nil
+		}
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+// readBundleFile reads a UTF-8 file at a path relative to bundleDir. Absolute
+// paths and paths that leave bundleDir, including through symlinks, are rejected.
+func readBundleFile(bundleDir, path string) (string, error) {
+	if bundleDir == "" {
+		return "", fmt.Errorf("file %q: %w", path, ErrBundleDirRequired)
+	}
+	root, err := os.OpenRoot(bundleDir)
+	if err != nil {
+		return "", fmt.Errorf("opening bundle directory: %w", err)
+	}
+	defer func() { _ = root.Close() }()
+	contents, err := root.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("reading file %q: %w", path, err)
+	}
+	if !utf8.Valid(contents) {
+		return "", fmt.Errorf("file %q is not valid UTF-8", path)
+	}
+	return string(contents), nil
+}
+
+// InlineValuesTemplateFiles returns the values file template src with each
+// {{ file "PATH" }} call replaced by the contents of PATH, read relative to
+// bundleDir, as a string literal. The template then renders the same without
+// the files it reads, as when packaged into a bundle artifact. PATH must be a
+// string literal so the file can be read before the template is rendered.
+func InlineValuesTemplateFiles(name string, src []byte, bundleDir string) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(valuesTemplateFuncs(nil, "")).Parse(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrParseValuesTemplate, newValuesTemplateError(name, name, err))
+	}
+	var calls []*parse.CommandNode
+	for _, t := range tmpl.Templates() {
+		if t.Tree != nil && t.Tree.Root != nil {
+			collectFileCalls(t.Tree.Root, &calls)
+		}
+	}
+	// Replace from the end so earlier positions stay valid.
+	slices.SortFunc(calls, func(a, b *parse.CommandNode) int { return int(b.Pos) - int(a.Pos) })
+	calls = slices.CompactFunc(calls, func(a, b *parse.CommandNode) bool { return a.Pos == b.Pos })
+	out := slices.Clone(src)
+	for _, call := range calls {
+		line := 1 + bytes.Count(src[:call.Args[0].Position()], []byte("\n"))
+		path, ok := call.Args[len(call.Args)-1].(*parse.StringNode)
+		if len(call.Args) != 2 || !ok {
+			err := errors.New("file must be called with a string literal path to be packaged")
+			return nil, fmt.Errorf("%w: %w", ErrInlineValuesTemplateFile, ValuesTemplateError{File: name, Line: line, Err: err})
+		}
+		contents, err := readBundleFile(bundleDir, path.Text)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInlineValuesTemplateFile, ValuesTemplateError{File: name, Line: line, Err: err})
+		}
+		start, end := int(call.Args[0].Position()), int(path.Pos)+len(path.Quoted)
+		out = slices.Concat(out[:start], []byte(strconv.Quote(contents)), out[end:])
+	}
+	return out, nil
+}
+
+// collectFileCalls appends the commands below node that call file.
+func collectFileCalls(node parse.Node, calls *[]*parse.CommandNode) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectFileCalls(child, calls)
+		}
+	case *parse.ActionNode:
+		collectFileCalls(n.Pipe, calls)
+	case *parse.IfNode:
+		collectBranchFileCalls(&n.BranchNode, calls)
+	case *parse.RangeNode:
+		collectBranchFileCalls(&n.BranchNode, calls)
+	case *parse.WithNode:
+		collectBranchFileCalls(&n.BranchNode, calls)
+	case *parse.TemplateNode:
+		collectFileCalls(n.Pipe, calls)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			collectFileCalls(cmd, calls)
+		}
+	case *parse.CommandNode:
+		if fn, ok := n.Args[0].(*parse.IdentifierNode); ok && fn.Ident == "file" {
+			*calls = append(*calls, n)
+		}
+		for _, arg := range n.Args {
+			collectFileCalls(arg, calls)
+		}
+	case *parse.ChainNode:
+		collectFileCalls(n.Node, calls)
+	}
+}
+
+func collectBranchFileCalls(n *parse.BranchNode, calls *[]*parse.CommandNode) {
+	collectFileCalls(n.Pipe, calls)
+	collectFileCalls(n.List, calls)
+	collectFileCalls(n.ElseList, calls)
+}
+
+// newValuesTemplateError attributes a text/template error for the template
+// named name to file. text/template reports locations only in its messages,
+// formatted as "template: NAME:LINE[:COL]: DETAIL", so the line is parsed from there.
+func newValuesTemplateError(file, name string, err error) ValuesTemplateError {
+	location := regexp.MustCompile(`(?s)^template: ` + regexp.QuoteMeta(name) + `:(\d+)(?::\d+)?: (.*)$`)
+	match := location.FindStringSubmatch(err.Error())
+	if match == nil {
+		return ValuesTemplateError{File: file, Err: err}
+	}
+	line, _ := strconv.Atoi(match[1])
+	return ValuesTemplateError{File: file, Line: line, Err: locatedTemplateError{msg: match[2], err: err}}
+}
+
+// locatedTemplateError is a template error with its location prefix removed.
+type locatedTemplateError struct {
+	msg string
+	err error
+}
+
+func (e locatedTemplateError) Error() string { return e.msg }
+
+func (e locatedTemplateError) Unwrap() error { return e.err }
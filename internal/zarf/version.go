@@ -0,0 +1,170 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"oras.land/oras-go/v2/registry"
+)
+
+// ResolvedVersion is a package source resolved to a registry tag and the
+// manifest digest the tag pointed at.
+type ResolvedVersion struct {
+	// Tag is the resolved tag. It is empty for sources pinned by digest alone.
+	Tag    string
+	Digest string
+}
+
+// flavoredConstraint splits a trailing Zarf flavor, as in ~0.40-upstream,
+// from a version constraint.
+var flavoredConstraint = regexp.MustCompile(`^(.*[0-9x*])-([A-Za-z][0-9A-Za-z.-]*)$`)
+
+// SourceConstraint splits an OCI package source whose tag is a semantic
+// version constraint, such as oci://ghcr.io/uds/core:~0.40, into its
+// repository and constraint. A constraint is a tag that uses characters no
+// OCI tag can contain: one of ~ ^ < > = * , or a space. A trailing
+// -<flavor> selects tags published for that Zarf flavor.
+func SourceConstraint(source string) (repository, constraint string, ok bool) {
+	ref := udsoci.TrimScheme(source)
+	if strings.Contains(ref, "@") {
+		return "", "", false
+	}
+	slash := strings.LastIndex(ref, "/")
+	colon := strings.LastIndex(ref, ":")
+	if colon < 0 || colon < slash {
+		return "", "", false
+	}
+	repository, tag := ref[:colon], ref[colon+1:]
+	if !strings.ContainsAny(tag, "~^<>=*, ") {
+		return "", "", false
+	}
+	if !strings.HasPrefix(source, "oci://") && !udsoci.IsOCIReference(repository) {
+		return "", "", false
+	}
+	return repository, tag, true
+}
+
+// PinnedSource returns source pinned to digest, keeping any oci:// scheme and
+// replacing a tag or version constraint with tag.
+func PinnedSource(source string, version ResolvedVersion) string {
+	scheme := ""
+	if strings.HasPrefix(source, "oci://") {
+		scheme = "oci://"
+	}
+	ref := udsoci.TrimScheme(source)
+	if at := strings.Index(ref, "@"); at >= 0 {
+		ref = ref[:at]
+	}
+	if colon := strings.LastIndex(ref, ":"); colon > strings.LastIndex(ref, "/") {
+		ref = ref[:colon]
+	}
+	if version.Tag != "" {
+		ref += ":" + version.Tag
+	}
+	return scheme + ref + "@" + version.Digest
+}
+
+// ResolvePackageVersion resolves an OCI package source to a tag and manifest
+// digest. Version constraints are matched against the repository's tag list
+// and resolve to the highest satisfying version.
+func ResolvePackageVersion(ctx context.Context, source string, opts bundleinternal.ConfigOptions, streams iostreams.IOStreams) (ResolvedVersion, error) {
+	if !IsRemoteSource(source) {
+		return ResolvedVersion{}, fmt.Errorf("%q is not an OCI reference: %w", source, ErrResolvePackageVersion)
+	}
+	s := &remoteSource{ref: udsoci.TrimScheme(source), arch: opts.Architecture, opts: opts, streams: streams}
+	return s.resolveVersion(ctx)
+}
+
+// resolveVersion resolves the source reference, matching a version
+// constraint against the repository's tags first.
+func (s *remoteSource) resolveVersion(ctx context.Context) (ResolvedVersion, error) {
+	ref := s.ref
+	if repository, constraint, ok := SourceConstraint(ref); ok {
+		tag, err := s.matchConstraint(ctx, repository, constraint)
+		if err != nil {
+			return ResolvedVersion{}, err
+		}
+		ref = repository + ":" + tag
+	}
+	parsed, err := registry.ParseReference(ref)
+	if err != nil {
+		return ResolvedVersion{}, fmt.Errorf("%w %q: %w", ErrResolvePackageVersion, s.ref, err)
+	}
+	repo, err := udsoci.NewRemoteRepository(ctx, ref, s.opts)
+	if err != nil {
+		return ResolvedVersion{}, fmt.Errorf("%w %q: %w", ErrResolvePackageVersion, s.ref, err)
+	}
+	desc, err := repo.Resolve(ctx, parsed.Reference)
+	if err != nil {
+		return ResolvedVersion{}, fmt.Errorf("%w %q: %w", ErrResolvePackageVersion, ref, err)
+	}
+	version := ResolvedVersion{Digest: desc.Digest.String()}
+	if parsed.ValidateReferenceAsDigest() != nil {
+		version.Tag = parsed.Reference
+	}
+	s.streams.Debug("resolved package version", "source", s.ref, "tag", version.Tag, "digest", version.Digest)
+	return version, nil
+}
+
+// matchConstraint returns the highest tag in repository satisfying
+// constraint.
+func (s *remoteSource) matchConstraint(ctx context.Context, repository, constraint string) (string, error) {
+	versionConstraint, flavor := constraint, ""
+	if m := flavoredConstraint.FindStringSubmatch(constraint); m != nil {
+		versionConstraint, flavor = m[1], m[2]
+	}
+	c, err := semver.NewConstraint(versionConstraint)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: invalid version constraint %q: %w", ErrResolvePackageVersion, s.ref, constraint, err)
+	}
+	repo, err := udsoci.NewRemoteRepository(ctx, repository, s.opts)
+	if err != nil {
+		return "", fmt.Errorf("%w %q: %w", ErrResolvePackageVersion, s.ref, err)
+	}
+	var tags []string
+	if err := repo.Tags(ctx, "", func(page []string) error {
+		tags = append(tags, page...)
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("%w %q: listing tags: %w", ErrResolvePackageVersion, repository, err)
+	}
+	tag, ok := highestMatchingTag(tags, c, flavor)
+	if !ok {
+		return "", fmt.Errorf("%w %q: %w", ErrResolvePackageVersion, s.ref, NoMatchingVersionError{Repository: repository, Constraint: constraint})
+	}
+	return tag, nil
+}
+
+// highestMatchingTag returns the tag with the highest version satisfying c.
+// With a flavor, only tags ending in -<flavor> are considered and the flavor
+// is removed before the version is compared.
+func highestMatchingTag(tags []string, c *semver.Constraints, flavor string) (string, bool) {
+	var best *semver.Version
+	var bestTag string
+	for _, tag := range tags {
+		versionTag := tag
+		if flavor != "" {
+			var ok bool
+			if versionTag, ok = strings.CutSuffix(tag, "-"+flavor); !ok {
+				continue
+			}
+		}
+		v, err := semver.NewVersion(versionTag)
+		if err != nil || !c.Check(v) {
+			continue
+		}
+		if best == nil || v.GreaterThan(best) {
+			best, bestTag = v, tag
+		}
+	}
+	return bestTag, best != nil
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package zarf
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Masterminds/semver/v3"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	oras "oras.land/oras-go/v2"
+)
+
+func TestSourceConstraint(t *testing.T) {
+	tests := []struct {
+		source     string
+		repository string
+		constraint string
+		ok         bool
+	}{
+		{source: "oci://ghcr.io/uds/core:~0.40", repository: "ghcr.io/uds/core", constraint: "~0.40", ok: true},
+		{source: "ghcr.io/uds/core:>=1.2, <2", repository: "ghcr.io/uds/core", constraint: ">=1.2, <2", ok: true},
+		{source: "localhost:5000/uds/core:^1-upstream", repository: "localhost:5000/uds/core", constraint: "^1-upstream", ok: true},
+		{source: "oci://ghcr.io/uds/core:0.40.1"},
+		{source: "oci://ghcr.io/uds/core@sha256:" + strings.Repeat("a", 64)},
+		{source: "localhost:5000/uds/core"},
+		{source: "./packages/zarf-package-core.tar.zst"},
+		{source: "core"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			repository, constraint, ok := SourceConstraint(tt.source)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.repository, repository)
+			assert.Equal(t, tt.constraint, constraint)
+		})
+	}
+}
+
+func TestPinnedSource(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("b", 64)
+	version := ResolvedVersion{Tag: "0.40.2", Digest: digest}
+
+	assert.Equal(t, "oci://ghcr.io/uds/core:0.40.2@"+digest, PinnedSource("oci://ghcr.io/uds/core:~0.40", version))
+	assert.Equal(t, "localhost:5000/uds/core:0.40.2@"+digest, PinnedSource("localhost:5000/uds/core:0.40.2", version))
+	assert.Equal(t, "ghcr.io/uds/core@"+digest, PinnedSource("ghcr.io/uds/core@"+digest, ResolvedVersion{Digest: digest}))
+}
+
+func TestHighestMatchingTag(t *testing.T) {
+	tags := []string{"0.39.9", "0.40.0", "0.40.3", "0.40.10-upstream", "0.40.2-upstream", "0.41.0", "0.40.4-rc.1", "latest"}
+
+	c, err := semver.NewConstraint("~0.40")
+	require.NoError(t, err)
+	tag, ok := highestMatchingTag(tags, c, "")
+	require.True(t, ok)
+	assert.Equal(t, "0.40.3", tag)
+
+	tag, ok = highestMatchingTag(tags, c, "upstream")
+	require.True(t, ok)
+	assert.Equal(t, "0.40.10-upstream", tag)
+
+	_, ok = highestMatchingTag(tags, c, "registry1")
+	assert.False(t, ok)
+}
+
+func TestResolvePackageVersion(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	repository := strings.TrimPrefix(server.URL, "http://") + "/uds/core"
+	opts := bundleinternal.ConfigOptions{PlainHTTP: true}
+
+	repo, err := udsoci.NewRemoteRepository(t.Context(), repository, opts)
+	require.NoError(t, err)
+	digests := map[string]string{}
+	for _, tag := range []string{"0.40.0", "0.40.2", "0.41.0"} {
+		desc, err := oras.PackManifest(t.Context(), repo, oras.PackManifestVersion1_1, "application/vnd.test."+strings.ReplaceAll(tag, ".", "-"), oras.PackManifestOptions{})
+		require.NoError(t, err)
+		require.NoError(t, repo.Tag(t.Context(), desc, tag))
+		digests[tag] = desc.Digest.String()
+	}
+
+	version, err := ResolvePackageVersion(t.Context(), "oci://"+repository+":~0.40", opts, iostreams.IOStreams{})
+	require.NoError(t, err)
+	assert.Equal(t, ResolvedVersion{Tag: "0.40.2", Digest: digests["0.40.2"]}, version)
+
+	version, err = ResolvePackageVersion(t.Context(), repository+":0.41.0", opts, iostreams.IOStreams{})
+	require.NoError(t, err)
+	assert.Equal(t, ResolvedVersion{Tag: "0.41.0", Digest: digests["0.41.0"]}, version)
+
+	_, err = ResolvePackageVersion(t.Context(), repository+":^1", opts, iostreams.IOStreams{})
+	require.ErrorIs(t, err, ErrResolvePackageVersion)
+	var noMatch NoMatchingVersionError
+	require.ErrorAs(t, err, &noMatch)
+	assert.Equal(t, "^1", noMatch.Constraint)
+}
@@ -29,4 +29,7 @@ type ConfigOptions struct {
 	SkipTLSVerify bool
 	TmpDir        string
 	Concurrency   int
+	// ChunkConcurrency is the number of parallel ranged requests used to
+	// download a single large blob. Zero or one disables chunking.
+	ChunkConcurrency int
 }
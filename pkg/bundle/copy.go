@@ -0,0 +1,121 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
+)
+
+// CopyOptions holds configuration for copying a bundle between OCI registries.
+type CopyOptions struct {
+	Config *UDSBundleConfig
+	// Verification, when configured, must be satisfied by every copied
+	// bundle before anything is written to the destination.
+	Verification VerificationPolicy
+	// AllArchitectures copies every architecture published at the source
+	// reference instead of the configured one.
+	AllArchitectures bool
+	Streams          iostreams.IOStreams
+}
+
+// CopyResult represents the output of a bundle copy operation.
+type CopyResult struct {
+	Source        string   `json:"source" yaml:"source" text:"Source"`
+	OCIReference  string   `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
+	Architectures []string `json:"architectures" yaml:"architectures" text:"Architectures"`
+	SignedBy      []string `json:"signedBy,omitempty" yaml:"signedBy,omitempty" text:"Signed By,omitempty"`
+}
+
+// Copy copies a bundle from one OCI registry to another without staging it on
+// local disk. Bundles for other architectures already published at dstRef are
+// preserved.
+func Copy(ctx context.Context, srcRef, dstRef string, opts CopyOptions) (*CopyResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if srcRef == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	if err := validateOCIReference(srcRef); err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrCopyBundle, srcRef, err)
+	}
+	if err := validateOCIReference(dstRef); err != nil {
+		return nil, fmt.Errorf("%w to %q: %w", ErrCopyBundle, dstRef, err)
+	}
+	result, err := copyBundle(ctx, srcRef, dstRef, opts, copyHooks{})
+	if err != nil {
+		if errors.Is(err, udsoci.ErrBundleSignatureNotFound) {
+			return nil, fmt.Errorf("%w %q to %q: %w: %w", ErrCopyBundle, srcRef, dstRef, ErrBundleNotSigned, err)
+		}
+		return nil, fmt.Errorf("%w %q to %q: %w", ErrCopyBundle, srcRef, dstRef, err)
+	}
+	return result, nil
+}
+
+type copyHooks struct {
+	sourceTarget      func(ctx context.Context, ociReference string, opts *CopyOptions) (oras.Target, error)
+	destinationTarget func(ctx context.Context, ociReference string, opts *CopyOptions) (oras.Target, error)
+}
+
+func copyBundle(ctx context.Context, srcRef, dstRef string, opts CopyOptions, hooks copyHooks) (*CopyResult, error) {
+	var signedBy []string
+	result, err := udsoci.CopyBundle(ctx, srcRef, dstRef, toOCICopyOptions(opts, hooks, &signedBy))
+	if err != nil {
+		return nil, err
+	}
+	return &CopyResult{
+		Source:        result.Source,
+		OCIReference:  result.OCIReference,
+		Architectures: result.Architectures,
+		SignedBy:      signedBy,
+	}, nil
+}
+
+// toOCICopyOptions converts public copy options and hooks to internal
+// equivalents. Signers that satisfy the verification policy for any copied
+// bundle are stored in signedBy.
+func toOCICopyOptions(opts CopyOptions, hooks copyHooks, signedBy *[]string) udsoci.CopyOptions {
+	internal := udsoci.CopyOptions{
+		Config:           toInternalConfig(opts.Config),
+		Streams:          opts.Streams,
+		AllArchitectures: opts.AllArchitectures,
+	}
+	if opts.Verification.configured() {
+		internal.CopyHooks.VerifyBundle = func(ctx context.Context, src content.Fetcher, index []byte, signatures [][]byte) error {
+			bundleName, err := policyBundleName(ctx, opts.Streams, opts.Verification, index, src)
+			if err != nil {
+				return err
+			}
+			verified, err := verifySignature(ctx, index, signatures, bundleName, opts.Verification, opts.Config.Options.TmpDir)
+			if err != nil {
+				return err
+			}
+			for _, signer := range verified.signedBy {
+				if !slices.Contains(*signedBy, signer) {
+					*signedBy = append(*signedBy, signer)
+				}
+			}
+			return nil
+		}
+	}
+	if hooks.sourceTarget != nil {
+		internal.CopyHooks.SourceTarget = func(ctx context.Context, ref string, _ *udsoci.CopyOptions) (oras.Target, error) {
+			return hooks.sourceTarget(ctx, ref, &opts)
+		}
+	}
+	if hooks.destinationTarget != nil {
+		internal.CopyHooks.DestinationTarget = func(ctx context.Context, ref string, _ *udsoci.CopyOptions) (oras.Target, error) {
+			return hooks.destinationTarget(ctx, ref, &opts)
+		}
+	}
+	return internal
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopy_VerifiesAndMirrorsSignedBundle(t *testing.T) {
+	dev := httptest.NewServer(registry.New())
+	t.Cleanup(dev.Close)
+	prod := httptest.NewServer(registry.New())
+	t.Cleanup(prod.Close)
+
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "promoted"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	signingKey, publicKey := generateTestKeyPair(t)
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  source,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: signingKey},
+		TmpDir:  t.TempDir(),
+	}))
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	config.Options.TmpDir = t.TempDir()
+	devRef := "oci://" + strings.TrimPrefix(dev.URL, "http://") + "/test/promoted:1.0.0"
+	prodRef := "oci://" + strings.TrimPrefix(prod.URL, "http://") + "/release/promoted:1.0.0"
+	_, err := Push(t.Context(), source, devRef, PushOptions{Config: config})
+	require.NoError(t, err)
+
+	policy := VerificationPolicy{PublicKey: publicKey}
+	copied, err := Copy(t.Context(), devRef, prodRef, CopyOptions{Config: config, Verification: policy})
+	require.NoError(t, err)
+	require.Equal(t, []string{config.Options.Architecture}, copied.Architectures)
+	require.Equal(t, []string{"public-key"}, copied.SignedBy)
+
+	pulled, err := Pull(t.Context(), prodRef, t.TempDir(), PullOptions{Config: config, Verification: policy})
+	require.NoError(t, err)
+	require.Equal(t, []string{"public-key"}, pulled.SignedBy)
+	entries := readTarZstEntries(t, pulled.OutputPath)
+	require.Equal(t, readTarZstEntries(t, source)[bundleSignatureFileName], entries[bundleSignatureFileName])
+}
+
+func TestCopy_ReportsUnsignedBundleWhenVerifying(t *testing.T) {
+	dev := httptest.NewServer(registry.New())
+	t.Cleanup(dev.Close)
+	prod := httptest.NewServer(registry.New())
+	t.Cleanup(prod.Close)
+
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "unsigned-copy"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	devRef := strings.TrimPrefix(dev.URL, "http://") + "/test/unsigned:1.0.0"
+	prodRef := strings.TrimPrefix(prod.URL, "http://") + "/test/unsigned:1.0.0"
+	_, err := Push(t.Context(), source, devRef, PushOptions{Config: config})
+	require.NoError(t, err)
+
+	_, err = Copy(t.Context(), devRef, prodRef, CopyOptions{Config: config, Verification: VerificationPolicy{PublicKey: "unused"}})
+	require.ErrorIs(t, err, ErrCopyBundle)
+	require.ErrorIs(t, err, ErrBundleNotSigned)
+
+	copied, err := Copy(t.Context(), devRef, prodRef, CopyOptions{Config: config})
+	require.NoError(t, err)
+	require.Empty(t, copied.SignedBy)
+	_, err = Pull(t.Context(), prodRef, t.TempDir(), PullOptions{Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
 )
 
 // CreateOptions holds configuration for the top-level bundle create operation.
@@ -21,6 +22,10 @@ type CreateOptions struct {
 	Config  *UDSBundleConfig
 	Streams iostreams.IOStreams
 	Signing SigningOptions
+	// VerifyReproducible builds the bundle a second time in a temporary
+	// directory and fails with a NotReproducibleError when the two unsigned
+	// archives differ.
+	VerifyReproducible bool
 }
 
 // CreateResult represents the output of a bundle create operation.
@@ -31,7 +36,9 @@ type CreateResult struct {
 
 // Create creates a UDS bundle tar.zst from the given bundle definition file.
 // It parses and validates the bundle, ingests all packages, and writes the
-// resulting archive next to the bundle file.
+// resulting archive next to the bundle file. OCI packages are built from the
+// digests pinned in bundle.lock.hcl; packages missing from the lock, or whose
+// source changed, are resolved and added to it.
 func Create(ctx context.Context, bundleFile string, opts CreateOptions) (*CreateResult, error) {
 	if err := opts.Validate(); err != nil {
 		return nil, err
@@ -44,7 +51,10 @@ func Create(ctx context.Context, bundleFile string, opts CreateOptions) (*Create
 
 	s.Info("reading bundle definition", "source", bundleFile)
 	s.Debug("parsing bundle file", "path", bundleFile)
-	b, bundleHCL, err := parseAndMaterializeBundleFile(ctx, opts.Config.Options.Architecture, s, bundleFile)
+	srcDir := filepath.Dir(bundleFile)
+	included := newIncludedBundles(opts.Config, srcDir, s)
+	defer closeIncludedBundles(s, included)
+	b, bundleHCL, err := parseAndMaterializeBundleFile(ctx, opts.Config.Options.Architecture, s, bundleFile, included)
 	if err != nil {
 		return nil, fmt.Errorf("%w from %q: %w", ErrCreateBundle, bundleFile, err)
 	}
@@ -56,28 +66,50 @@ func Create(ctx context.Context, bundleFile string, opts CreateOptions) (*Create
 	s.Debug("bundle validated")
 	s.Info("building bundle artifact", "name", b.Metadata.Name, "packages", len(b.Packages))
 
-	srcDir := filepath.Dir(bundleFile)
+	lock, lockChanged, err := lockBundle(ctx, s, b, srcDir, opts.Config)
+	if err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrCreateBundle, bundleFile, err)
+	}
 	var defaultsHCL []byte
-	defaultsPath := filepath.Join(srcDir, bundleDefaultsFileName)
-	if _, err := os.Stat(defaultsPath); err == nil {
-		defaultsHCL, err = bundleinternal.MaterializeDefaultsFile(defaultsPath)
-		if err != nil {
+	defaultsPath, err := bundleinternal.AdjacentDefaultsPath(srcDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w: accessing defaults HCL: %w", ErrCreateBundle, err)
+	}
+	if defaultsPath != "" {
+		if defaultsHCL, err = bundleinternal.MaterializeDefaultsFile(defaultsPath); err != nil {
 			return nil, fmt.Errorf("%w: materializing defaults HCL: %w", ErrCreateBundle, err)
 		}
-	} else if !os.IsNotExist(err) {
-		return nil, fmt.Errorf("%w: accessing defaults HCL: %w", ErrCreateBundle, err)
 	}
-	result, err := artifact.Create(ctx, artifact.CreateOptions{
+	if defaultsHCL, err = bundleinternal.MaterializeIncludedDefaults(ctx, included.defaults, defaultsHCL); err != nil {
+		return nil, fmt.Errorf("%w: merging included bundle defaults: %w", ErrCreateBundle, err)
+	}
+	createOpts := artifact.CreateOptions{
 		Config:      toInternalConfig(opts.Config),
 		Bundle:      b,
 		BundleHCL:   bundleHCL,
 		DefaultsHCL: defaultsHCL,
 		BundleDir:   srcDir,
+		Lock:        lock,
+		Included:    included.bundles,
 		Streams:     s,
-	})
+	}
+	result, err := artifact.Create(ctx, createOpts)
 	if err != nil {
 		return nil, fmt.Errorf("%w from %q: %w", ErrCreateBundle, bundleFile, err)
 	}
+	if lockChanged {
+		if err := writeBundleLock(s, srcDir, lock); err != nil {
+			return nil, fmt.Errorf("%w from %q: %w", ErrCreateBundle, bundleFile, err)
+		}
+	}
+	if opts.VerifyReproducible {
+		if err := verifyReproducible(ctx, s, createOpts, result.OutputPath); err != nil {
+			if removeErr := os.Remove(result.OutputPath); removeErr != nil && !os.IsNotExist(removeErr) {
+				err = errors.Join(err, removeErr)
+			}
+			return nil, fmt.Errorf("%w from %q: %w", ErrCreateBundle, bundleFile, err)
+		}
+	}
 	if opts.Signing.Mode == "" || opts.Signing.Mode == SigningModeUnsigned {
 		s.Warn("bundle is unsigned; its integrity and origin are not established")
 	} else if err := Sign(ctx, SignOptions{Source: result.OutputPath, Signing: opts.Signing, Config: opts.Config, TmpDir: opts.Config.Options.TmpDir, Streams: s}); err != nil {
@@ -88,3 +120,54 @@ func Create(ctx context.Context, bundleFile string, opts CreateOptions) (*Create
 	}
 	return &CreateResult{BundleName: b.Metadata.Name, OutputPath: result.OutputPath}, nil
 }
+
+// verifyReproducible rebuilds the bundle described by createOpts into a
+// temporary directory and compares the result with the archive at outputPath.
+func verifyReproducible(ctx context.Context, s iostreams.IOStreams, createOpts artifact.CreateOptions, outputPath string) error {
+	s.Info("rebuilding bundle to verify reproducibility")
+	dir, err := os.MkdirTemp(createOpts.Config.Options.TmpDir, "uds-bundle-reproducible-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if removeErr := os.RemoveAll(dir); removeErr != nil {
+			s.Warn("failed to remove temporary directory", "path", dir, "error", removeErr)
+		}
+	}()
+	createOpts.OutputDir = dir
+	second, err := artifact.Create(ctx, createOpts)
+	if err != nil {
+		return fmt.Errorf("rebuilding bundle: %w", err)
+	}
+
+	first, err := fileDigest(outputPath)
+	if err != nil {
+		return err
+	}
+	rebuilt, err := fileDigest(second.OutputPath)
+	if err != nil {
+		return err
+	}
+	if first == rebuilt {
+		s.Info("bundle archive is reproducible", "digest", first)
+		return nil
+	}
+	entries, err := artifact.DiffTarZstEntries(ctx, outputPath, second.OutputPath)
+	if err != nil {
+		return fmt.Errorf("comparing rebuilt bundle: %w", err)
+	}
+	return &NotReproducibleError{First: first.String(), Second: rebuilt.String(), Entries: entries}
+}
+
+func fileDigest(path string) (_ godigest.Digest, retErr error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return godigest.FromReader(f)
+}
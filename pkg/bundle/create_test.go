@@ -6,13 +6,18 @@ package bundle
 import (
 	"encoding/json"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
@@ -389,3 +394,192 @@ package "pkg1" {
 		}
 	}
 }
+
+func TestCreate_VerifyReproducible(t *testing.T) {
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "localpkg"))
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "reproducible"
+  version = "0.1.0"
+}
+package "pkg1" {
+  source = "localpkg"
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+
+	createOnce := func() []byte {
+		config := newTestConfigWithArch("amd64")
+		config.Options.TmpDir = t.TempDir()
+		result, err := Create(t.Context(), bundleFile, CreateOptions{
+			Config:             config,
+			Signing:            SigningOptions{Mode: SigningModeUnsigned},
+			Streams:            iostreams.New(nil, nil, io.Discard),
+			VerifyReproducible: true,
+		})
+		require.NoError(t, err)
+		leftovers, err := os.ReadDir(config.Options.TmpDir)
+		require.NoError(t, err)
+		require.Empty(t, leftovers)
+		data, err := os.ReadFile(result.OutputPath)
+		require.NoError(t, err)
+		require.NoError(t, os.Remove(result.OutputPath))
+		return data
+	}
+
+	first := createOnce()
+	assert.Equal(t, first, createOnce(), "identical inputs must produce byte-identical archives")
+
+	t.Setenv(bundleinternal.SourceDateEpochEnv, "1700000000")
+	pinned := createOnce()
+	assert.NotEqual(t, first, pinned, "SOURCE_DATE_EPOCH must be recorded in the archive")
+	assert.Equal(t, pinned, createOnce())
+}
+
+func TestNotReproducibleError(t *testing.T) {
+	t.Parallel()
+
+	err := error(&NotReproducibleError{First: "sha256:aa", Second: "sha256:bb", Entries: []string{"oci/index.json", "uds.provenance.json"}})
+	require.ErrorIs(t, err, ErrNotReproducible)
+	assert.Equal(t, "bundle archive is not reproducible: first build sha256:aa, second build sha256:bb; differing entries: oci/index.json, uds.provenance.json", err.Error())
+}
+
+func TestCreate_IncludesPackagesFromBundleBlock(t *testing.T) {
+	coreDir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(coreDir, "istio"))
+	writeMinimalZarfPackage(t, filepath.Join(coreDir, "keycloak"))
+	require.NoError(t, os.WriteFile(filepath.Join(coreDir, "istio.yaml"), []byte("domain: {{ .vars.domain }}\n"), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(coreDir, bundleDefaultsFileName), []byte("variables = {\n  domain = \"uds.dev\"\n  admin  = \"admin.uds.dev\"\n}\n"), tmpFilePerm))
+	coreFile := filepath.Join(coreDir, bundleFileName)
+	require.NoError(t, os.WriteFile(coreFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "core"
+  version = "1.2.3"
+}
+package "istio" {
+  source       = "istio"
+  values_files = ["istio.yaml"]
+  signature_verification { verify = false }
+}
+package "keycloak" {
+  source     = "keycloak"
+  depends_on = [package.istio]
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+	core, err := Create(t.Context(), coreFile, CreateOptions{Config: newTestConfig(), Signing: SigningOptions{Mode: SigningModeUnsigned}, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "app"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleDefaultsFileName), []byte("variables = {\n  domain = \"example.com\"\n}\n"), tmpFilePerm))
+	bundleFile := filepath.Join(dir, bundleFileName)
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "product"
+  version = "1.0.0"
+}
+bundle "core" {
+  source = "`+core.OutputPath+`"
+}
+package "app" {
+  source     = "app"
+  depends_on = [bundle.core]
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+	result, err := Create(t.Context(), bundleFile, CreateOptions{Config: newTestConfig(), Signing: SigningOptions{Mode: SigningModeUnsigned}, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+
+	extracted, err := artifact.ExtractArtifact(t.Context(), iostreams.IOStreams{}, result.OutputPath, t.TempDir())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"app", "core.istio", "core.keycloak"}, slices.Collect(maps.Keys(extracted.PackageManifests)))
+	coreExtracted, err := artifact.ExtractArtifact(t.Context(), iostreams.IOStreams{}, core.OutputPath, t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, coreExtracted.PackageManifests["istio"].Digest, extracted.PackageManifests["core.istio"].Digest)
+
+	definition, err := os.ReadFile(extracted.BundleDefPath)
+	require.NoError(t, err)
+	b, err := bundleinternal.NewHCLParser("", iostreams.IOStreams{}).ParseBundleBytes(t.Context(), definition)
+	require.NoError(t, err)
+	require.Len(t, b.Packages, 3)
+	assert.Equal(t, []spec.PackageRef{{Name: "core.istio"}, {Name: "core.keycloak"}}, b.Packages[0].DependsOn)
+	assert.Equal(t, []spec.PackageRef{{Name: "core.istio"}}, b.Packages[2].DependsOn)
+
+	values, err := extracted.ValuesFilesByPackage()
+	require.NoError(t, err)
+	require.Len(t, values["core.istio"], 1)
+	defaults, err := bundleinternal.ParseDefaults(t.Context(), filepath.Join(extracted.Dir, bundleDefaultsFileName))
+	require.NoError(t, err)
+	assert.Equal(t, bundleinternal.Variables{"domain": "example.com", "admin": "admin.uds.dev"}, defaults)
+}
+
+func TestCreate_ExpandsForEachPackages(t *testing.T) {
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "tenant"))
+	for _, tenant := range []string{"blue", "red"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, tenant+".yaml"), []byte("tenant: "+tenant+"\n"), tmpFilePerm))
+	}
+	bundleFile := filepath.Join(dir, bundleFileName)
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "tenants"
+  version = "1.0.0"
+}
+package "tenant" {
+  for_each     = ["blue", "red"]
+  source       = "tenant"
+  namespace    = "tenant-${each.key}"
+  values_files = ["${each.key}.yaml"]
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+	result, err := Create(t.Context(), bundleFile, CreateOptions{Config: newTestConfig(), Signing: SigningOptions{Mode: SigningModeUnsigned}, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+
+	extracted, err := artifact.ExtractArtifact(t.Context(), iostreams.IOStreams{}, result.OutputPath, t.TempDir())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{`tenant["blue"]`, `tenant["red"]`}, slices.Collect(maps.Keys(extracted.PackageManifests)))
+	valuesFiles, err := extracted.ValuesFilesByPackage()
+	require.NoError(t, err)
+	require.Len(t, valuesFiles[`tenant["red"]`], 1)
+	red, err := os.ReadFile(valuesFiles[`tenant["red"]`][0])
+	require.NoError(t, err)
+	assert.Equal(t, "tenant: red\n", string(red))
+}
+
+func TestCreate_JSONSyntax(t *testing.T) {
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "app"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "domain.txt"), []byte("uds.dev"), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleinternal.BundleJSONFileName), []byte(`{
+  "uds": {"bundle_api_version": "uds.dev/v1alpha1"},
+  "metadata": {"name": "app", "version": "1.0.0"},
+  "package": {"app": {"source": "app", "signature_verification": {"verify": false}}}
+}`), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleinternal.BundleDefaultsJSONFileName), []byte(`{
+  "variables": {"domain": "${file(\"domain.txt\")}"}
+}`), tmpFilePerm))
+
+	bundleFile := bundleinternal.ResolveBundlePath(dir)
+	result, err := Create(t.Context(), bundleFile, CreateOptions{Config: newTestConfig(), Signing: SigningOptions{Mode: SigningModeUnsigned}, Streams: iostreams.New(nil, nil, io.Discard)})
+	require.NoError(t, err)
+
+	extracted, err := artifact.ExtractArtifact(t.Context(), iostreams.IOStreams{}, result.OutputPath, t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, bundleFileName, filepath.Base(extracted.BundleDefPath))
+	assert.Contains(t, slices.Collect(maps.Keys(extracted.PackageManifests)), "app")
+	defaults, err := bundleinternal.ParseDefaults(t.Context(), filepath.Join(filepath.Dir(extracted.BundleDefPath), bundleDefaultsFileName))
+	require.NoError(t, err)
+	assert.Equal(t, bundleinternal.Variables{"domain": "uds.dev"}, defaults)
+}
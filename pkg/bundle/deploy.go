@@ -6,6 +6,7 @@ package bundle
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 	"github.com/defenseunicorns/uds-cli/internal/artifact"
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
 	internalzarf "github.com/defenseunicorns/uds-cli/internal/zarf"
 	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
@@ -89,6 +91,9 @@ type DeploySource struct {
 	Bundle *spec.UDSBundle
 	// Loader overrides how package layouts are obtained; nil means use the default source loader.
 	Loader ZarfPackageLayoutLoader
+	// SignedBy lists the signers that satisfied the verification policy when
+	// the source was prepared from an OCI reference.
+	SignedBy []string
 
 	close func() error
 }
@@ -107,7 +112,13 @@ type DeployOptions struct {
 	Packages []string
 	// Force bypasses ValidateDeploySafety, allowing selected packages to deploy
 	// even when required dependencies are absent.
-	Force              bool
+	Force bool
+	// Prune removes, after a successful deploy, the packages the cluster
+	// records as deployed by this bundle that it no longer declares.
+	Prune bool
+	// PruneDryRun reports those packages in DeployResult.Orphans without
+	// removing them.
+	PruneDryRun        bool
 	BundleDeployHooks  BundleDeployHooks
 	PackageDeployHooks PackageDeployHooks
 	Streams            iostreams.IOStreams
@@ -116,7 +127,11 @@ type DeployOptions struct {
 // DeployResult represents the output of a bundle deploy operation.
 type DeployResult struct {
 	BundleName string                `json:"bundleName" yaml:"bundleName" text:"Bundle Name"`
+	SignedBy   []string              `json:"signedBy,omitempty" yaml:"signedBy,omitempty" text:"Signed By,omitempty"`
 	Packages   []DeployPackageResult `json:"packages" yaml:"packages" text:"Packages"`
+	// Orphans lists the packages found for pruning when Prune or PruneDryRun is set.
+	Orphans []string              `json:"orphans,omitempty" yaml:"orphans,omitempty" text:"Orphans,omitempty"`
+	Pruned  []RemovePackageResult `json:"pruned,omitempty" yaml:"pruned,omitempty" text:"Pruned,omitempty"`
 }
 
 // DeployPackageResult represents a package successfully deployed as part of a bundle.
@@ -183,9 +198,75 @@ func Deploy(ctx context.Context, source *DeploySource, opts DeployOptions) (*Dep
 		return nil, fmt.Errorf("%w: deployer returned no result", ErrDeployBundle)
 	}
 	s.Info("bundle deployed", "name", result.BundleName, "packages", len(result.Packages))
+
+	if opts.Prune || opts.PruneDryRun {
+		if err := pruneDeployed(ctx, s, b, opts, result); err != nil {
+			return result, fmt.Errorf("%w %q: %w", ErrPruneBundle, b.Metadata.Name, err)
+		}
+	}
 	return result, nil
 }
 
+// DeployedOrphans returns the packages the cluster records as deployed by the
+// bundle named b.Metadata.Name that b no longer declares. These are the
+// packages a deploy with Prune removes.
+func DeployedOrphans(ctx context.Context, b *spec.UDSBundle, opts DeployOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	orphans, err := newZarfRemover(s).remover.DeployedOrphans(ctx, b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPruneBundle, err)
+	}
+	return orphans, nil
+}
+
+// pruneDeployed records the orphans of a deployed bundle on result and, unless
+// opts.PruneDryRun is set, removes them. Cluster state is read again after the
+// deploy, so a package that was renamed onto an existing Zarf package is not
+// mistaken for an orphan.
+func pruneDeployed(ctx context.Context, s iostreams.IOStreams, b *spec.UDSBundle, opts DeployOptions, result *DeployResult) error {
+	remover := newZarfRemover(s)
+	orphans, err := remover.remover.DeployedOrphans(ctx, b)
+	if err != nil {
+		return err
+	}
+	result.Orphans = orphans
+	if len(orphans) == 0 {
+		s.Info("no packages to prune", "name", b.Metadata.Name)
+		return nil
+	}
+	if opts.PruneDryRun {
+		s.Info("packages would be pruned", "name", b.Metadata.Name, "packages", orphans)
+		return nil
+	}
+	if !opts.Force {
+		deployed, err := remover.remover.DeployedBundle(ctx, b.Metadata.Name)
+		if err != nil {
+			return err
+		}
+		if err := validateRemovalSafety(ctx, s, deployed, orphans); err != nil {
+			return fmt.Errorf("unable to prune safely: %w", err)
+		}
+	}
+
+	pruned, err := remover.pruneDeployedBundle(ctx, b, removePackageOptions{
+		Config:        opts.Config,
+		Force:         opts.Force,
+		SafetyChecked: !opts.Force,
+	})
+	if pruned != nil {
+		result.Pruned = pruned.Packages
+	}
+	if err != nil {
+		return err
+	}
+	removed, skipped := countRemovalResults(result.Pruned)
+	s.Info("bundle prune complete", "name", b.Metadata.Name, "removed", removed, "skipped", skipped)
+	return nil
+}
+
 type zarfDeployer struct {
 	deployer *internalzarf.ZarfDeployer
 	streams  iostreams.IOStreams
@@ -239,6 +320,7 @@ func toZarfConfig(cfg *UDSBundleConfig) *internalzarf.UDSBundleConfig {
 			LogLevel: cfg.Options.LogLevel, Architecture: cfg.Options.Architecture,
 			PlainHTTP: cfg.Options.PlainHTTP, SkipTLSVerify: cfg.Options.SkipTLSVerify,
 			TmpDir: cfg.Options.TmpDir, Concurrency: cfg.Options.Concurrency,
+			ChunkConcurrency: cfg.Options.ChunkConcurrency,
 		}
 	}
 	return &internalzarf.UDSBundleConfig{Options: options, Variables: bundleinternal.Variables(cfg.Variables)}
@@ -254,6 +336,7 @@ func fromZarfConfig(cfg *internalzarf.UDSBundleConfig) *UDSBundleConfig {
 			LogLevel: cfg.Options.LogLevel, Architecture: cfg.Options.Architecture,
 			PlainHTTP: cfg.Options.PlainHTTP, SkipTLSVerify: cfg.Options.SkipTLSVerify,
 			TmpDir: cfg.Options.TmpDir, Concurrency: cfg.Options.Concurrency,
+			ChunkConcurrency: cfg.Options.ChunkConcurrency,
 		}
 	}
 	return &UDSBundleConfig{Options: options, Variables: Variables(cfg.Variables)}
@@ -397,6 +480,14 @@ func fromInternalVariableValue(value any) any {
 
 // PrepareDeploySource prepares a bundle directory or verified tar.zst artifact.
 func PrepareDeploySource(ctx context.Context, streams iostreams.IOStreams, path, tmpDir, architecture string) (*DeploySource, error) {
+	return PrepareDeploySourceForPackages(ctx, streams, path, tmpDir, architecture, nil)
+}
+
+// PrepareDeploySourceForPackages prepares a deploy source like
+// PrepareDeploySource, but extracts only the content of a tar.zst artifact
+// needed to deploy the packages named in packages. Every blob in the artifact
+// is still digest-verified. An empty packages extracts the whole artifact.
+func PrepareDeploySourceForPackages(ctx context.Context, streams iostreams.IOStreams, path, tmpDir, architecture string, packages []string) (*DeploySource, error) {
 	if path == "" {
 		return nil, fmt.Errorf("path must not be empty: %w", ErrSourceRequired)
 	}
@@ -414,30 +505,92 @@ func PrepareDeploySource(ctx context.Context, streams iostreams.IOStreams, path,
 		return nil, fmt.Errorf("%w: creating workspace for bundle artifact: %w", ErrPrepareDeploySource, err)
 	}
 	cleanup := func() error { return os.RemoveAll(workspaceDir) }
-	extracted, err := artifact.ExtractArtifact(ctx, streams, path, workspaceDir)
+	extracted, err := artifact.ExtractArtifactPackages(ctx, streams, path, workspaceDir, packages)
 	if err != nil {
 		_ = cleanup()
 		return nil, fmt.Errorf("%w: extracting bundle artifact: %w", ErrPrepareDeploySource, err)
 	}
-	valuesOverride, err := extracted.ValuesFilesByPackage()
+	source, err := newArtifactDeploySource(ctx, streams, extracted, architecture)
+	if err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("%w from %q: %w", ErrPrepareDeploySource, path, err)
+	}
+	source.close = cleanup
+	return source, nil
+}
+
+// PrepareOCIDeploySource prepares a deploy source directly from a bundle in an
+// OCI registry. The bundle is verified with the same policy as Pull, then only
+// the bundle definition and the packages named in packages (every package when
+// empty) are pulled into a temporary OCI layout. No archive is written, and
+// unselected package layers are never downloaded. Close removes the layout.
+func PrepareOCIDeploySource(ctx context.Context, ref string, packages []string, opts PullOptions) (*DeploySource, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if !opts.SkipSignatureVerification {
+		if err := opts.Verification.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if ref == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	if err := validateOCIReference(ref); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrPrepareDeploySource, ref, err)
+	}
+	return prepareOCIDeploySource(ctx, ref, packages, opts, pullHooks{})
+}
+
+func prepareOCIDeploySource(ctx context.Context, ref string, packages []string, opts PullOptions, hooks pullHooks) (*DeploySource, error) {
+	workspaceDir, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-deploy-*")
 	if err != nil {
+		return nil, fmt.Errorf("%w: creating workspace for bundle artifact: %w", ErrPrepareDeploySource, err)
+	}
+	cleanup := func() error { return os.RemoveAll(workspaceDir) }
+
+	var signedBy []string
+	pullOpts := toOCIPullOptions(opts, hooks, &signedBy)
+	pullOpts.Packages = packages
+	if _, err := udsoci.NewDefaultPuller().PullBundleLayout(ctx, ref, workspaceDir, pullOpts); err != nil {
 		_ = cleanup()
-		return nil, fmt.Errorf("%w: collecting values files from artifact: %w", ErrPrepareDeploySource, err)
+		if errors.Is(err, udsoci.ErrBundleSignatureNotFound) {
+			return nil, fmt.Errorf("%w %q: %w: %w", ErrPullBundle, ref, ErrBundleNotSigned, err)
+		}
+		return nil, fmt.Errorf("%w %q: %w", ErrPullBundle, ref, err)
 	}
+	extracted, err := artifact.OpenPulledBundle(ctx, opts.Streams, workspaceDir, packages)
+	if err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("%w: opening pulled bundle: %w", ErrPrepareDeploySource, err)
+	}
+	source, err := newArtifactDeploySource(ctx, opts.Streams, extracted, opts.Config.Options.Architecture)
+	if err != nil {
+		_ = cleanup()
+		return nil, fmt.Errorf("%w from %q: %w", ErrPrepareDeploySource, ref, err)
+	}
+	source.SignedBy = signedBy
+	source.close = cleanup
+	return source, nil
+}
 
+// newArtifactDeploySource parses an extracted bundle and points its values
+// files and package loader at the extracted content.
+func newArtifactDeploySource(ctx context.Context, streams iostreams.IOStreams, extracted *artifact.ExtractedBundle, architecture string) (*DeploySource, error) {
+	valuesOverride, err := extracted.ValuesFilesByPackage()
+	if err != nil {
+		return nil, fmt.Errorf("collecting values files from artifact: %w", err)
+	}
 	bundleBytes, err := os.ReadFile(extracted.BundleDefPath)
 	if err != nil {
-		_ = cleanup()
-		return nil, fmt.Errorf("%w: reading extracted bundle definition: %w", ErrPrepareDeploySource, err)
+		return nil, fmt.Errorf("reading extracted bundle definition: %w", err)
 	}
 	preparedBundle, err := bundleinternal.NewHCLParser(architecture, streams).ParseBundleBytes(ctx, bundleBytes)
 	if err != nil {
-		_ = cleanup()
-		return nil, fmt.Errorf("%w: parsing extracted bundle definition: %w", ErrPrepareDeploySource, err)
+		return nil, fmt.Errorf("parsing extracted bundle definition: %w", err)
 	}
 	if err := applyArtifactValuesFiles(preparedBundle, valuesOverride, extracted.Dir); err != nil {
-		_ = cleanup()
-		return nil, fmt.Errorf("%w from %q: %w", ErrPrepareDeploySource, path, err)
+		return nil, err
 	}
 
 	source := &DeploySource{
@@ -446,12 +599,10 @@ func PrepareDeploySource(ctx context.Context, streams iostreams.IOStreams, path,
 		Loader: &extractedArtifactPackageLayoutLoader{loader: &internalzarf.ExtractedArtifactPackageLayoutLoader{
 			OCIDir: extracted.OCIDir, PackageManifests: extracted.PackageManifests,
 		}},
-		close: cleanup,
 	}
 	source.DefaultsPath, err = bundleinternal.AdjacentDefaultsPath(filepath.Dir(extracted.BundleDefPath))
 	if err != nil {
-		_ = cleanup()
-		return nil, fmt.Errorf("%w: discovering adjacent defaults: %w", ErrPrepareDeploySource, err)
+		return nil, fmt.Errorf("discovering adjacent defaults: %w", err)
 	}
 	return source, nil
 }
@@ -0,0 +1,169 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/internal/oci"
+	internalzarf "github.com/defenseunicorns/uds-cli/internal/zarf"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/google/go-containerregistry/pkg/registry"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"github.com/zarf-dev/zarf/src/pkg/packager"
+	"github.com/zarf-dev/zarf/src/pkg/packager/layout"
+	"github.com/zarf-dev/zarf/src/pkg/value"
+)
+
+func TestPrepareOCIDeploySource_PullsOnlySelectedPackages(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "localpkg"))
+	otherPkg := filepath.Join(dir, "otherpkg")
+	writeMinimalZarfPackage(t, otherPkg)
+	otherZarfYAML := []byte("kind: ZarfPackageConfig\nmetadata:\n  name: other\n  version: 0.0.2\n  aggregateChecksum: e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855\ncomponents: []\n")
+	require.NoError(t, os.WriteFile(filepath.Join(otherPkg, "zarf.yaml"), otherZarfYAML, tmpFilePerm))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "values"), tempDirPerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values", "pkg1.yaml"), []byte("replicas: 2\n"), tmpFilePerm))
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "selective"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source       = "localpkg"
+  values_files = ["values/pkg1.yaml"]
+  signature_verification { verify = false }
+}
+package "pkg2" {
+  source = "otherpkg"
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+	created, err := Create(t.Context(), bundleFile, CreateOptions{
+		Config:  newTestConfig(),
+		Signing: SigningOptions{Mode: SigningModeUnsigned},
+		Streams: iostreams.New(nil, nil, io.Discard),
+	})
+	require.NoError(t, err)
+	signingKey, publicKey := generateTestKeyPair(t)
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  created.OutputPath,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: signingKey},
+		TmpDir:  t.TempDir(),
+	}))
+
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	config.Options.TmpDir = t.TempDir()
+	ref := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/test/selective:1.0.0"
+	_, err = Push(t.Context(), created.OutputPath, ref, PushOptions{Config: config})
+	require.NoError(t, err)
+
+	source, err := PrepareOCIDeploySource(t.Context(), ref, []string{"pkg1"}, PullOptions{
+		Config:       config,
+		Verification: VerificationPolicy{PublicKey: publicKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"public-key"}, source.SignedBy)
+	require.NotNil(t, source.Bundle)
+	require.Len(t, source.Bundle.Packages, 2)
+	require.Equal(t, []string{filepath.Join("values", "pkg1", "0.yaml")}, source.Bundle.Packages[0].ValuesFiles)
+
+	workspace := filepath.Dir(source.BundlePath)
+	idxBytes, err := os.ReadFile(filepath.Join(workspace, "oci", "index.json"))
+	require.NoError(t, err)
+	var idx ocispec.Index
+	require.NoError(t, json.Unmarshal(idxBytes, &idx))
+	manifests := make(map[string]ocispec.Descriptor)
+	for _, desc := range idx.Manifests {
+		if name := desc.Annotations[oci.AnnotationPackageName]; name != "" {
+			manifests[name] = desc
+		}
+	}
+	require.Len(t, manifests, 2)
+	require.FileExists(t, filepath.Join(workspace, "oci", "blobs", "sha256", manifests["pkg1"].Digest.Hex()))
+	require.NoFileExists(t, filepath.Join(workspace, "oci", "blobs", "sha256", manifests["pkg2"].Digest.Hex()))
+	entries, err := os.ReadDir(workspace)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		require.False(t, strings.HasSuffix(entry.Name(), ".tar.zst"), "deploy source must not write an archive")
+	}
+
+	require.NoError(t, source.Close())
+	require.NoDirExists(t, workspace)
+
+	_, err = PrepareOCIDeploySource(t.Context(), ref, []string{"missing"}, PullOptions{Config: config, SkipSignatureVerification: true})
+	require.ErrorIs(t, err, oci.ErrBundlePackageNotFound)
+	require.ErrorContains(t, err, "missing")
+	leftovers, err := os.ReadDir(config.Options.TmpDir)
+	require.NoError(t, err)
+	require.Empty(t, leftovers)
+}
+
+func TestDeployPackage_ArtifactRendersValuesTemplateFiles(t *testing.T) {
+	dir := t.TempDir()
+	writeMinimalZarfPackage(t, filepath.Join(dir, "localpkg"))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "certs"), tempDirPerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "certs", "ca.pem"), []byte("line1\nline2"), tmpFilePerm))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "values"), tempDirPerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "values", "pkg1.yaml"), []byte("ca: |{{ file \"certs/ca.pem\" | nindent 2 }}\ndomain: {{ .vars.domain }}\n"), tmpFilePerm))
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "files"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source       = "localpkg"
+  values_files = ["values/pkg1.yaml"]
+  signature_verification { verify = false }
+}
+`), tmpFilePerm))
+	streams := iostreams.New(nil, nil, io.Discard)
+	created, err := Create(t.Context(), bundleFile, CreateOptions{
+		Config:  newTestConfig(),
+		Signing: SigningOptions{Mode: SigningModeUnsigned},
+		Streams: streams,
+	})
+	require.NoError(t, err)
+	// The artifact must render without the files its templates read.
+	require.NoError(t, os.RemoveAll(filepath.Join(dir, "certs")))
+
+	source, err := PrepareDeploySource(t.Context(), streams, created.OutputPath, t.TempDir(), runtime.GOARCH)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = source.Close() })
+	config := newTestConfig()
+	config.Options.TmpDir = t.TempDir()
+	config.Variables = Variables{"domain": "uds.dev"}
+
+	var values value.Values
+	err = newZarfDeployer(streams, source.Loader).deployer.DeployPackage(t.Context(), &source.Bundle.Packages[0], internalzarf.DeployPackageOptions{
+		Config:    toZarfConfig(config),
+		BundleDir: filepath.Dir(source.BundlePath),
+		ClusterDeployFn: func(_ context.Context, _ *layout.PackageLayout, opts *packager.DeployOptions, _ bool) error {
+			values = opts.Values
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "line1\nline2\n", values["ca"])
+	require.Equal(t, "uds.dev", values["domain"])
+}
@@ -0,0 +1,280 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// DiffOptions configures a comparison between two built bundles.
+type DiffOptions struct {
+	Old     string
+	New     string
+	Config  *UDSBundleConfig
+	Streams iostreams.IOStreams
+}
+
+// DiffResult represents the differences between two built bundles.
+type DiffResult struct {
+	Old             string           `json:"old" yaml:"old" text:"Old"`
+	OldDigest       string           `json:"oldDigest" yaml:"oldDigest" text:"Old Digest"`
+	New             string           `json:"new" yaml:"new" text:"New"`
+	NewDigest       string           `json:"newDigest" yaml:"newDigest" text:"New Digest"`
+	Version         *ValueChange     `json:"version,omitempty" yaml:"version,omitempty" text:"Version,omitempty"`
+	PackagesAdded   []string         `json:"packagesAdded,omitempty" yaml:"packagesAdded,omitempty" text:"Packages Added,omitempty"`
+	PackagesRemoved []string         `json:"packagesRemoved,omitempty" yaml:"packagesRemoved,omitempty" text:"Packages Removed,omitempty"`
+	Defaults        []VariableChange `json:"defaults,omitempty" yaml:"defaults,omitempty" text:"Defaults,omitempty"`
+	Packages        []PackageDiff    `json:"packages,omitempty" yaml:"packages,omitempty" text:"Changed Packages,omitempty"`
+}
+
+// ValueChange records an old and new value for a changed field.
+type ValueChange struct {
+	Old string `json:"old" yaml:"old" text:"Old"`
+	New string `json:"new" yaml:"new" text:"New"`
+}
+
+// VariableChange records a changed defaults.uds.hcl variable. Nested variables
+// are reported with dotted names.
+type VariableChange struct {
+	Name   string `json:"name" yaml:"name" text:"Name"`
+	Change string `json:"change" yaml:"change" text:"Change"`
+	Old    string `json:"old,omitempty" yaml:"old,omitempty" text:"Old,omitempty"`
+	New    string `json:"new,omitempty" yaml:"new,omitempty" text:"New,omitempty"`
+}
+
+// ValuesFileChange records a changed values file by its declaration index.
+type ValuesFileChange struct {
+	Index     int    `json:"index" yaml:"index" text:"Index"`
+	Change    string `json:"change" yaml:"change" text:"Change"`
+	OldDigest string `json:"oldDigest,omitempty" yaml:"oldDigest,omitempty" text:"Old Digest,omitempty"`
+	NewDigest string `json:"newDigest,omitempty" yaml:"newDigest,omitempty" text:"New Digest,omitempty"`
+}
+
+// PackageDiff reports the differences for a package present in both bundles.
+type PackageDiff struct {
+	Name             string             `json:"name" yaml:"name" text:"Name"`
+	Source           *ValueChange       `json:"source,omitempty" yaml:"source,omitempty" text:"Source,omitempty"`
+	Version          *ValueChange       `json:"version,omitempty" yaml:"version,omitempty" text:"Version,omitempty"`
+	ManifestDigest   *ValueChange       `json:"manifestDigest,omitempty" yaml:"manifestDigest,omitempty" text:"Manifest Digest,omitempty"`
+	DependsOnAdded   []string           `json:"dependsOnAdded,omitempty" yaml:"dependsOnAdded,omitempty" text:"DependsOn Added,omitempty"`
+	DependsOnRemoved []string           `json:"dependsOnRemoved,omitempty" yaml:"dependsOnRemoved,omitempty" text:"DependsOn Removed,omitempty"`
+	ValuesFiles      []ValuesFileChange `json:"valuesFiles,omitempty" yaml:"valuesFiles,omitempty" text:"Value Files,omitempty"`
+	ImagesAdded      []string           `json:"imagesAdded,omitempty" yaml:"imagesAdded,omitempty" text:"Images Added,omitempty"`
+	ImagesRemoved    []string           `json:"imagesRemoved,omitempty" yaml:"imagesRemoved,omitempty" text:"Images Removed,omitempty"`
+}
+
+const (
+	// ChangeAdded means the entry exists only in the new bundle.
+	ChangeAdded = "added"
+	// ChangeRemoved means the entry exists only in the old bundle.
+	ChangeRemoved = "removed"
+	// ChangeModified means the entry exists in both bundles with different content.
+	ChangeModified = "modified"
+)
+
+// Diff compares two built local or OCI bundles. It reads bundle indexes,
+// definitions, and package metadata without pulling package layers, and does
+// not verify bundle signatures.
+func Diff(ctx context.Context, opts DiffOptions) (*DiffResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	streams := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	read := func(source string) (*artifact.BundleContents, error) {
+		streams.Debug("reading bundle contents", "source", source)
+		contents, err := artifact.ReadContents(ctx, artifact.InspectOptions{
+			Source:  source,
+			Config:  toInternalConfig(opts.Config),
+			Streams: streams,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrDiffBundle, source, err)
+		}
+		return contents, nil
+	}
+	oldContents, err := read(opts.Old)
+	if err != nil {
+		return nil, err
+	}
+	newContents, err := read(opts.New)
+	if err != nil {
+		return nil, err
+	}
+
+	result := diffContents(oldContents, newContents)
+	result.Old = opts.Old
+	result.New = opts.New
+	return result, nil
+}
+
+// Validate validates diff options without performing I/O.
+func (o DiffOptions) Validate() error {
+	if strings.TrimSpace(o.Old) == "" || strings.TrimSpace(o.New) == "" {
+		return fmt.Errorf("old and new sources are required: %w", ErrSourceRequired)
+	}
+	if err := validateConfig(o.Config); err != nil {
+		return err
+	}
+	for _, source := range []string{o.Old, o.New} {
+		if udsoci.IsOCIReference(source) {
+			if err := validateOCIReference(source); err != nil {
+				return fmt.Errorf("%w %q: %w", ErrDiffBundle, source, err)
+			}
+			continue
+		}
+		if !artifact.IsTarZst(source) {
+			return fmt.Errorf("%w %q: source must be a .tar.zst bundle artifact or OCI reference", ErrDiffBundle, source)
+		}
+	}
+	return nil
+}
+
+func diffContents(oldContents, newContents *artifact.BundleContents) *DiffResult {
+	result := &DiffResult{
+		OldDigest: oldContents.ArtifactDigest,
+		NewDigest: newContents.ArtifactDigest,
+		Version:   changed(oldContents.Bundle.Metadata.Version, newContents.Bundle.Metadata.Version),
+		Defaults:  diffVariables(oldContents.Defaults, newContents.Defaults),
+	}
+	for _, pkg := range oldContents.Bundle.Packages {
+		if _, ok := newContents.Packages[pkg.Name]; !ok {
+			result.PackagesRemoved = append(result.PackagesRemoved, pkg.Name)
+		}
+	}
+	for _, pkg := range newContents.Bundle.Packages {
+		oldPkg, ok := oldContents.Packages[pkg.Name]
+		if !ok {
+			result.PackagesAdded = append(result.PackagesAdded, pkg.Name)
+			continue
+		}
+		if pkgDiff := diffPackage(pkg.Name, oldPkg, newContents.Packages[pkg.Name]); pkgDiff != nil {
+			result.Packages = append(result.Packages, *pkgDiff)
+		}
+	}
+	return result
+}
+
+func diffPackage(name string, oldPkg, newPkg artifact.PackageContents) *PackageDiff {
+	d := PackageDiff{
+		Name:           name,
+		Source:         changed(oldPkg.Source, newPkg.Source),
+		Version:        changed(oldPkg.Version, newPkg.Version),
+		ManifestDigest: changed(oldPkg.ManifestDigest, newPkg.ManifestDigest),
+		ValuesFiles:    diffValuesFiles(oldPkg.ValuesFiles, newPkg.ValuesFiles),
+	}
+	d.DependsOnAdded, d.DependsOnRemoved = diffSets(oldPkg.DependsOn, newPkg.DependsOn)
+	d.ImagesAdded, d.ImagesRemoved = diffSets(oldPkg.Images, newPkg.Images)
+	if d.Source == nil && d.Version == nil && d.ManifestDigest == nil && len(d.ValuesFiles) == 0 &&
+		len(d.DependsOnAdded) == 0 && len(d.DependsOnRemoved) == 0 && len(d.ImagesAdded) == 0 && len(d.ImagesRemoved) == 0 {
+		return nil
+	}
+	return &d
+}
+
+func changed(oldValue, newValue string) *ValueChange {
+	if oldValue == newValue {
+		return nil
+	}
+	return &ValueChange{Old: oldValue, New: newValue}
+}
+
+// diffSets returns the sorted entries only in newValues and only in oldValues.
+func diffSets(oldValues, newValues []string) (added, removed []string) {
+	for _, v := range newValues {
+		if !slices.Contains(oldValues, v) && !slices.Contains(added, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range oldValues {
+		if !slices.Contains(newValues, v) && !slices.Contains(removed, v) {
+			removed = append(removed, v)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	return added, removed
+}
+
+func diffValuesFiles(oldDigests, newDigests []string) []ValuesFileChange {
+	var changes []ValuesFileChange
+	for i := range max(len(oldDigests), len(newDigests)) {
+		switch {
+		case i >= len(oldDigests):
+			changes = append(changes, ValuesFileChange{Index: i, Change: ChangeAdded, NewDigest: newDigests[i]})
+		case i >= len(newDigests):
+			changes = append(changes, ValuesFileChange{Index: i, Change: ChangeRemoved, OldDigest: oldDigests[i]})
+		case oldDigests[i] != newDigests[i]:
+			changes = append(changes, ValuesFileChange{Index: i, Change: ChangeModified, OldDigest: oldDigests[i], NewDigest: newDigests[i]})
+		}
+	}
+	return changes
+}
+
+func diffVariables(oldVars, newVars bundleinternal.Variables) []VariableChange {
+	oldFlat := map[string]string{}
+	newFlat := map[string]string{}
+	flattenVariables("", oldVars, oldFlat)
+	flattenVariables("", newVars, newFlat)
+
+	names := make([]string, 0, len(oldFlat)+len(newFlat))
+	for name := range oldFlat {
+		names = append(names, name)
+	}
+	for name := range newFlat {
+		if _, ok := oldFlat[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var changes []VariableChange
+	for _, name := range names {
+		oldValue, inOld := oldFlat[name]
+		newValue, inNew := newFlat[name]
+		switch {
+		case !inOld:
+			changes = append(changes, VariableChange{Name: name, Change: ChangeAdded, New: newValue})
+		case !inNew:
+			changes = append(changes, VariableChange{Name: name, Change: ChangeRemoved, Old: oldValue})
+		case oldValue != newValue:
+			changes = append(changes, VariableChange{Name: name, Change: ChangeModified, Old: oldValue, New: newValue})
+		}
+	}
+	return changes
+}
+
+// flattenVariables writes nested variables to out keyed by dotted path, with
+// leaf values rendered as JSON so types are compared as well as content.
+func flattenVariables(prefix string, vars map[string]any, out map[string]string) {
+	for key, value := range vars {
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+		switch nested := value.(type) {
+		case bundleinternal.Variables:
+			flattenVariables(name, nested, out)
+		case map[string]any:
+			flattenVariables(name, nested, out)
+		default:
+			encoded, err := json.Marshal(value)
+			if err != nil {
+				encoded = []byte(fmt.Sprintf("%v", value))
+			}
+			out[name] = string(encoded)
+		}
+	}
+}
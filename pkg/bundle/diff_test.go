@@ -0,0 +1,101 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffContents(t *testing.T) {
+	oldContents := &artifact.BundleContents{
+		Bundle: &spec.UDSBundle{
+			Metadata: spec.Metadata{Name: "core", Version: "1.0.0"},
+			Packages: []spec.Package{{Name: "init"}, {Name: "istio"}, {Name: "legacy"}},
+		},
+		ArtifactDigest: "sha256:old",
+		Defaults: bundleinternal.Variables{
+			"domain": "uds.dev",
+			"istio":  bundleinternal.Variables{"replicas": 1.0, "removed": true},
+		},
+		Packages: map[string]artifact.PackageContents{
+			"init":   {Source: "oci://example.com/init:1.0.0", Version: "1.0.0", ManifestDigest: "sha256:init"},
+			"istio":  {Source: "oci://example.com/istio:1.0.0", Version: "1.0.0", ManifestDigest: "sha256:istio-1", DependsOn: []string{"init"}, ValuesFiles: []string{"sha256:a", "sha256:b"}, Images: []string{"istio/pilot:1.0", "istio/proxy:1.0"}},
+			"legacy": {Source: "legacy.tar.zst", ManifestDigest: "sha256:legacy"},
+		},
+	}
+	newContents := &artifact.BundleContents{
+		Bundle: &spec.UDSBundle{
+			Metadata: spec.Metadata{Name: "core", Version: "1.1.0"},
+			Packages: []spec.Package{{Name: "init"}, {Name: "istio"}, {Name: "keycloak"}},
+		},
+		ArtifactDigest: "sha256:new",
+		Defaults: bundleinternal.Variables{
+			"domain": "uds.dev",
+			"istio":  bundleinternal.Variables{"replicas": 2.0},
+			"realm":  "uds",
+		},
+		Packages: map[string]artifact.PackageContents{
+			"init":     {Source: "oci://example.com/init:1.0.0", Version: "1.0.0", ManifestDigest: "sha256:init"},
+			"istio":    {Source: "oci://example.com/istio:1.1.0", Version: "1.1.0", ManifestDigest: "sha256:istio-2", DependsOn: []string{"keycloak"}, ValuesFiles: []string{"sha256:a", "sha256:c", "sha256:d"}, Images: []string{"istio/pilot:1.1", "istio/proxy:1.0"}},
+			"keycloak": {Source: "oci://example.com/keycloak:1.0.0", ManifestDigest: "sha256:keycloak"},
+		},
+	}
+
+	result := diffContents(oldContents, newContents)
+
+	assert.Equal(t, "sha256:old", result.OldDigest)
+	assert.Equal(t, "sha256:new", result.NewDigest)
+	assert.Equal(t, &ValueChange{Old: "1.0.0", New: "1.1.0"}, result.Version)
+	assert.Equal(t, []string{"keycloak"}, result.PackagesAdded)
+	assert.Equal(t, []string{"legacy"}, result.PackagesRemoved)
+	assert.Equal(t, []VariableChange{
+		{Name: "istio.removed", Change: ChangeRemoved, Old: "true"},
+		{Name: "istio.replicas", Change: ChangeModified, Old: "1", New: "2"},
+		{Name: "realm", Change: ChangeAdded, New: `"uds"`},
+	}, result.Defaults)
+
+	require.Len(t, result.Packages, 1, "unchanged packages are omitted")
+	istio := result.Packages[0]
+	assert.Equal(t, "istio", istio.Name)
+	assert.Equal(t, &ValueChange{Old: "oci://example.com/istio:1.0.0", New: "oci://example.com/istio:1.1.0"}, istio.Source)
+	assert.Equal(t, &ValueChange{Old: "1.0.0", New: "1.1.0"}, istio.Version)
+	assert.Equal(t, &ValueChange{Old: "sha256:istio-1", New: "sha256:istio-2"}, istio.ManifestDigest)
+	assert.Equal(t, []string{"keycloak"}, istio.DependsOnAdded)
+	assert.Equal(t, []string{"init"}, istio.DependsOnRemoved)
+	assert.Equal(t, []ValuesFileChange{
+		{Index: 1, Change: ChangeModified, OldDigest: "sha256:b", NewDigest: "sha256:c"},
+		{Index: 2, Change: ChangeAdded, NewDigest: "sha256:d"},
+	}, istio.ValuesFiles)
+	assert.Equal(t, []string{"istio/pilot:1.1"}, istio.ImagesAdded)
+	assert.Equal(t, []string{"istio/pilot:1.0"}, istio.ImagesRemoved)
+}
+
+func TestDiffOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    DiffOptions
+		wantErr string
+	}{
+		{name: "requires sources", opts: DiffOptions{Config: validValidationConfig()}, wantErr: "old and new sources are required"},
+		{name: "requires config", opts: DiffOptions{Old: "a.tar.zst", New: "b.tar.zst"}, wantErr: "config is required"},
+		{name: "rejects bundle definitions", opts: DiffOptions{Old: "bundle.uds.hcl", New: "b.tar.zst", Config: validValidationConfig()}, wantErr: "source must be a .tar.zst bundle artifact or OCI reference"},
+		{name: "accepts artifacts and references", opts: DiffOptions{Old: "a.tar.zst", New: "oci://example.com/bundle:v2", Config: validValidationConfig()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
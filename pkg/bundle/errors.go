@@ -23,6 +23,8 @@ var (
 	ErrTargetDirRequired = errors.New("target directory is required")
 	// ErrBundleFileRequired occurs when bundle creation has no definition file.
 	ErrBundleFileRequired = errors.New("bundle file is required")
+	// ErrBundleNameRequired occurs when a deployed bundle is addressed without a name.
+	ErrBundleNameRequired = errors.New("bundle name is required")
 	// ErrSourceRequired occurs when an operation has no bundle source.
 	ErrSourceRequired = errors.New("bundle source is required")
 	// ErrInvalidOCIReference occurs when an OCI reference cannot be parsed.
@@ -37,8 +39,14 @@ var (
 	ErrInvalidVerificationPolicy = errors.New("invalid bundle verification policy")
 	// ErrCreateBundle occurs when bundle creation fails after option validation.
 	ErrCreateBundle = errors.New("creating bundle")
+	// ErrDiffBundle occurs when bundle contents cannot be read for comparison.
+	ErrDiffBundle = errors.New("comparing bundles")
 	// ErrDeployBundle occurs when bundle parsing or deployment fails.
 	ErrDeployBundle = errors.New("deploying bundle")
+	// ErrPruneBundle occurs when packages dropped from a deployed bundle cannot be found or removed.
+	ErrPruneBundle = errors.New("pruning bundle")
+	// ErrPruneOptionsConflict occurs when a deploy asks to both prune and only report orphaned packages.
+	ErrPruneOptionsConflict = errors.New("prune and prune dry run are mutually exclusive")
 	// ErrInspectBundle occurs when reading or verifying bundle metadata fails.
 	ErrInspectBundle = errors.New("inspecting bundle")
 	// ErrPrepareDeploySource occurs when an artifact cannot be prepared for deployment.
@@ -49,6 +57,30 @@ var (
 	ErrPullBundle = errors.New("pulling bundle")
 	// ErrPushBundle occurs when bundle extraction or registry upload fails.
 	ErrPushBundle = errors.New("pushing bundle")
+	// ErrUpdateBundle occurs when a bundle lock file cannot be updated.
+	ErrUpdateBundle = errors.New("updating bundle lock")
+	// ErrLintBundle occurs when a bundle definition cannot be loaded for linting.
+	ErrLintBundle = errors.New("linting bundle")
+	// ErrMigrateBundle occurs when a legacy bundle cannot be converted or its replacement files cannot be written.
+	ErrMigrateBundle = errors.New("migrating legacy bundle")
+	// ErrMigrateOutputExists occurs when a migration would replace existing files without overwrite.
+	ErrMigrateOutputExists = errors.New("migration output already exists")
+	// ErrGraphBundle occurs when a bundle cannot be read or its dependency graph cannot be built.
+	ErrGraphBundle = errors.New("graphing bundle")
+	// ErrLanguageServer occurs when the bundle language server loses its connection to the client.
+	ErrLanguageServer = errors.New("bundle language server")
+	// ErrCopyBundle occurs when a bundle cannot be copied between OCI registries.
+	ErrCopyBundle = errors.New("copying bundle")
+	// ErrCollectGarbage occurs when unreachable manifests cannot be collected from a repository.
+	ErrCollectGarbage = errors.New("collecting registry garbage")
+	// ErrExportBundle occurs when a bundle cannot be exported to volumes.
+	ErrExportBundle = errors.New("exporting bundle")
+	// ErrImportBundle occurs when exported volumes cannot be imported.
+	ErrImportBundle = errors.New("importing bundle")
+	// ErrExportDirNotEmpty occurs when an export would write into a directory that already has content.
+	ErrExportDirNotEmpty = errors.New("export directory is not empty")
+	// ErrImportDestinationConflict occurs when an import names both an output directory and an OCI reference.
+	ErrImportDestinationConflict = errors.New("import output directory and OCI reference are mutually exclusive")
 	// ErrReconfigureBundle occurs when local or remote bundle reconfiguration fails.
 	ErrReconfigureBundle = errors.New("reconfiguring bundle")
 	// ErrSignBundle occurs when a validated bundle signing operation fails.
@@ -60,7 +92,19 @@ var (
 // ErrBundleNotSigned indicates that a bundle has no signature evidence.
 var ErrBundleNotSigned = errors.New("bundle is not signed")
 
-var _ error = (*DependencyViolationError)(nil)
+// ErrSignaturePolicyNotSatisfied indicates that bundle signature evidence did not match enough trusted signers.
+var ErrSignaturePolicyNotSatisfied = errors.New("bundle signature does not satisfy the verification policy")
+
+// ErrAttestationSubjectMismatch indicates that a bundle attestation describes a different bundle index.
+var ErrAttestationSubjectMismatch = errors.New("bundle attestation subject does not match the bundle")
+
+// ErrNotReproducible indicates that two builds of the same bundle inputs produced different archives.
+var ErrNotReproducible = errors.New("bundle archive is not reproducible")
+
+var (
+	_ error = (*DependencyViolationError)(nil)
+	_ error = (*NotReproducibleError)(nil)
+)
 
 type DependencyViolationError struct {
 	// Violations maps a package name to its related package names (sorted).
@@ -95,3 +139,21 @@ func formatDependencyError(header, relation string, violations map[string][]stri
 		Violations: violations,
 	}
 }
+
+// NotReproducibleError reports the archive digests of two builds of the same
+// bundle and the archive entries that differ between them.
+type NotReproducibleError struct {
+	First   string
+	Second  string
+	Entries []string
+}
+
+func (e *NotReproducibleError) Error() string {
+	msg := fmt.Sprintf("%s: first build %s, second build %s", ErrNotReproducible, e.First, e.Second)
+	if len(e.Entries) != 0 {
+		msg += "; differing entries: " + strings.Join(e.Entries, ", ")
+	}
+	return msg
+}
+
+func (e *NotReproducibleError) Unwrap() error { return ErrNotReproducible }
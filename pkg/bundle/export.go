@@ -0,0 +1,193 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ExportOptions holds configuration for exporting a bundle to volumes.
+type ExportOptions struct {
+	Config *UDSBundleConfig
+	// VolumeSize limits each volume to this many bytes. Zero writes a single
+	// volume of any size.
+	VolumeSize int64
+	Streams    iostreams.IOStreams
+}
+
+// ExportResult represents the output of a bundle export operation.
+type ExportResult struct {
+	Source  string         `json:"source" yaml:"source" text:"Source"`
+	Bundle  string         `json:"bundle" yaml:"bundle" text:"Bundle"`
+	Volumes []ExportVolume `json:"volumes" yaml:"volumes" text:"Volumes"`
+}
+
+// ExportVolume describes one volume written by an export.
+type ExportVolume struct {
+	Name     string `json:"name" yaml:"name" text:"Name"`
+	Path     string `json:"path" yaml:"path" text:"Path"`
+	Size     int64  `json:"size" yaml:"size" text:"Size"`
+	Checksum string `json:"checksum" yaml:"checksum" text:"Checksum"`
+}
+
+// ImportOptions holds configuration for importing an exported bundle.
+type ImportOptions struct {
+	Config *UDSBundleConfig
+	// OutputDir receives the reassembled bundle archive. It defaults to the
+	// current directory unless OCIReference is set.
+	OutputDir string
+	// OCIReference, when set, pushes the reassembled bundle to this
+	// reference instead of writing an archive.
+	OCIReference string
+	Streams      iostreams.IOStreams
+}
+
+// ImportResult represents the output of a bundle import operation.
+type ImportResult struct {
+	Bundle       string `json:"bundle" yaml:"bundle" text:"Bundle"`
+	Volumes      int    `json:"volumes" yaml:"volumes" text:"Volumes"`
+	OutputPath   string `json:"outputPath,omitempty" yaml:"outputPath,omitempty" text:"Output Path,omitempty"`
+	OCIReference string `json:"ociReference,omitempty" yaml:"ociReference,omitempty" text:"OCI Reference,omitempty"`
+}
+
+// Export writes the bundle archive at source to outputDir as an unpacked,
+// verifiable OCI image layout for offline transfer. With a volume size, the
+// layout is split across volume directories that each fit on removable media
+// of that size. Every volume carries a manifest listing all volumes with
+// per-file digests and per-volume checksums.
+func Export(ctx context.Context, source, outputDir string, opts ExportOptions) (*ExportResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if source == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	if outputDir == "" {
+		return nil, fmt.Errorf("%w: %w", ErrExportBundle, ErrTargetDirRequired)
+	}
+	empty, err := isEmptyDir(outputDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w to %q: %w", ErrExportBundle, outputDir, err)
+	}
+	if !empty {
+		return nil, fmt.Errorf("%w to %q: %w", ErrExportBundle, outputDir, ErrExportDirNotEmpty)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	tmp, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-export-*")
+	if err != nil {
+		return nil, fmt.Errorf("%w: creating temp dir: %w", ErrExportBundle, err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	s.Info("extracting bundle archive", "source", source)
+	if err := artifact.ExtractTarZst(ctx, s, source, tmp); err != nil {
+		return nil, fmt.Errorf("%w %q: extracting bundle: %w", ErrExportBundle, source, err)
+	}
+	if err := os.MkdirAll(outputDir, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("%w to %q: %w", ErrExportBundle, outputDir, err)
+	}
+	manifest, err := artifact.ExportLayout(ctx, s, tmp, outputDir, opts.VolumeSize)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrExportBundle, source, err)
+	}
+
+	result := &ExportResult{Source: source, Bundle: manifest.Bundle.String()}
+	for _, volume := range manifest.Volumes {
+		path := outputDir
+		if len(manifest.Volumes) > 1 {
+			path = filepath.Join(outputDir, volume.Name)
+		}
+		result.Volumes = append(result.Volumes, ExportVolume{
+			Name:     volume.Name,
+			Path:     path,
+			Size:     volume.Size,
+			Checksum: volume.Checksum.String(),
+		})
+	}
+	return result, nil
+}
+
+// Import reassembles the volumes of an exported bundle, verifying every file
+// and the bundle's OCI graph. The bundle is written to a .tar.zst archive in
+// opts.OutputDir, or pushed to opts.OCIReference when one is set. Each entry
+// of volumes is a volume directory or a directory holding volume-*
+// directories.
+func Import(ctx context.Context, volumes []string, opts ImportOptions) (*ImportResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if len(volumes) == 0 {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	tmp, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-import-*")
+	if err != nil {
+		return nil, fmt.Errorf("%w: creating temp dir: %w", ErrImportBundle, err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	manifest, err := artifact.ImportLayout(ctx, s, volumes, tmp)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportBundle, err)
+	}
+	result := &ImportResult{Bundle: manifest.Bundle.String(), Volumes: len(manifest.Volumes)}
+
+	if opts.OCIReference != "" {
+		pushed, err := pushBundle(ctx, tmp, opts.OCIReference, PushOptions{Config: opts.Config, Streams: opts.Streams}, pushHooks{})
+		if err != nil {
+			return nil, fmt.Errorf("%w to %q: %w", ErrImportBundle, opts.OCIReference, err)
+		}
+		result.OCIReference = pushed.OCIReference
+		return result, nil
+	}
+
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = "."
+	}
+	ociDir := filepath.Join(tmp, "oci")
+	indexBytes, err := os.ReadFile(filepath.Join(ociDir, "index.json"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportBundle, err)
+	}
+	var idx ocispec.Index
+	if err := json.Unmarshal(indexBytes, &idx); err != nil {
+		return nil, fmt.Errorf("%w: parsing bundle index: %w", ErrImportBundle, err)
+	}
+	arch := idx.Annotations[udsoci.AnnotationBundleArchitecture]
+	if arch == "" {
+		return nil, fmt.Errorf("%w: bundle index is missing the %s annotation: %w", ErrImportBundle, udsoci.AnnotationBundleArchitecture, udsoci.ErrMissingArchitecture)
+	}
+	if err := os.MkdirAll(outputDir, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("%w to %q: %w", ErrImportBundle, outputDir, err)
+	}
+	s.Info("writing bundle archive", "output_dir", outputDir)
+	result.OutputPath, err = artifact.CreateBundleArchive(ctx, s, ociDir, outputDir, idx, arch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrImportBundle, err)
+	}
+	return result, nil
+}
+
+// isEmptyDir reports whether dir does not exist or has no entries.
+func isEmptyDir(dir string) (bool, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return true, nil
+	}
+	return len(entries) == 0, err
+}
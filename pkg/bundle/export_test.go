@@ -0,0 +1,127 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+)
+
+const exportTestBundleHCL = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "sneakernet"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`
+
+func TestExportImport_RoundTripsAcrossVolumes(t *testing.T) {
+	source := createTestBundle(t, exportTestBundleHCL, "")
+	config := newTestConfig()
+	config.Options.TmpDir = t.TempDir()
+	exportDir := filepath.Join(t.TempDir(), "export")
+
+	exported, err := Export(t.Context(), source, exportDir, ExportOptions{Config: config, VolumeSize: 6000})
+	require.NoError(t, err)
+	require.Greater(t, len(exported.Volumes), 1)
+	for _, volume := range exported.Volumes {
+		info, err := os.Stat(filepath.Join(volume.Path, artifact.ExportManifestFileName))
+		require.NoError(t, err)
+		require.LessOrEqual(t, volume.Size+info.Size(), int64(6000))
+	}
+
+	imported, err := Import(t.Context(), []string{exportDir}, ImportOptions{Config: config, OutputDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Equal(t, exported.Bundle, imported.Bundle)
+	require.Equal(t, len(exported.Volumes), imported.Volumes)
+	want, err := os.ReadFile(source)
+	require.NoError(t, err)
+	got, err := os.ReadFile(imported.OutputPath)
+	require.NoError(t, err)
+	require.Equal(t, want, got, "the imported archive must match the exported one byte for byte")
+}
+
+func TestExport_WritesSingleVolumeLayout(t *testing.T) {
+	source := createTestBundle(t, exportTestBundleHCL, "")
+	config := newTestConfig()
+	exportDir := t.TempDir()
+
+	exported, err := Export(t.Context(), source, exportDir, ExportOptions{Config: config})
+	require.NoError(t, err)
+	require.Len(t, exported.Volumes, 1)
+	require.Equal(t, exportDir, exported.Volumes[0].Path)
+	require.FileExists(t, filepath.Join(exportDir, "oci", "index.json"))
+	require.FileExists(t, filepath.Join(exportDir, "oci", "oci-layout"))
+
+	_, err = Export(t.Context(), source, exportDir, ExportOptions{Config: config})
+	require.ErrorIs(t, err, ErrExportDirNotEmpty)
+}
+
+func TestImport_RejectsTamperedAndMissingVolumes(t *testing.T) {
+	source := createTestBundle(t, exportTestBundleHCL, "")
+	config := newTestConfig()
+	exportDir := t.TempDir()
+	exported, err := Export(t.Context(), source, exportDir, ExportOptions{Config: config, VolumeSize: 6000})
+	require.NoError(t, err)
+	require.Greater(t, len(exported.Volumes), 1)
+
+	volumes := make([]string, 0, len(exported.Volumes))
+	for _, volume := range exported.Volumes {
+		volumes = append(volumes, volume.Path)
+	}
+	_, err = Import(t.Context(), volumes[1:], ImportOptions{Config: config, OutputDir: t.TempDir()})
+	var missing artifact.MissingExportVolumesError
+	require.ErrorAs(t, err, &missing)
+	require.Equal(t, []string{exported.Volumes[0].Name}, missing.Missing)
+
+	indexes, err := filepath.Glob(filepath.Join(exportDir, "volume-*", "oci", "index.json"))
+	require.NoError(t, err)
+	require.Len(t, indexes, 1)
+	index := indexes[0]
+	data, err := os.ReadFile(index)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(index, append(data, ' '), 0o600))
+	_, err = Import(t.Context(), volumes, ImportOptions{Config: config, OutputDir: t.TempDir()})
+	require.ErrorIs(t, err, ErrImportBundle)
+	var mismatch artifact.ExportFileMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	require.Equal(t, "oci/index.json", mismatch.Path)
+}
+
+func TestImport_PushesToRegistry(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	source := createTestBundle(t, exportTestBundleHCL, "")
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	exportDir := t.TempDir()
+	_, err := Export(t.Context(), source, exportDir, ExportOptions{Config: config})
+	require.NoError(t, err)
+
+	ref := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/airgap/sneakernet:1.0.0"
+	imported, err := Import(t.Context(), []string{exportDir}, ImportOptions{Config: config, OCIReference: ref})
+	require.NoError(t, err)
+	require.NotEmpty(t, imported.OCIReference)
+	require.Empty(t, imported.OutputPath)
+
+	pulled, err := Pull(t.Context(), ref, t.TempDir(), PullOptions{Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err)
+	require.Equal(t, readTarZstEntries(t, source), readTarZstEntries(t, pulled.OutputPath))
+}
+
+func TestImportOptions_Validate(t *testing.T) {
+	opts := ImportOptions{Config: newTestConfig(), OutputDir: "out", OCIReference: "localhost:5000/b:1.0.0"}
+	require.ErrorIs(t, opts.Validate(), ErrImportDestinationConflict)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// GCOptions holds configuration for garbage collecting a bundle repository.
+type GCOptions struct {
+	Config *UDSBundleConfig
+	// DryRun reports what would be deleted without deleting anything.
+	DryRun bool
+	// KeepLast, when positive, keeps only the KeepLast highest semantic
+	// version tags. Tags that are not semantic versions are always kept.
+	KeepLast int
+	Streams  iostreams.IOStreams
+}
+
+// GCResult represents the output of a registry garbage collection.
+type GCResult struct {
+	Repository  string       `json:"repository" yaml:"repository" text:"Repository"`
+	DryRun      bool         `json:"dryRun" yaml:"dryRun" text:"Dry Run"`
+	KeptTags    []string     `json:"keptTags" yaml:"keptTags" text:"Kept Tags"`
+	RemovedTags []string     `json:"removedTags,omitempty" yaml:"removedTags,omitempty" text:"Removed Tags,omitempty"`
+	Deleted     []GCManifest `json:"deleted" yaml:"deleted" text:"Deleted"`
+}
+
+// GCManifest is a manifest deleted, or with DryRun planned for deletion, by
+// garbage collection.
+type GCManifest struct {
+	Digest       string `json:"digest" yaml:"digest" text:"Digest"`
+	MediaType    string `json:"mediaType" yaml:"mediaType" text:"Media Type"`
+	ArtifactType string `json:"artifactType,omitempty" yaml:"artifactType,omitempty" text:"Artifact Type,omitempty"`
+}
+
+// CollectGarbage deletes bundle manifests in repository that no kept tag
+// reaches: child indexes and package manifests left behind when a tag was
+// re-pushed, the signatures and attestations that referred to them, and the
+// bundles behind tags dropped by the retention rule. repository must not
+// include a tag or digest. The registry must support the OCI distribution
+// delete API.
+func CollectGarbage(ctx context.Context, repository string, opts GCOptions) (*GCResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if repository == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	result, err := collectGarbage(ctx, repository, opts, gcHooks{})
+	if err != nil {
+		return nil, fmt.Errorf("%w in %q: %w", ErrCollectGarbage, repository, err)
+	}
+	return result, nil
+}
+
+type gcHooks struct {
+	target func(ctx context.Context, repository string, opts *GCOptions) (udsoci.GCTarget, error)
+}
+
+func collectGarbage(ctx context.Context, repository string, opts GCOptions, hooks gcHooks) (*GCResult, error) {
+	internal := udsoci.GCOptions{
+		Config:   toInternalConfig(opts.Config),
+		Streams:  opts.Streams,
+		DryRun:   opts.DryRun,
+		KeepLast: opts.KeepLast,
+	}
+	if hooks.target != nil {
+		internal.GCHooks.ToOrasTarget = func(ctx context.Context, repository string, _ *udsoci.GCOptions) (udsoci.GCTarget, error) {
+			return hooks.target(ctx, repository, &opts)
+		}
+	}
+	collected, err := udsoci.CollectGarbage(ctx, repository, internal)
+	if err != nil {
+		return nil, err
+	}
+	result := &GCResult{
+		Repository:  collected.Repository,
+		DryRun:      collected.DryRun,
+		KeptTags:    collected.KeptTags,
+		RemovedTags: collected.RemovedTags,
+		Deleted:     make([]GCManifest, 0, len(collected.Deleted)),
+	}
+	for _, m := range collected.Deleted {
+		result.Deleted = append(result.Deleted, GCManifest{Digest: m.Digest, MediaType: m.MediaType, ArtifactType: m.ArtifactType})
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectGarbage_DropsOldVersions(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	config.Options.TmpDir = t.TempDir()
+	repository := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/test/app"
+
+	for _, version := range []string{"1.0.0", "2.0.0"} {
+		source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "app"
+  version = "`+version+`"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+		_, err := Push(t.Context(), source, repository+":"+version, PushOptions{Config: config})
+		require.NoError(t, err)
+	}
+
+	planned, err := CollectGarbage(t.Context(), repository, GCOptions{Config: config, DryRun: true, KeepLast: 1})
+	require.NoError(t, err)
+	require.True(t, planned.DryRun)
+	require.Equal(t, []string{"1.0.0"}, planned.RemovedTags)
+	require.NotEmpty(t, planned.Deleted)
+	_, err = Pull(t.Context(), repository+":1.0.0", t.TempDir(), PullOptions{Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err, "a dry run must not delete anything")
+
+	result, err := CollectGarbage(t.Context(), repository, GCOptions{Config: config, KeepLast: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2.0.0"}, result.KeptTags)
+	require.Equal(t, planned.Deleted, result.Deleted)
+	_, err = Pull(t.Context(), repository+":2.0.0", t.TempDir(), PullOptions{Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err)
+
+	_, err = CollectGarbage(t.Context(), repository+":2.0.0", GCOptions{Config: config})
+	require.ErrorIs(t, err, ErrCollectGarbage)
+}
@@ -0,0 +1,357 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// GraphOptions configures the dependency graph of a bundle.
+type GraphOptions struct {
+	Config *UDSBundleConfig
+	// Packages, when set, highlights these packages together with the
+	// packages a deploy of them needs and the packages a removal of them
+	// would break.
+	Packages []string
+	Streams  iostreams.IOStreams
+}
+
+// GraphResult is the deploy structure of a bundle: its packages grouped into
+// levels that deploy in sequence, with the metrics that bound a deploy.
+type GraphResult struct {
+	Bundle string       `json:"bundle" yaml:"bundle" text:"Bundle"`
+	Levels []GraphLevel `json:"levels" yaml:"levels" text:"Levels"`
+	// Packages are listed in deployment order.
+	Packages []GraphPackage `json:"packages" yaml:"packages" text:"Packages"`
+	// CriticalPath is the longest dependency chain in deployment order.
+	CriticalPath []string        `json:"criticalPath" yaml:"criticalPath" text:"Critical Path"`
+	Selection    *GraphSelection `json:"selection,omitempty" yaml:"selection,omitempty" text:"Selection,omitempty"`
+}
+
+// GraphLevel is a set of packages that deploy in parallel.
+type GraphLevel struct {
+	Level    int      `json:"level" yaml:"level" text:"Level"`
+	Packages []string `json:"packages" yaml:"packages" text:"Packages"`
+}
+
+// GraphPackage is a package node of the dependency graph.
+type GraphPackage struct {
+	Name      string   `json:"name" yaml:"name" text:"Name"`
+	Source    string   `json:"source" yaml:"source" text:"Source"`
+	Level     int      `json:"level" yaml:"level" text:"Level"`
+	DependsOn []string `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty" text:"DependsOn,omitempty"`
+	// FanIn is the number of packages that depend on this one directly.
+	FanIn int `json:"fanIn" yaml:"fanIn" text:"Fan-In"`
+	// Highlight is the role of the package in the --packages selection.
+	Highlight string `json:"highlight,omitempty" yaml:"highlight,omitempty" text:"Highlight,omitempty"`
+}
+
+// GraphSelection reports the packages related to a selection of packages.
+type GraphSelection struct {
+	Packages []string `json:"packages" yaml:"packages" text:"Packages"`
+	// Dependencies are the packages the selection needs, directly or
+	// transitively, that are not selected.
+	Dependencies []string `json:"dependencies" yaml:"dependencies" text:"Dependencies"`
+	// Dependents are the packages that need the selection, directly or
+	// transitively, that are not selected.
+	Dependents []string `json:"dependents" yaml:"dependents" text:"Dependents"`
+	// MissingDependencies are the direct dependencies a deploy of the
+	// selection reports as not selected, by selected package.
+	MissingDependencies map[string][]string `json:"missingDependencies" yaml:"missingDependencies" text:"-"`
+	// BlockingDependents are the direct dependents a removal of the selection
+	// reports as left behind, by selected package.
+	BlockingDependents map[string][]string `json:"blockingDependents" yaml:"blockingDependents" text:"-"`
+}
+
+const (
+	// GraphHighlightSelected marks a package named by GraphOptions.Packages.
+	GraphHighlightSelected = "selected"
+	// GraphHighlightDependency marks a package the selection depends on.
+	GraphHighlightDependency = "dependency"
+	// GraphHighlightDependent marks a package that depends on the selection.
+	GraphHighlightDependent = "dependent"
+)
+
+// Graph computes the dependency graph of a bundle definition file or of a
+// built local or OCI bundle. Bundle blocks in a definition are fetched as by
+// Create. Built bundles are read without pulling package layers and without
+// verifying their signatures.
+func Graph(ctx context.Context, source string, opts GraphOptions) (*GraphResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	if udsoci.IsOCIReference(source) {
+		if err := validateOCIReference(source); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+		}
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	b, err := loadGraphBundle(ctx, source, opts.Config, s)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+	}
+	if err := bundleinternal.ValidatePackageNames(opts.Packages, b.Packages); err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+	}
+	dag, err := bundleinternal.BuildDependencyGraph(ctx, s, b)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+	}
+	levels, err := dag.TopologicalLevels()
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+	}
+	criticalPath, err := dag.CriticalPath()
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+	}
+
+	result := &GraphResult{Bundle: b.Metadata.Name, Levels: []GraphLevel{}, Packages: []GraphPackage{}, CriticalPath: criticalPath}
+	if result.CriticalPath == nil {
+		result.CriticalPath = []string{}
+	}
+	for i, level := range levels {
+		names := make([]string, len(level))
+		for j, pkg := range level {
+			names[j] = pkg.Name
+			result.Packages = append(result.Packages, GraphPackage{
+				Name:      pkg.Name,
+				Source:    pkg.Source,
+				Level:     i,
+				DependsOn: dag.Dependencies(pkg.Name),
+				FanIn:     len(dag.Dependents(pkg.Name)),
+			})
+		}
+		result.Levels = append(result.Levels, GraphLevel{Level: i, Packages: names})
+	}
+
+	if len(opts.Packages) > 0 {
+		selection, err := graphSelection(ctx, s, b, dag, opts.Packages)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrGraphBundle, source, err)
+		}
+		result.Selection = selection
+		for i := range result.Packages {
+			switch name := result.Packages[i].Name; {
+			case slices.Contains(selection.Packages, name):
+				result.Packages[i].Highlight = GraphHighlightSelected
+			case slices.Contains(selection.Dependencies, name):
+				result.Packages[i].Highlight = GraphHighlightDependency
+			case slices.Contains(selection.Dependents, name):
+				result.Packages[i].Highlight = GraphHighlightDependent
+			}
+		}
+	}
+	s.Debug("bundle graph computed", "bundle", b.Metadata.Name, "packages", len(result.Packages), "levels", len(result.Levels))
+	return result, nil
+}
+
+// loadGraphBundle parses the bundle at source, a built bundle or a definition
+// file.
+func loadGraphBundle(ctx context.Context, source string, config *UDSBundleConfig, s iostreams.IOStreams) (*spec.UDSBundle, error) {
+	if udsoci.IsOCIReference(source) || artifact.IsTarZst(source) {
+		s.Debug("reading built bundle", "source", source)
+		inspected, err := artifact.Inspect(ctx, artifact.InspectOptions{Source: source, Config: toInternalConfig(config), Streams: s})
+		if err != nil {
+			return nil, err
+		}
+		return inspected.Bundle, nil
+	}
+	s.Debug("parsing bundle file", "path", source)
+	included := newIncludedBundles(config, filepath.Dir(source), s)
+	defer closeIncludedBundles(s, included)
+	return bundleinternal.NewHCLParser(config.Options.Architecture, s).WithBundleResolver(included).ParseBundleFile(ctx, source)
+}
+
+// graphSelection relates the selected packages to the rest of the bundle the
+// way deploy and remove do when only those packages are requested.
+func graphSelection(ctx context.Context, s iostreams.IOStreams, b *spec.UDSBundle, dag *bundleinternal.DAG, packages []string) (*GraphSelection, error) {
+	selected := slices.Clone(packages)
+	slices.Sort(selected)
+	selected = slices.Compact(selected)
+	missing, err := bundleinternal.DeployViolations(ctx, s, b, selected)
+	if err != nil {
+		return nil, err
+	}
+	blocking, err := bundleinternal.RemovalViolations(ctx, s, b, selected)
+	if err != nil {
+		return nil, err
+	}
+	return &GraphSelection{
+		Packages:            selected,
+		Dependencies:        dag.TransitiveDependencies(selected),
+		Dependents:          dag.TransitiveDependents(selected),
+		MissingDependencies: missing,
+		BlockingDependents:  blocking,
+	}, nil
+}
+
+// graphColors fills highlighted nodes in DOT and Mermaid output.
+var graphColors = map[string]string{
+	GraphHighlightSelected:   "#ffd966",
+	GraphHighlightDependency: "#9fc5e8",
+	GraphHighlightDependent:  "#ea9999",
+}
+
+// criticalEdges returns the package -> dependency edges on the critical path.
+func (r *GraphResult) criticalEdges() map[[2]string]bool {
+	edges := map[[2]string]bool{}
+	for i := 1; i < len(r.CriticalPath); i++ {
+		edges[[2]string{r.CriticalPath[i], r.CriticalPath[i-1]}] = true
+	}
+	return edges
+}
+
+// graphComments returns the metrics written as comments at the top of DOT
+// and Mermaid output.
+func (r *GraphResult) graphComments() []string {
+	comments := []string{
+		fmt.Sprintf("Dependency graph of bundle %q. Edges point from a package to the packages it depends on.", r.Bundle),
+		fmt.Sprintf("critical path (%d): %s", len(r.CriticalPath), strings.Join(r.CriticalPath, " -> ")),
+	}
+	maxFanIn := 0
+	for _, pkg := range r.Packages {
+		maxFanIn = max(maxFanIn, pkg.FanIn)
+	}
+	if maxFanIn > 0 {
+		var names []string
+		for _, pkg := range r.Packages {
+			if pkg.FanIn == maxFanIn {
+				names = append(names, pkg.Name)
+			}
+		}
+		comments = append(comments, fmt.Sprintf("max fan-in (%d): %s", maxFanIn, strings.Join(names, ", ")))
+	}
+	if r.Selection != nil {
+		comments = append(comments, "selection: "+strings.Join(r.Selection.Packages, ", "))
+	}
+	return comments
+}
+
+// WriteDOT writes the graph in the Graphviz DOT language. Each level is a
+// cluster, critical path edges are drawn bold and selected packages and their
+// related packages are filled.
+func (r *GraphResult) WriteDOT(w io.Writer) error {
+	var out strings.Builder
+	for _, comment := range r.graphComments() {
+		fmt.Fprintf(&out, "// %s\n", comment)
+	}
+	fmt.Fprintf(&out, "digraph %s {\n", dotQuote(r.Bundle))
+	out.WriteString("  rankdir=BT;\n  node [shape=box];\n")
+	for _, level := range r.Levels {
+		fmt.Fprintf(&out, "  subgraph %s {\n", dotQuote(fmt.Sprintf("cluster_level_%d", level.Level)))
+		fmt.Fprintf(&out, "    label=%s;\n", dotQuote(fmt.Sprintf("level %d", level.Level)))
+		for _, name := range level.Packages {
+			pkg := r.packageNamed(name)
+			attrs := []string{"tooltip=" + dotQuote(fmt.Sprintf("%s\nfan-in: %d", pkg.Source, pkg.FanIn))}
+			if color, ok := graphColors[pkg.Highlight]; ok {
+				attrs = append(attrs, "style=filled", "fillcolor="+dotQuote(color))
+			}
+			fmt.Fprintf(&out, "    %s [%s];\n", dotQuote(name), strings.Join(attrs, ", "))
+		}
+		out.WriteString("  }\n")
+	}
+	critical := r.criticalEdges()
+	for _, pkg := range r.Packages {
+		for _, dep := range pkg.DependsOn {
+			attrs := ""
+			if critical[[2]string{pkg.Name, dep}] {
+				attrs = " [penwidth=2, style=bold]"
+			}
+			fmt.Fprintf(&out, "  %s -> %s%s;\n", dotQuote(pkg.Name), dotQuote(dep), attrs)
+		}
+	}
+	out.WriteString("}\n")
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart. Each level is a
+// subgraph, critical path edges are drawn thick and selected packages and
+// their related packages are filled.
+func (r *GraphResult) WriteMermaid(w io.Writer) error {
+	var out strings.Builder
+	for _, comment := range r.graphComments() {
+		fmt.Fprintf(&out, "%%%% %s\n", comment)
+	}
+	out.WriteString("flowchart BT\n")
+	// Package names may contain characters Mermaid ids cannot, such as the
+	// keys of for_each instances, so nodes get positional ids.
+	ids := make(map[string]string, len(r.Packages))
+	for i, pkg := range r.Packages {
+		ids[pkg.Name] = fmt.Sprintf("p%d", i)
+	}
+	for _, level := range r.Levels {
+		fmt.Fprintf(&out, "  subgraph level_%d[\"level %d\"]\n", level.Level, level.Level)
+		for _, name := range level.Packages {
+			fmt.Fprintf(&out, "    %s[\"%s\"]\n", ids[name], mermaidEscape(name))
+		}
+		out.WriteString("  end\n")
+	}
+	critical := r.criticalEdges()
+	var criticalLinks []string
+	links := 0
+	for _, pkg := range r.Packages {
+		for _, dep := range pkg.DependsOn {
+			arrow := "-->"
+			if critical[[2]string{pkg.Name, dep}] {
+				arrow = "==>"
+				criticalLinks = append(criticalLinks, fmt.Sprint(links))
+			}
+			fmt.Fprintf(&out, "  %s %s %s\n", ids[pkg.Name], arrow, ids[dep])
+			links++
+		}
+	}
+	for _, highlight := range []string{GraphHighlightSelected, GraphHighlightDependency, GraphHighlightDependent} {
+		var nodes []string
+		for _, pkg := range r.Packages {
+			if pkg.Highlight == highlight {
+				nodes = append(nodes, ids[pkg.Name])
+			}
+		}
+		if len(nodes) > 0 {
+			fmt.Fprintf(&out, "  classDef %s fill:%s\n  class %s %s\n", highlight, graphColors[highlight], strings.Join(nodes, ","), highlight)
+		}
+	}
+	if len(criticalLinks) > 0 {
+		fmt.Fprintf(&out, "  linkStyle %s stroke-width:3px\n", strings.Join(criticalLinks, ","))
+	}
+	_, err := io.WriteString(w, out.String())
+	return err
+}
+
+func (r *GraphResult) packageNamed(name string) GraphPackage {
+	for _, pkg := range r.Packages {
+		if pkg.Name == name {
+			return pkg
+		}
+	}
+	return GraphPackage{Name: name}
+}
+
+// dotQuote returns s as a DOT quoted string.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
+
+// mermaidEscape escapes s for a quoted Mermaid node label.
+func mermaidEscape(s string) string {
+	return strings.NewReplacer(`"`, "#quot;").Replace(s)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphTestBundle = `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "platform"
+}
+package "init" {
+  source = "oci://ghcr.io/uds/init:1.0.0"
+}
+package "core" {
+  source     = "oci://ghcr.io/uds/core:1.0.0"
+  depends_on = [package.init]
+}
+package "db" {
+  source     = "oci://ghcr.io/uds/db:1.0.0"
+  depends_on = [package.init]
+}
+package "app" {
+  source     = "oci://ghcr.io/uds/app:1.0.0"
+  depends_on = [package.core, package.db]
+}
+package "docs" {
+  source     = "oci://ghcr.io/uds/docs:1.0.0"
+  depends_on = [package.core]
+}
+`
+
+func writeGraphTestBundle(t *testing.T) string {
+	t.Helper()
+	bundleFile := filepath.Join(t.TempDir(), bundleFileName)
+	require.NoError(t, os.WriteFile(bundleFile, []byte(graphTestBundle), tmpFilePerm))
+	return bundleFile
+}
+
+func TestGraph(t *testing.T) {
+	result, err := Graph(t.Context(), writeGraphTestBundle(t), GraphOptions{Config: newTestConfig()})
+	require.NoError(t, err)
+
+	assert.Equal(t, "platform", result.Bundle)
+	assert.Equal(t, []GraphLevel{
+		{Level: 0, Packages: []string{"init"}},
+		{Level: 1, Packages: []string{"core", "db"}},
+		{Level: 2, Packages: []string{"app", "docs"}},
+	}, result.Levels)
+	assert.Equal(t, []string{"init", "core", "app"}, result.CriticalPath)
+	require.Len(t, result.Packages, 5)
+	assert.Equal(t, GraphPackage{Name: "init", Source: "oci://ghcr.io/uds/init:1.0.0", FanIn: 2}, result.Packages[0])
+	assert.Equal(t, GraphPackage{Name: "app", Source: "oci://ghcr.io/uds/app:1.0.0", Level: 2, DependsOn: []string{"core", "db"}}, result.Packages[3])
+	assert.Nil(t, result.Selection)
+}
+
+func TestGraph_Selection(t *testing.T) {
+	result, err := Graph(t.Context(), writeGraphTestBundle(t), GraphOptions{Config: newTestConfig(), Packages: []string{"core"}})
+	require.NoError(t, err)
+
+	require.NotNil(t, result.Selection)
+	assert.Equal(t, []string{"core"}, result.Selection.Packages)
+	assert.Equal(t, []string{"init"}, result.Selection.Dependencies)
+	assert.Equal(t, []string{"app", "docs"}, result.Selection.Dependents)
+	assert.Equal(t, map[string][]string{"core": {"init"}}, result.Selection.MissingDependencies)
+	assert.Equal(t, map[string][]string{"core": {"app", "docs"}}, result.Selection.BlockingDependents)
+
+	highlights := map[string]string{}
+	for _, pkg := range result.Packages {
+		highlights[pkg.Name] = pkg.Highlight
+	}
+	assert.Equal(t, map[string]string{
+		"init": GraphHighlightDependency, "core": GraphHighlightSelected, "db": "",
+		"app": GraphHighlightDependent, "docs": GraphHighlightDependent,
+	}, highlights)
+
+	_, err = Graph(t.Context(), writeGraphTestBundle(t), GraphOptions{Config: newTestConfig(), Packages: []string{"ghost"}})
+	require.ErrorIs(t, err, ErrGraphBundle)
+	require.ErrorIs(t, err, bundleinternal.ErrUnknownPackages)
+}
+
+func TestGraph_Invalid(t *testing.T) {
+	_, err := Graph(t.Context(), writeGraphTestBundle(t), GraphOptions{})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+
+	_, err = Graph(t.Context(), " ", GraphOptions{Config: newTestConfig()})
+	require.ErrorIs(t, err, ErrSourceRequired)
+
+	_, err = Graph(t.Context(), filepath.Join(t.TempDir(), bundleFileName), GraphOptions{Config: newTestConfig()})
+	require.ErrorIs(t, err, ErrGraphBundle)
+}
+
+func TestGraphResult_Write(t *testing.T) {
+	result := &GraphResult{
+		Bundle: "demo",
+		Levels: []GraphLevel{{Level: 0, Packages: []string{"base"}}, {Level: 1, Packages: []string{`app["a"]`}}},
+		Packages: []GraphPackage{
+			{Name: "base", Source: "oci://example.com/base:1", FanIn: 1, Highlight: GraphHighlightDependency},
+			{Name: `app["a"]`, Source: "./app.tar.zst", Level: 1, DependsOn: []string{"base"}, Highlight: GraphHighlightSelected},
+		},
+		CriticalPath: []string{"base", `app["a"]`},
+		Selection:    &GraphSelection{Packages: []string{`app["a"]`}},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, result.WriteDOT(&out))
+	assert.Equal(t, `// Dependency graph of bundle "demo". Edges point from a package to the packages it depends on.
+// critical path (2): base -> app["a"]
+// max fan-in (1): base
+// selection: app["a"]
+digraph "demo" {
+  rankdir=BT;
+  node [shape=box];
+  subgraph "cluster_level_0" {
+    label="level 0";
+    "base" [tooltip="oci://example.com/base:1\nfan-in: 1", style=filled, fillcolor="#9fc5e8"];
+  }
+  subgraph "cluster_level_1" {
+    label="level 1";
+    "app[\"a\"]" [tooltip="./app.tar.zst\nfan-in: 0", style=filled, fillcolor="#ffd966"];
+  }
+  "app[\"a\"]" -> "base" [penwidth=2, style=bold];
+}
+`, out.String())
+
+	out.Reset()
+	require.NoError(t, result.WriteMermaid(&out))
+	assert.Equal(t, `%% Dependency graph of bundle "demo". Edges point from a package to the packages it depends on.
+%% critical path (2): base -> app["a"]
+%% max fan-in (1): base
+%% selection: app["a"]
+flowchart BT
+  subgraph level_0["level 0"]
+    p0["base"]
+  end
+  subgraph level_1["level 1"]
+    p1["app[#quot;a#quot;]"]
+  end
+  p1 ==> p0
+  classDef selected fill:#ffd966
+  class p1 selected
+  classDef dependency fill:#9fc5e8
+  class p0 dependency
+  linkStyle 0 stroke-width:3px
+`, out.String())
+}
@@ -14,6 +14,7 @@ import (
 	"testing"
 
 	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
 	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/mholt/archives"
@@ -22,6 +23,21 @@ import (
 	oras "oras.land/oras-go/v2"
 )
 
+// TestMain points the UDS home at a temporary directory so pulls staged under
+// it never touch the real one.
+func TestMain(m *testing.M) {
+	home, err := os.MkdirTemp("", "uds-home-")
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Setenv(logger.HomeEnv, home); err != nil {
+		panic(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(home)
+	os.Exit(code)
+}
+
 const (
 	tempDirPerm fs.FileMode = 0o700
 	tmpFilePerm fs.FileMode = 0o600
@@ -0,0 +1,128 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// includedBundles resolves bundle blocks at create time. Each included bundle
+// is pulled or extracted into a workspace that stays open until Close, so its
+// packages can be copied into the new bundle.
+type includedBundles struct {
+	config    *UDSBundleConfig
+	bundleDir string
+	streams   iostreams.IOStreams
+	hooks     pullHooks
+	dir       string
+	bundles   map[string]*artifact.ExtractedBundle
+	defaults  []bundleinternal.Variables
+}
+
+var _ bundleinternal.BundleResolver = (*includedBundles)(nil)
+
+func newIncludedBundles(config *UDSBundleConfig, bundleDir string, streams iostreams.IOStreams) *includedBundles {
+	return &includedBundles{config: config, bundleDir: bundleDir, streams: streams, bundles: map[string]*artifact.ExtractedBundle{}}
+}
+
+// ResolveBundle fetches the bundle at source, an OCI reference or a bundle
+// archive path relative to the bundle directory. OCI bundles are verified with
+// the configured signature verification policy, as by Pull.
+func (r *includedBundles) ResolveBundle(ctx context.Context, name, source string) (*bundleinternal.IncludedBundle, error) {
+	if r.dir == "" {
+		dir, err := os.MkdirTemp(r.config.Options.TmpDir, "uds-bundle-include-*")
+		if err != nil {
+			return nil, fmt.Errorf("creating workspace for included bundles: %w", err)
+		}
+		r.dir = dir
+	}
+	dst := filepath.Join(r.dir, name)
+	if err := os.MkdirAll(dst, filesystem.PrivateDirectoryMode); err != nil {
+		return nil, fmt.Errorf("creating workspace for included bundle: %w", err)
+	}
+
+	var extracted *artifact.ExtractedBundle
+	if udsoci.IsOCIReference(source) {
+		if err := validateOCIReference(source); err != nil {
+			return nil, err
+		}
+		pullOpts := PullOptions{Config: r.config, Streams: r.streams}
+		if r.config.SignatureVerification != nil {
+			pullOpts.Verification = *r.config.SignatureVerification
+		}
+		var signedBy []string
+		if _, err := udsoci.NewDefaultPuller().PullBundleLayout(ctx, source, dst, toOCIPullOptions(pullOpts, r.hooks, &signedBy)); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrPullBundle, source, err)
+		}
+		opened, err := artifact.OpenPulledBundle(ctx, r.streams, dst, nil)
+		if err != nil {
+			return nil, err
+		}
+		extracted = opened
+	} else {
+		path := source
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(r.bundleDir, path)
+		}
+		opened, err := artifact.ExtractArtifact(ctx, r.streams, path, dst)
+		if err != nil {
+			return nil, err
+		}
+		extracted = opened
+	}
+	r.bundles[name] = extracted
+
+	bundleBytes, err := os.ReadFile(extracted.BundleDefPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading included bundle definition: %w", err)
+	}
+	b, err := bundleinternal.NewHCLParser(r.config.Options.Architecture, r.streams).ParseBundleBytes(ctx, bundleBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing included bundle definition: %w", err)
+	}
+	valuesFiles, err := extracted.ValuesFilesByPackage()
+	if err != nil {
+		return nil, fmt.Errorf("collecting values files from included bundle: %w", err)
+	}
+	for i := range b.Packages {
+		b.Packages[i].ValuesFiles = valuesFiles[b.Packages[i].Name]
+	}
+
+	var defaults bundleinternal.Variables
+	defaultsPath := filepath.Join(extracted.Dir, bundleDefaultsFileName)
+	if _, err := os.Stat(defaultsPath); err == nil {
+		if defaults, err = bundleinternal.ParseDefaults(ctx, defaultsPath); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("accessing included bundle defaults: %w", err)
+	}
+	r.defaults = append(r.defaults, defaults)
+	r.streams.Info("included bundle fetched", "name", name, "bundle", b.Metadata.Name, "version", b.Metadata.Version, "packages", len(b.Packages))
+	return &bundleinternal.IncludedBundle{Bundle: b, Defaults: defaults}, nil
+}
+
+// Close removes the workspace the included bundles were fetched into.
+func (r *includedBundles) Close() error {
+	if r.dir == "" {
+		return nil
+	}
+	return os.RemoveAll(r.dir)
+}
+
+// closeIncludedBundles closes r, warning when its workspace cannot be removed.
+func closeIncludedBundles(s iostreams.IOStreams, r *includedBundles) {
+	if err := r.Close(); err != nil {
+		s.Warn("failed to remove temporary directory", "path", r.dir, "error", err)
+	}
+}
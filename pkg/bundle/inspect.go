@@ -5,7 +5,6 @@ package bundle
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -37,13 +36,16 @@ type InspectResult struct {
 	ArtifactDigest   string                  `json:"artifactDigest,omitempty" yaml:"artifactDigest,omitempty" text:"Artifact Digest,omitempty"`
 	ReconfiguredFrom string                  `json:"reconfiguredFrom,omitempty" yaml:"reconfiguredFrom,omitempty" text:"Reconfigured From,omitempty"`
 	BundleSignature  *BundleSignatureSummary `json:"bundleSignature,omitempty" yaml:"bundleSignature,omitempty" text:"Bundle Signature,omitempty"`
+	Attestations     []AttestationSummary    `json:"attestations,omitempty" yaml:"attestations,omitempty" text:"Attestations,omitempty"`
 	Packages         []PackageSummary        `json:"packages" yaml:"packages" text:"Packages"`
 }
 
 // BundleSignatureSummary reports bundle signature status.
 // Package metadata is not proof of bundle integrity.
 type BundleSignatureSummary struct {
-	Status string `json:"status" yaml:"status" text:"Status"`
+	Status     string             `json:"status" yaml:"status" text:"Status"`
+	SignedBy   []string           `json:"signedBy,omitempty" yaml:"signedBy,omitempty" text:"Signed By,omitempty"`
+	Signatures []SignatureSummary `json:"signatures,omitempty" yaml:"signatures,omitempty" text:"Signatures,omitempty"`
 }
 
 const (
@@ -55,6 +57,8 @@ const (
 	BundleSignatureStatusNotChecked = "not_checked"
 	// BundleSignatureStatusSkipped means the caller explicitly bypassed verification.
 	BundleSignatureStatusSkipped = "skipped"
+	// BundleSignatureStatusUntrusted means a signature was checked but matched no trusted signer.
+	BundleSignatureStatusUntrusted = "untrusted"
 )
 
 // PackageSummary is a serializable summary of a package within a bundle.
@@ -102,6 +106,7 @@ func Inspect(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
 	policy := opts.verificationPolicy()
 
 	status := BundleSignatureStatusNotChecked
+	var verified *VerifyResult
 	if opts.SkipSignatureVerification {
 		if err := checkSkippedSignatureEvidence(ctx, opts); err != nil {
 			return nil, fmt.Errorf("%w %q: %w", ErrInspectBundle, opts.Source, err)
@@ -109,12 +114,13 @@ func Inspect(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
 		status = BundleSignatureStatusSkipped
 		warnSkippedSignatureVerification(streams)
 	} else if policy.configured() {
-		verifiedSource, cleanup, err := stageInspectSource(ctx, opts, policy, streams)
+		staged, cleanup, err := stageInspectSource(ctx, opts, policy, streams)
 		if err != nil {
 			return nil, fmt.Errorf("%w %q: %w", ErrInspectBundle, opts.Source, err)
 		}
 		defer cleanup()
-		opts.Source = verifiedSource
+		verified = staged
+		opts.Source = verified.Source
 		status = BundleSignatureStatusVerified
 	} else {
 		streams.Warn(bundleSignatureNotCheckedWarning)
@@ -138,6 +144,28 @@ func Inspect(ctx context.Context, opts InspectOptions) (*InspectResult, error) {
 		BundleSignature:  &BundleSignatureSummary{Status: status},
 		Packages:         make([]PackageSummary, len(internalResult.Packages)),
 	}
+	if verified != nil {
+		result.BundleSignature.SignedBy = verified.SignedBy
+		result.BundleSignature.Signatures = verified.Signatures
+	} else {
+		result.BundleSignature.Signatures = signatureSummaries(internalResult.Signatures, status)
+	}
+	for _, attestation := range internalResult.Attestations {
+		summary := AttestationSummary{
+			MediaType:     attestation.MediaType,
+			Format:        attestation.Format,
+			SubjectDigest: attestation.SubjectDigest,
+			Signature:     AttestationSignatureStatusUnsigned,
+		}
+		if attestation.Signed {
+			// Verify checks every signed attestation against the bundle policy.
+			summary.Signature = AttestationSignatureStatusSigned
+			if status == BundleSignatureStatusVerified {
+				summary.Signature = AttestationSignatureStatusVerified
+			}
+		}
+		result.Attestations = append(result.Attestations, summary)
+	}
 	for i, pkg := range internalResult.Packages {
 		summary, ok := internalResult.PackageSignatures[pkg.Name]
 		if !ok {
@@ -196,40 +224,18 @@ func (o InspectOptions) verificationPolicy() VerificationPolicy {
 }
 
 func checkSkippedSignatureEvidence(ctx context.Context, opts InspectOptions) error {
-	if !udsoci.IsOCIReference(opts.Source) {
-		signatureEntries, err := artifact.CountTarZstEntries(ctx, opts.Source, bundleSignatureFileName)
-		if err != nil {
-			return fmt.Errorf("checking bundle signature evidence: %w", err)
-		}
-		if signatureEntries > 1 {
-			return fmt.Errorf("expected exactly one bundle signature evidence entry, found %d", signatureEntries)
-		}
+	if udsoci.IsOCIReference(opts.Source) {
 		return nil
 	}
-
-	repo, err := udsoci.NewRemoteRepository(ctx, udsoci.TrimScheme(opts.Source), toInternalConfigOptions(*opts.Config.Options))
-	if err != nil {
-		return fmt.Errorf("checking bundle signature evidence: %w", err)
-	}
-	reference, err := udsoci.ReferenceIdentifier(opts.Source)
-	if err != nil {
-		return err
-	}
-	child, _, err := udsoci.ResolveBundleChild(ctx, repo, reference, opts.Config.Options.Architecture)
-	if err != nil {
-		return fmt.Errorf("checking bundle signature evidence: %w", err)
-	}
-	_, err = udsoci.FetchBundleSignature(ctx, repo, child)
-	if errors.Is(err, udsoci.ErrBundleSignatureDuplicate) {
-		return fmt.Errorf("reading bundle signature evidence: %w", err)
-	}
-	return nil
+	return checkSignatureEntries(ctx, opts.Source)
 }
 
-func stageInspectSource(ctx context.Context, opts InspectOptions, policy VerificationPolicy, streams iostreams.IOStreams) (string, func(), error) {
+// stageInspectSource verifies a private copy of the inspected bundle so the
+// metadata read afterwards comes from the verified bytes.
+func stageInspectSource(ctx context.Context, opts InspectOptions, policy VerificationPolicy, streams iostreams.IOStreams) (*VerifyResult, func(), error) {
 	workspace, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-inspect-verified-*")
 	if err != nil {
-		return "", func() {}, fmt.Errorf("creating verification workspace: %w", err)
+		return nil, func() {}, fmt.Errorf("creating verification workspace: %w", err)
 	}
 	cleanup := func() { _ = os.RemoveAll(workspace) }
 
@@ -241,59 +247,61 @@ func stageInspectSource(ctx context.Context, opts InspectOptions, policy Verific
 		})
 		if err != nil {
 			cleanup()
-			return "", func() {}, fmt.Errorf("verifying bundle: %w", err)
+			return nil, func() {}, fmt.Errorf("verifying bundle: %w", err)
 		}
-		if err := Verify(ctx, VerifyOptions{
+		verified, err := VerifyWithResult(ctx, VerifyOptions{
 			Source:  pulled.OutputPath,
 			Policy:  policy,
 			Config:  opts.Config,
 			TmpDir:  opts.Config.Options.TmpDir,
 			Streams: streams,
-		}); err != nil {
+		})
+		if err != nil {
 			cleanup()
-			return "", func() {}, fmt.Errorf("verifying bundle: %w", err)
+			return nil, func() {}, fmt.Errorf("verifying bundle: %w", err)
 		}
-		return pulled.OutputPath, cleanup, nil
+		return verified, cleanup, nil
 	}
 
 	verifiedPath := filepath.Join(workspace, "bundle.tar.zst")
 	input, err := os.Open(opts.Source)
 	if err != nil {
 		cleanup()
-		return "", func() {}, fmt.Errorf("staging bundle for verification: %w", err)
+		return nil, func() {}, fmt.Errorf("staging bundle for verification: %w", err)
 	}
 	output, err := os.OpenFile(verifiedPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
 	if err != nil {
 		_ = input.Close()
 		cleanup()
-		return "", func() {}, fmt.Errorf("staging bundle for verification: %w", err)
+		return nil, func() {}, fmt.Errorf("staging bundle for verification: %w", err)
 	}
 	_, copyErr := io.Copy(output, input)
 	closeOutputErr := output.Close()
 	closeInputErr := input.Close()
 	if copyErr != nil {
 		cleanup()
-		return "", func() {}, fmt.Errorf("staging bundle for verification: %w", copyErr)
+		return nil, func() {}, fmt.Errorf("staging bundle for verification: %w", copyErr)
 	}
 	if closeOutputErr != nil {
 		cleanup()
-		return "", func() {}, fmt.Errorf("staging bundle for verification: %w", closeOutputErr)
+		return nil, func() {}, fmt.Errorf("staging bundle for verification: %w", closeOutputErr)
 	}
 	if closeInputErr != nil {
 		cleanup()
-		return "", func() {}, fmt.Errorf("staging bundle for verification: %w", closeInputErr)
+		return nil, func() {}, fmt.Errorf("staging bundle for verification: %w", closeInputErr)
 	}
-	if err := Verify(ctx, VerifyOptions{
+	verified, err := VerifyWithResult(ctx, VerifyOptions{
 		Source:  verifiedPath,
 		Policy:  policy,
 		Config:  opts.Config,
 		TmpDir:  opts.Config.Options.TmpDir,
 		Streams: streams,
-	}); err != nil {
+	})
+	if err != nil {
 		cleanup()
-		return "", func() {}, fmt.Errorf("verifying bundle: %w", err)
+		return nil, func() {}, fmt.Errorf("verifying bundle: %w", err)
 	}
-	return verifiedPath, cleanup, nil
+	return verified, cleanup, nil
 }
 
 func packageSigningStatusString(status artifact.PackageSigningStatus) string {
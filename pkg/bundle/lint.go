@@ -0,0 +1,225 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/defenseunicorns/uds-cli/internal/lint"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/version"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// LintOptions holds configuration for linting a bundle definition.
+type LintOptions struct {
+	Config *UDSBundleConfig
+	// ConfigFile is the config.uds.hcl whose variables count as defined. Empty
+	// uses the config.uds.hcl next to the bundle, if any.
+	ConfigFile string
+	// Enable, when set, runs only these rules.
+	Enable []string
+	// Disable skips these rules.
+	Disable []string
+	Streams iostreams.IOStreams
+}
+
+// LintResult represents the output of a bundle lint.
+type LintResult struct {
+	Bundle   string        `json:"bundle" yaml:"bundle" text:"Bundle"`
+	Rules    []string      `json:"rules" yaml:"rules" text:"-"`
+	Findings []LintFinding `json:"findings" yaml:"findings" text:"Findings"`
+	Errors   int           `json:"errors" yaml:"errors" text:"Errors"`
+	Warnings int           `json:"warnings" yaml:"warnings" text:"Warnings"`
+}
+
+// LintFinding is one problem reported by a lint rule.
+type LintFinding struct {
+	Rule      string `json:"rule" yaml:"rule" text:"Rule"`
+	Severity  string `json:"severity" yaml:"severity" text:"Severity"`
+	Message   string `json:"message" yaml:"message" text:"Message"`
+	Location  string `json:"-" yaml:"-" text:"Location"`
+	File      string `json:"file" yaml:"file" text:"-"`
+	Line      int    `json:"line" yaml:"line" text:"-"`
+	Column    int    `json:"column" yaml:"column" text:"-"`
+	EndLine   int    `json:"endLine" yaml:"endLine" text:"-"`
+	EndColumn int    `json:"endColumn" yaml:"endColumn" text:"-"`
+}
+
+// LintRule describes a lint rule that can be enabled or disabled by ID.
+type LintRule struct {
+	ID          string
+	Description string
+	Severity    string
+}
+
+// LintRules returns every lint rule, ordered by ID.
+func LintRules() []LintRule {
+	rules := lint.Rules()
+	result := make([]LintRule, len(rules))
+	for i, rule := range rules {
+		result[i] = LintRule{ID: rule.ID, Description: rule.Description, Severity: string(rule.Severity)}
+	}
+	return result
+}
+
+// Lint checks the bundle definition at bundleFile, together with the
+// defaults.uds.hcl and bundle.lock.hcl next to it, for problems that create
+// does not reject: unreachable packages, redundant dependencies, undefined or
+// unused variables, missing namespaces, unpinned tags and unknown optional
+// components. Findings do not make Lint fail; callers decide from
+// LintResult.Errors.
+func Lint(ctx context.Context, bundleFile string, opts LintOptions) (*LintResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleFile == "" {
+		return nil, fmt.Errorf("bundle file is required: %w", ErrBundleFileRequired)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	in, err := lint.Load(ctx, lint.LoadOptions{BundleFile: bundleFile, ConfigFile: opts.ConfigFile, Streams: s})
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrLintBundle, bundleFile, err)
+	}
+	report, err := lint.Run(ctx, in, lint.Options{Enable: opts.Enable, Disable: opts.Disable})
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrLintBundle, bundleFile, err)
+	}
+
+	result := &LintResult{Bundle: bundleFile, Rules: report.Rules, Findings: []LintFinding{}}
+	for _, f := range report.Findings {
+		file := f.Range.Filename
+		if rel, err := filepath.Rel(filepath.Dir(bundleFile), file); err == nil && filepath.IsLocal(rel) {
+			file = filepath.ToSlash(rel)
+		}
+		result.Findings = append(result.Findings, LintFinding{
+			Rule:      f.Rule,
+			Severity:  string(f.Severity),
+			Message:   f.Message,
+			Location:  fmt.Sprintf("%s:%d:%d", file, f.Range.Start.Line, f.Range.Start.Column),
+			File:      file,
+			Line:      f.Range.Start.Line,
+			Column:    f.Range.Start.Column,
+			EndLine:   f.Range.End.Line,
+			EndColumn: f.Range.End.Column,
+		})
+		switch f.Severity {
+		case lint.SeverityError:
+			result.Errors++
+		case lint.SeverityWarning:
+			result.Warnings++
+		}
+	}
+	s.Debug("bundle linted", "bundle", bundleFile, "errors", result.Errors, "warnings", result.Warnings)
+	return result, nil
+}
+
+// sarifLog is the subset of SARIF 2.1.0 that lint results use.
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string            `json:"id"`
+	ShortDescription     sarifMessage      `json:"shortDescription"`
+	DefaultConfiguration sarifRuleDefaults `json:"defaultConfiguration"`
+}
+
+type sarifRuleDefaults struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+// WriteSARIF writes the result as a SARIF 2.1.0 log for code scanning tools.
+// File URIs are relative to the bundle directory.
+func (r *LintResult) WriteSARIF(w io.Writer) error {
+	driver := sarifDriver{
+		Name:           "uds",
+		Version:        version.Version,
+		InformationURI: "https://github.com/defenseunicorns/uds-cli",
+		Rules:          []sarifRule{},
+	}
+	ruleIndex := map[string]int{}
+	for _, rule := range LintRules() {
+		ruleIndex[rule.ID] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifRuleDefaults{Level: rule.Severity},
+		})
+	}
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}
+	for _, f := range r.Findings {
+		run.Results = append(run.Results, sarifResult{
+			RuleID:    f.Rule,
+			RuleIndex: ruleIndex[f.Rule],
+			Level:     f.Severity,
+			Message:   sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: f.File},
+				Region:           sarifRegion{StartLine: f.Line, StartColumn: f.Column, EndLine: f.EndLine, EndColumn: f.EndColumn},
+			}}},
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/defenseunicorns/uds-cli/internal/lint"
+)
+
+func TestLint_WritesSARIF(t *testing.T) {
+	dir := t.TempDir()
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "lint"
+  version = "1.0.0"
+}
+package "core" {
+  source     = "oci://ghcr.io/uds/core:1.0.0"
+  depends_on = [package.missing]
+}
+`), 0o600))
+
+	result, err := Lint(t.Context(), bundleFile, LintOptions{Config: newTestConfig(), Disable: []string{"unpinned-tag"}})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Errors)
+	assert.Equal(t, 1, result.Warnings)
+	require.Len(t, result.Findings, 2)
+	assert.Equal(t, LintFinding{
+		Rule: "missing-namespace", Severity: "warning", Message: `package "core" does not set a namespace`,
+		Location: "bundle.uds.hcl:8:1", File: "bundle.uds.hcl", Line: 8, Column: 1, EndLine: 8, EndColumn: 15,
+	}, result.Findings[0])
+	assert.Equal(t, "unreachable-package", result.Findings[1].Rule)
+	assert.Equal(t, "bundle.uds.hcl:10:17", result.Findings[1].Location)
+
+	var out bytes.Buffer
+	require.NoError(t, result.WriteSARIF(&out))
+	var log sarifLog
+	require.NoError(t, json.Unmarshal(out.Bytes(), &log))
+	assert.Equal(t, "2.1.0", log.Version)
+	require.Len(t, log.Runs, 1)
+	assert.Len(t, log.Runs[0].Tool.Driver.Rules, len(LintRules()))
+	require.Len(t, log.Runs[0].Results, 2)
+	got := log.Runs[0].Results[1]
+	assert.Equal(t, "unreachable-package", got.RuleID)
+	assert.Equal(t, "unreachable-package", log.Runs[0].Tool.Driver.Rules[got.RuleIndex].ID)
+	assert.Equal(t, "error", got.Level)
+	assert.Equal(t, "bundle.uds.hcl", got.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	assert.Equal(t, 10, got.Locations[0].PhysicalLocation.Region.StartLine)
+
+	_, err = Lint(t.Context(), bundleFile, LintOptions{Config: newTestConfig(), Enable: []string{"bogus"}})
+	require.ErrorIs(t, err, ErrLintBundle)
+	require.ErrorIs(t, err, lint.ErrUnknownRule)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/zarf"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// UpdateOptions holds configuration for updating a bundle lock file.
+type UpdateOptions struct {
+	Config *UDSBundleConfig
+	// Packages limits the update to the named packages. Empty updates every
+	// OCI package in the bundle.
+	Packages []string
+	Streams  iostreams.IOStreams
+}
+
+// UpdateResult represents the output of a bundle update operation.
+type UpdateResult struct {
+	LockFile string          `json:"lockFile" yaml:"lockFile" text:"Lock File"`
+	Packages []LockedPackage `json:"packages" yaml:"packages" text:"Packages"`
+}
+
+// LockedPackage is a package source pinned in bundle.lock.hcl.
+type LockedPackage struct {
+	Name    string `json:"name" yaml:"name" text:"Name"`
+	Source  string `json:"source" yaml:"source" text:"Source"`
+	Version string `json:"version,omitempty" yaml:"version,omitempty" text:"Version,omitempty"`
+	Digest  string `json:"digest" yaml:"digest" text:"Digest"`
+	// Previous is the digest the package was locked to before the update.
+	Previous string `json:"previous,omitempty" yaml:"previous,omitempty" text:"Previous,omitempty"`
+}
+
+// Update re-resolves OCI package sources in the bundle definition at
+// bundleFile against their registries and rewrites bundle.lock.hcl next to
+// it. Version constraints such as oci://ghcr.io/uds/core:~0.40 resolve to the
+// highest matching tag. Later creates build from the locked digests until
+// the next update.
+func Update(ctx context.Context, bundleFile string, opts UpdateOptions) (*UpdateResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleFile == "" {
+		return nil, fmt.Errorf("bundle file is required: %w", ErrBundleFileRequired)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	included := newIncludedBundles(opts.Config, filepath.Dir(bundleFile), s)
+	defer closeIncludedBundles(s, included)
+	b, _, err := parseAndMaterializeBundleFile(ctx, opts.Config.Options.Architecture, s, bundleFile, included)
+	if err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrUpdateBundle, bundleFile, err)
+	}
+	if err := bundleinternal.ValidatePackageNames(opts.Packages, b.Packages); err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrUpdateBundle, bundleFile, err)
+	}
+	lockPath := bundleinternal.LockPath(filepath.Dir(bundleFile))
+	current, err := bundleinternal.ReadLock(lockPath)
+	if err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrUpdateBundle, bundleFile, err)
+	}
+	refresh := func(name string) bool { return len(opts.Packages) == 0 || slices.Contains(opts.Packages, name) }
+	lock, err := lockPackages(ctx, s, b, current, refresh, newLockHooks(opts.Config, filepath.Dir(bundleFile), s))
+	if err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrUpdateBundle, bundleFile, err)
+	}
+	if err := bundleinternal.WriteLock(lockPath, lock); err != nil {
+		return nil, fmt.Errorf("%w from %q: %w", ErrUpdateBundle, bundleFile, err)
+	}
+
+	result := &UpdateResult{LockFile: lockPath, Packages: []LockedPackage{}}
+	for _, pkg := range lock.Packages {
+		if !refresh(pkg.Name) {
+			continue
+		}
+		updated := LockedPackage{Name: pkg.Name, Source: pkg.Source, Version: pkg.Version, Digest: pkg.Digest}
+		if previous, ok := current.Package(pkg.Name); ok && previous.Digest != pkg.Digest {
+			updated.Previous = previous.Digest
+		}
+		result.Packages = append(result.Packages, updated)
+	}
+	return result, nil
+}
+
+// lockBundle resolves the bundle's OCI packages for create, keeping every
+// lock entry whose source is unchanged. It reports whether bundle.lock.hcl
+// must be rewritten; create writes it with writeBundleLock only once the
+// artifact has been built.
+func lockBundle(ctx context.Context, s iostreams.IOStreams, b *spec.UDSBundle, bundleDir string, config *UDSBundleConfig) (*bundleinternal.Lock, bool, error) {
+	lockPath := bundleinternal.LockPath(bundleDir)
+	current, err := bundleinternal.ReadLock(lockPath)
+	if err != nil {
+		return nil, false, err
+	}
+	lock, err := lockPackages(ctx, s, b, current, func(string) bool { return false }, newLockHooks(config, bundleDir, s))
+	if err != nil {
+		return nil, false, err
+	}
+	if slices.Equal(lock.Packages, current.Packages) {
+		return lock, false, nil
+	}
+	if len(lock.Packages) == 0 {
+		if _, err := os.Stat(lockPath); os.IsNotExist(err) {
+			return lock, false, nil
+		}
+	}
+	return lock, true, nil
+}
+
+// writeBundleLock writes lock to bundle.lock.hcl in bundleDir.
+func writeBundleLock(s iostreams.IOStreams, bundleDir string, lock *bundleinternal.Lock) error {
+	lockPath := bundleinternal.LockPath(bundleDir)
+	s.Info("writing bundle lock file", "path", lockPath, "packages", len(lock.Packages))
+	return bundleinternal.WriteLock(lockPath, lock)
+}
+
+type lockHooks struct {
+	resolve func(ctx context.Context, source string) (zarf.ResolvedVersion, error)
+}
+
+func newLockHooks(config *UDSBundleConfig, bundleDir string, s iostreams.IOStreams) lockHooks {
+	opts := bundleinternal.ConfigOptions{
+		LogLevel:      config.Options.LogLevel,
+		Architecture:  config.Options.Architecture,
+		PlainHTTP:     config.Options.PlainHTTP,
+		SkipTLSVerify: config.Options.SkipTLSVerify,
+		TmpDir:        config.Options.TmpDir,
+	}
+	return lockHooks{resolve: func(ctx context.Context, source string) (zarf.ResolvedVersion, error) {
+		return zarf.ResolvePackageVersion(ctx, source, opts, s)
+	}}
+}
+
+// lockPackages returns the lock for every OCI package in b. Entries in
+// current are kept while their source matches the bundle definition and
+// refresh does not select the package; every other OCI package is resolved
+// against its registry. Local packages are not locked.
+func lockPackages(ctx context.Context, s iostreams.IOStreams, b *spec.UDSBundle, current *bundleinternal.Lock, refresh func(name string) bool, hooks lockHooks) (*bundleinternal.Lock, error) {
+	lock := &bundleinternal.Lock{}
+	for _, pkg := range b.Packages {
+		// Included packages are pinned by the bundle they are included from.
+		if !zarf.IsRemoteSource(pkg.Source) || pkg.Bundle != "" {
+			continue
+		}
+		if locked, ok := current.Package(pkg.Name); ok && locked.Source == pkg.Source && !refresh(pkg.Name) {
+			lock.Packages = append(lock.Packages, locked)
+			continue
+		}
+		s.Info("resolving package version", "name", pkg.Name, "source", pkg.Source)
+		version, err := hooks.resolve(ctx, pkg.Source)
+		if err != nil {
+			return nil, fmt.Errorf("package %q: %w", pkg.Name, err)
+		}
+		lock.Packages = append(lock.Packages, bundleinternal.LockedPackage{
+			Name:    pkg.Name,
+			Source:  pkg.Source,
+			Version: version.Tag,
+			Digest:  version.Digest,
+		})
+	}
+	return lock, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
+	"github.com/defenseunicorns/uds-cli/internal/zarf"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	oras "oras.land/oras-go/v2"
+)
+
+func TestLockPackages_HonoursLock(t *testing.T) {
+	t.Parallel()
+	b := &spec.UDSBundle{Packages: []spec.Package{
+		{Name: "core", Source: "oci://ghcr.io/uds/core:~0.40"},
+		{Name: "app", Source: "oci://ghcr.io/uds/app:~2"},
+		{Name: "local", Source: "./zarf-package-local.tar.zst"},
+	}}
+	current := &bundleinternal.Lock{Packages: []bundleinternal.LockedPackage{
+		{Name: "core", Source: "oci://ghcr.io/uds/core:~0.40", Version: "0.40.1", Digest: "sha256:core-locked"},
+		{Name: "app", Source: "oci://ghcr.io/uds/app:~1", Version: "1.9.0", Digest: "sha256:app-locked"},
+		{Name: "removed", Source: "oci://ghcr.io/uds/removed:1.0.0", Version: "1.0.0", Digest: "sha256:removed"},
+	}}
+	var resolved []string
+	hooks := lockHooks{resolve: func(_ context.Context, source string) (zarf.ResolvedVersion, error) {
+		resolved = append(resolved, source)
+		return zarf.ResolvedVersion{Tag: "latest-match", Digest: "sha256:resolved"}, nil
+	}}
+
+	lock, err := lockPackages(t.Context(), iostreams.IOStreams{}, b, current, func(string) bool { return false }, hooks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oci://ghcr.io/uds/app:~2"}, resolved, "only the package whose source changed is resolved")
+	assert.Equal(t, []bundleinternal.LockedPackage{
+		current.Packages[0],
+		{Name: "app", Source: "oci://ghcr.io/uds/app:~2", Version: "latest-match", Digest: "sha256:resolved"},
+	}, lock.Packages)
+
+	resolved = nil
+	lock, err = lockPackages(t.Context(), iostreams.IOStreams{}, b, lock, func(name string) bool { return name == "core" }, hooks)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"oci://ghcr.io/uds/core:~0.40"}, resolved)
+	assert.Equal(t, "sha256:resolved", lock.Packages[0].Digest)
+}
+
+func TestUpdate_ResolvesVersionConstraints(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	config.Options.TmpDir = t.TempDir()
+	repository := strings.TrimPrefix(server.URL, "http://") + "/uds/core"
+	repo, err := udsoci.NewRemoteRepository(t.Context(), repository, bundleinternal.ConfigOptions{PlainHTTP: true})
+	require.NoError(t, err)
+	tag := func(version string) string {
+		desc, err := oras.PackManifest(t.Context(), repo, oras.PackManifestVersion1_1, "application/vnd.test.core-"+strings.ReplaceAll(version, ".", "-"), oras.PackManifestOptions{})
+		require.NoError(t, err)
+		require.NoError(t, repo.Tag(t.Context(), desc, version))
+		return desc.Digest.String()
+	}
+	first := tag("0.40.1")
+
+	dir := t.TempDir()
+	bundleFile := filepath.Join(dir, "bundle.uds.hcl")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "locked"
+  version = "1.0.0"
+}
+package "core" {
+  source = "oci://`+repository+`:~0.40"
+}
+`), 0o600))
+
+	result, err := Update(t.Context(), bundleFile, UpdateOptions{Config: config})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, bundleinternal.BundleLockFileName), result.LockFile)
+	require.Len(t, result.Packages, 1)
+	assert.Equal(t, LockedPackage{Name: "core", Source: "oci://" + repository + ":~0.40", Version: "0.40.1", Digest: first}, result.Packages[0])
+
+	second := tag("0.40.2")
+	tag("0.41.0")
+	lock, err := bundleinternal.ReadLock(result.LockFile)
+	require.NoError(t, err)
+	honoured, changed, err := lockBundle(t.Context(), iostreams.IOStreams{}, mustParseBundle(t, bundleFile), dir, config)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, lock, honoured, "create keeps the locked digest after a newer tag is published")
+
+	result, err = Update(t.Context(), bundleFile, UpdateOptions{Config: config, Packages: []string{"core"}})
+	require.NoError(t, err)
+	assert.Equal(t, LockedPackage{Name: "core", Source: "oci://" + repository + ":~0.40", Version: "0.40.2", Digest: second, Previous: first}, result.Packages[0])
+
+	_, err = Update(t.Context(), bundleFile, UpdateOptions{Config: config, Packages: []string{"missing"}})
+	require.ErrorIs(t, err, ErrUpdateBundle)
+	require.ErrorIs(t, err, bundleinternal.ErrUnknownPackages)
+
+	// Create resolves core before it fails on the missing local package, and
+	// must leave no lock behind.
+	require.NoError(t, os.Remove(result.LockFile))
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "locked"
+  version = "1.0.0"
+}
+package "missing" {
+  source = "missing"
+  signature_verification { verify = false }
+}
+package "core" {
+  source = "oci://`+repository+`:~0.40"
+  signature_verification { verify = false }
+}
+`), 0o600))
+	_, err = Create(t.Context(), bundleFile, CreateOptions{Config: config, Signing: SigningOptions{Mode: SigningModeUnsigned}})
+	require.ErrorIs(t, err, ErrCreateBundle)
+	assert.NoFileExists(t, result.LockFile)
+}
+
+func mustParseBundle(t *testing.T, bundleFile string) *spec.UDSBundle {
+	t.Helper()
+	b, _, err := parseAndMaterializeBundleFile(t.Context(), "amd64", iostreams.IOStreams{}, bundleFile, nil)
+	require.NoError(t, err)
+	return b
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/lsp"
+	"github.com/defenseunicorns/uds-cli/internal/version"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// LSPOptions holds configuration for the bundle language server.
+type LSPOptions struct {
+	Config *UDSBundleConfig
+	// Streams carries the protocol on In and Out; logs go to ErrOut.
+	Streams iostreams.IOStreams
+}
+
+// ServeLSP runs a Language Server Protocol server for bundle.uds.hcl,
+// defaults.uds.hcl and config.uds.hcl, and their .json forms, until the
+// client exits. Open documents get diagnostics from parsing, locals
+// evaluation and bundle validation at their HCL ranges, along with completion
+// of block and attribute names and package references, go-to-definition for
+// depends_on targets and locals, and hover documentation.
+func ServeLSP(ctx context.Context, opts LSPOptions) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	s.Debug("starting bundle language server", "version", version.Version)
+
+	server := lsp.NewServer(lsp.Options{Architecture: opts.Config.Options.Architecture, Version: version.Version, Streams: s})
+	if err := server.Serve(ctx, s.In(), s.Out()); err != nil {
+		return fmt.Errorf("%w: %w", ErrLanguageServer, err)
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/internal/lsp"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServeLSP(t *testing.T) {
+	frame := func(body string) string {
+		return "Content-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body
+	}
+	in := strings.NewReader(frame(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`) +
+		frame(`{"jsonrpc":"2.0","id":2,"method":"shutdown"}`) +
+		frame(`{"jsonrpc":"2.0","method":"exit"}`))
+	var out bytes.Buffer
+	require.NoError(t, ServeLSP(t.Context(), LSPOptions{Config: newTestConfig(), Streams: iostreams.New(in, &out, nil)}))
+	assert.Contains(t, out.String(), `"serverInfo":{"name":"uds"`)
+	assert.Contains(t, out.String(), `{"jsonrpc":"2.0","id":2,"result":null}`)
+
+	err := ServeLSP(t.Context(), LSPOptions{Config: newTestConfig(), Streams: iostreams.New(strings.NewReader(frame(`{"jsonrpc":"2.0","method":"exit"}`)), &out, nil)})
+	require.ErrorIs(t, err, ErrLanguageServer)
+	require.ErrorIs(t, err, lsp.ErrExitWithoutShutdown)
+
+	err = ServeLSP(t.Context(), LSPOptions{})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/defenseunicorns/uds-cli/internal/filesystem"
+	"github.com/defenseunicorns/uds-cli/internal/logger"
+	"github.com/defenseunicorns/uds-cli/internal/migrate"
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+)
+
+// MigrateOptions holds configuration for converting a legacy bundle.
+type MigrateOptions struct {
+	Config *UDSBundleConfig
+	// ConfigFile is the legacy uds-config.yaml whose options and variables
+	// become config.uds.hcl. Empty uses the uds-config.yaml next to the
+	// legacy bundle, if any.
+	ConfigFile string
+	// OutputDir receives the generated files. Empty uses the directory of
+	// the legacy bundle.
+	OutputDir string
+	// Overwrite replaces generated files that already exist.
+	Overwrite bool
+	Streams   iostreams.IOStreams
+}
+
+// MigrateResult represents the output of a legacy bundle migration.
+type MigrateResult struct {
+	Bundle      string              `json:"bundle" yaml:"bundle" text:"Bundle"`
+	Config      string              `json:"config,omitempty" yaml:"config,omitempty" text:"Config"`
+	Files       []string            `json:"files" yaml:"files" text:"Files"`
+	Diagnostics []MigrateDiagnostic `json:"diagnostics" yaml:"diagnostics" text:"Diagnostics"`
+	TODOs       int                 `json:"todos" yaml:"todos" text:"TODOs"`
+}
+
+// MigrateDiagnostic is a setting the migration changed or could not convert.
+// Severity todo needs a manual change before the bundle behaves like the
+// legacy one; note records a change worth reviewing.
+type MigrateDiagnostic struct {
+	Severity string `json:"severity" yaml:"severity" text:"Severity"`
+	Package  string `json:"package,omitempty" yaml:"package,omitempty" text:"Package"`
+	Message  string `json:"message" yaml:"message" text:"Message"`
+}
+
+// Migrate converts the legacy uds-bundle.yaml at bundleFile into
+// bundle.uds.hcl, defaults.uds.hcl, config.uds.hcl and templated values files
+// in opts.OutputDir. Repository and ref become source, publicKey and
+// keylessVerification become signature_verification, and chart overrides
+// become a values file per package whose variables are declared in
+// defaults.uds.hcl. Settings that cannot be converted, such as imports and
+// exports, are reported as todo diagnostics and as comments in the generated
+// package block. No file is written when any of them exists, unless
+// opts.Overwrite is set.
+func Migrate(ctx context.Context, bundleFile string, opts MigrateOptions) (*MigrateResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleFile == "" {
+		return nil, fmt.Errorf("bundle file is required: %w", ErrBundleFileRequired)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+
+	configFile := opts.ConfigFile
+	if configFile == "" {
+		adjacent := filepath.Join(filepath.Dir(bundleFile), migrate.LegacyConfigFileName)
+		if _, err := os.Stat(adjacent); err == nil {
+			configFile = adjacent
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("%w %q: %w", ErrMigrateBundle, bundleFile, err)
+		}
+	}
+	outputDir := opts.OutputDir
+	if outputDir == "" {
+		outputDir = filepath.Dir(bundleFile)
+	}
+
+	converted, err := migrate.Convert(ctx, migrate.Options{BundleFile: bundleFile, ConfigFile: configFile, OutputDir: outputDir})
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrMigrateBundle, bundleFile, err)
+	}
+	if !opts.Overwrite {
+		for _, file := range converted.Files {
+			path := filepath.Join(outputDir, filepath.FromSlash(file.Path))
+			if _, err := os.Stat(path); err == nil {
+				return nil, fmt.Errorf("%q (use overwrite to replace it): %w", path, ErrMigrateOutputExists)
+			}
+		}
+	}
+
+	result := &MigrateResult{Bundle: bundleFile, Config: configFile, Files: []string{}, Diagnostics: []MigrateDiagnostic{}}
+	for _, file := range converted.Files {
+		path := filepath.Join(outputDir, filepath.FromSlash(file.Path))
+		if err := os.MkdirAll(filepath.Dir(path), filesystem.PrivateDirectoryMode); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrMigrateBundle, bundleFile, err)
+		}
+		if err := os.WriteFile(path, file.Data, filesystem.PrivateFileMode); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrMigrateBundle, bundleFile, err)
+		}
+		result.Files = append(result.Files, path)
+	}
+	for _, d := range converted.Diagnostics {
+		result.Diagnostics = append(result.Diagnostics, MigrateDiagnostic{Severity: string(d.Severity), Package: d.Package, Message: d.Message})
+		if d.Severity == migrate.SeverityTodo {
+			result.TODOs++
+		}
+	}
+	s.Debug("legacy bundle migrated", "bundle", bundleFile, "files", len(result.Files), "todos", result.TODOs)
+	return result, nil
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	bundleFile := filepath.Join(dir, "uds-bundle.yaml")
+	require.NoError(t, os.WriteFile(bundleFile, []byte(`kind: UDSBundle
+metadata:
+  name: legacy
+  version: 0.1.0
+packages:
+  - name: podinfo
+    repository: ghcr.io/uds/podinfo
+    ref: 6.4.0
+    exports:
+      - name: DOMAIN
+    overrides:
+      podinfo:
+        podinfo:
+          variables:
+            - name: REPLICAS
+              path: replicaCount
+              default: 1
+`), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "uds-config.yaml"), []byte(`variables:
+  podinfo:
+    replicas: 2
+`), tmpFilePerm))
+
+	result, err := Migrate(t.Context(), bundleFile, MigrateOptions{Config: newTestConfig()})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "uds-config.yaml"), result.Config)
+	assert.Equal(t, []string{
+		filepath.Join(dir, bundleFileName),
+		filepath.Join(dir, bundleDefaultsFileName),
+		filepath.Join(dir, "config.uds.hcl"),
+		filepath.Join(dir, "values", "podinfo.yaml"),
+	}, result.Files)
+	assert.Equal(t, 3, result.TODOs)
+	assert.Contains(t, result.Diagnostics, MigrateDiagnostic{
+		Severity: "todo", Package: "podinfo",
+		Message: "exports DOMAIN, but packages cannot pass variables to each other; set domain in config.uds.hcl for the packages that imported it",
+	})
+	config, err := os.ReadFile(filepath.Join(dir, "config.uds.hcl"))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "replicas = 2")
+
+	_, err = Migrate(t.Context(), bundleFile, MigrateOptions{Config: newTestConfig()})
+	require.ErrorIs(t, err, ErrMigrateOutputExists)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleFileName), nil, tmpFilePerm))
+	_, err = Migrate(t.Context(), bundleFile, MigrateOptions{Config: newTestConfig(), Overwrite: true})
+	require.NoError(t, err)
+	written, err := os.ReadFile(filepath.Join(dir, bundleFileName))
+	require.NoError(t, err)
+	assert.Contains(t, string(written), `source       = "oci://ghcr.io/uds/podinfo:6.4.0"`)
+
+	out := filepath.Join(dir, "next")
+	result, err = Migrate(t.Context(), bundleFile, MigrateOptions{Config: newTestConfig(), OutputDir: out, ConfigFile: filepath.Join(dir, "uds-config.yaml")})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(out, bundleFileName), result.Files[0])
+}
+
+func TestMigrate_Errors(t *testing.T) {
+	_, err := Migrate(t.Context(), "", MigrateOptions{Config: newTestConfig()})
+	require.ErrorIs(t, err, ErrBundleFileRequired)
+
+	_, err = Migrate(t.Context(), filepath.Join(t.TempDir(), "uds-bundle.yaml"), MigrateOptions{Config: newTestConfig()})
+	require.ErrorIs(t, err, ErrMigrateBundle)
+
+	_, err = Migrate(t.Context(), "uds-bundle.yaml", MigrateOptions{})
+	require.ErrorIs(t, err, ErrInvalidConfig)
+}
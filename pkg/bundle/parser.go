@@ -20,8 +20,15 @@ func parseBundleFile(ctx context.Context, arch string, streams iostreams.IOStrea
 	return bundleinternal.NewHCLParser(arch, streams).ParseBundleFile(ctx, filePath)
 }
 
-func parseAndMaterializeBundleFile(ctx context.Context, arch string, streams iostreams.IOStreams, path string) (*spec.UDSBundle, []byte, error) {
-	return bundleinternal.NewHCLParser(arch, streams).ParseAndMaterializeBundleFile(ctx, path)
+// parseAndMaterializeBundleFile parses a source bundle and its artifact
+// representation. Bundle blocks are expanded with resolver, which may be nil
+// for bundles without them.
+func parseAndMaterializeBundleFile(ctx context.Context, arch string, streams iostreams.IOStreams, path string, resolver bundleinternal.BundleResolver) (*spec.UDSBundle, []byte, error) {
+	parser := bundleinternal.NewHCLParser(arch, streams)
+	if resolver != nil {
+		parser = parser.WithBundleResolver(resolver)
+	}
+	return parser.ParseAndMaterializeBundleFile(ctx, path)
 }
 
 // toInternalConfig converts public configuration to the internal HCL representation.
@@ -33,12 +40,13 @@ func toInternalConfig(cfg *UDSBundleConfig) *bundleinternal.UDSBundleConfig {
 	var options *bundleinternal.ConfigOptions
 	if cfg.Options != nil {
 		options = &bundleinternal.ConfigOptions{
-			LogLevel:      cfg.Options.LogLevel,
-			Architecture:  cfg.Options.Architecture,
-			PlainHTTP:     cfg.Options.PlainHTTP,
-			SkipTLSVerify: cfg.Options.SkipTLSVerify,
-			TmpDir:        cfg.Options.TmpDir,
-			Concurrency:   cfg.Options.Concurrency,
+			LogLevel:         cfg.Options.LogLevel,
+			Architecture:     cfg.Options.Architecture,
+			PlainHTTP:        cfg.Options.PlainHTTP,
+			SkipTLSVerify:    cfg.Options.SkipTLSVerify,
+			TmpDir:           cfg.Options.TmpDir,
+			Concurrency:      cfg.Options.Concurrency,
+			ChunkConcurrency: cfg.Options.ChunkConcurrency,
 		}
 	}
 	return &bundleinternal.UDSBundleConfig{
@@ -52,23 +60,40 @@ func toInternalVerificationPolicy(policy *VerificationPolicy) *bundleinternal.Si
 	if policy == nil {
 		return nil
 	}
-	result := &bundleinternal.SignatureVerification{PublicKey: policy.PublicKey}
-	if policy.Keyless != nil {
-		result.Keyless = &bundleinternal.KeylessVerification{
-			CertificateIdentity: policy.Keyless.CertificateIdentity, CertificateIdentityRegexp: policy.Keyless.CertificateIdentityRegexp,
-			CertificateOIDCIssuer: policy.Keyless.CertificateOIDCIssuer, CertificateOIDCIssuerRegexp: policy.Keyless.CertificateOIDCIssuerRegexp,
-			TrustedRoot: policy.Keyless.TrustedRoot,
-		}
+	result := &bundleinternal.SignatureVerification{
+		PublicKey: policy.PublicKey, Keyless: toInternalKeyless(policy.Keyless),
+		Rule: string(policy.Rule), Threshold: policy.Threshold,
+	}
+	for _, signer := range policy.Signers {
+		result.Signers = append(result.Signers, bundleinternal.TrustedSigner{Name: signer.Name, PublicKey: signer.PublicKey, Keyless: toInternalKeyless(signer.Keyless)})
+	}
+	for _, scope := range policy.Scopes {
+		result.Scopes = append(result.Scopes, bundleinternal.VerificationScope{
+			BundleName: scope.BundleName, Signers: append([]string(nil), scope.Signers...),
+			Rule: string(scope.Rule), Threshold: scope.Threshold,
+		})
 	}
 	return result
 }
 
+func toInternalKeyless(keyless *KeylessVerification) *bundleinternal.KeylessVerification {
+	if keyless == nil {
+		return nil
+	}
+	return &bundleinternal.KeylessVerification{
+		CertificateIdentity: keyless.CertificateIdentity, CertificateIdentityRegexp: keyless.CertificateIdentityRegexp,
+		CertificateOIDCIssuer: keyless.CertificateOIDCIssuer, CertificateOIDCIssuerRegexp: keyless.CertificateOIDCIssuerRegexp,
+		TrustedRoot: keyless.TrustedRoot,
+	}
+}
+
 // toInternalConfigOptions converts public configuration options to internal options.
 func toInternalConfigOptions(opts ConfigOptions) bundleinternal.ConfigOptions {
 	return bundleinternal.ConfigOptions{
 		LogLevel: opts.LogLevel, Architecture: opts.Architecture,
 		PlainHTTP: opts.PlainHTTP, SkipTLSVerify: opts.SkipTLSVerify,
 		TmpDir: opts.TmpDir, Concurrency: opts.Concurrency,
+		ChunkConcurrency: opts.ChunkConcurrency,
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	udsoci "github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	oras "oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 )
 
 // PullOptions holds configuration for pulling a bundle from an OCI registry.
@@ -24,8 +25,9 @@ type PullOptions struct {
 
 // PullResult represents the output of a bundle pull operation.
 type PullResult struct {
-	OCIReference string `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
-	OutputPath   string `json:"outputPath" yaml:"outputPath" text:"Output Path"`
+	OCIReference string   `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
+	OutputPath   string   `json:"outputPath" yaml:"outputPath" text:"Output Path"`
+	SignedBy     []string `json:"signedBy,omitempty" yaml:"signedBy,omitempty" text:"Signed By,omitempty"`
 }
 
 // Pull pulls a bundle artifact from an OCI registry into targetDir.
@@ -69,15 +71,17 @@ func pullBundle(ctx context.Context, ref, targetDir string, opts PullOptions, ho
 	if err := opts.Validate(); err != nil {
 		return nil, err
 	}
-	result, err := udsoci.NewDefaultPuller().PullBundle(ctx, ref, targetDir, toOCIPullOptions(opts, hooks))
+	var signedBy []string
+	result, err := udsoci.NewDefaultPuller().PullBundle(ctx, ref, targetDir, toOCIPullOptions(opts, hooks, &signedBy))
 	if result == nil {
 		return nil, err
 	}
-	return &PullResult{OCIReference: result.OCIReference, OutputPath: result.OutputPath}, err
+	return &PullResult{OCIReference: result.OCIReference, OutputPath: result.OutputPath, SignedBy: signedBy}, err
 }
 
-// toOCIPullOptions converts public pull options and hooks to internal equivalents.
-func toOCIPullOptions(opts PullOptions, hooks pullHooks) udsoci.PullOptions {
+// toOCIPullOptions converts public pull options and hooks to internal
+// equivalents. Signers that satisfy the verification policy are stored in signedBy.
+func toOCIPullOptions(opts PullOptions, hooks pullHooks, signedBy *[]string) udsoci.PullOptions {
 	internal := udsoci.PullOptions{
 		Config:                    toInternalConfig(opts.Config),
 		Streams:                   opts.Streams,
@@ -86,8 +90,17 @@ func toOCIPullOptions(opts PullOptions, hooks pullHooks) udsoci.PullOptions {
 	internal.PullHooks.CreateBundleArchive = artifact.CreateBundleArchive
 	internal.PullHooks.ModifyOrasSettings = hooks.modifyOrasSettings
 	if !opts.SkipSignatureVerification && opts.Verification.configured() {
-		internal.PullHooks.VerifyBundle = func(ctx context.Context, index, evidence []byte) error {
-			return verifySignature(ctx, index, evidence, opts.Verification, opts.Config.Options.TmpDir)
+		internal.PullHooks.VerifyBundle = func(ctx context.Context, src content.Fetcher, index []byte, signatures [][]byte) error {
+			bundleName, err := policyBundleName(ctx, opts.Streams, opts.Verification, index, src)
+			if err != nil {
+				return err
+			}
+			verified, err := verifySignature(ctx, index, signatures, bundleName, opts.Verification, opts.Config.Options.TmpDir)
+			if err != nil {
+				return err
+			}
+			*signedBy = verified.signedBy
+			return nil
 		}
 	}
 	if hooks.toOrasTarget != nil {
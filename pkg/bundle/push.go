@@ -27,12 +27,8 @@ func Push(ctx context.Context, bundleTarball, ref string, opts PushOptions) (*Pu
 		return nil, fmt.Errorf("%w to %q: %w", ErrPushBundle, ref, err)
 	}
 	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
-	signatureEntries, err := artifact.CountTarZstEntries(ctx, bundleTarball, bundleSignatureFileName)
-	if err != nil {
-		return nil, fmt.Errorf("%w: checking bundle signature evidence: %w", ErrPushBundle, err)
-	}
-	if signatureEntries > 1 {
-		return nil, fmt.Errorf("%w: expected exactly one bundle signature evidence entry, found %d", ErrPushBundle, signatureEntries)
+	if err := checkSignatureEntries(ctx, bundleTarball); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrPushBundle, err)
 	}
 	tmp, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-push-*")
 	if err != nil {
@@ -54,6 +50,37 @@ func Push(ctx context.Context, bundleTarball, ref string, opts PushOptions) (*Pu
 	return result, nil
 }
 
+// PushPackages publishes each Zarf package in a local bundle tarball as a
+// standalone package at <prefix>/<package-name>:<version>, with the package
+// flavor appended to the tag when it has one. Packages keep their signatures
+// and can be deployed with Zarf directly.
+func PushPackages(ctx context.Context, bundleTarball, prefix string, opts PushOptions) (*PushPackagesResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleTarball == "" {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	if prefix == "" {
+		return nil, fmt.Errorf("%w: registry prefix is required: %w", ErrPushBundle, ErrInvalidOCIReference)
+	}
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	tmp, err := os.MkdirTemp(opts.Config.Options.TmpDir, "uds-bundle-push-*")
+	if err != nil {
+		return nil, fmt.Errorf("%w: creating temp dir: %w", ErrPushBundle, err)
+	}
+	defer func() { _ = os.RemoveAll(tmp) }()
+	s.Info("extracting bundle archive", "source", bundleTarball)
+	if err := artifact.ExtractTarZst(ctx, s, bundleTarball, tmp); err != nil {
+		return nil, fmt.Errorf("%w: extracting bundle: %w", ErrPushBundle, err)
+	}
+	result, err := pushBundlePackages(ctx, tmp, prefix, opts, pushHooks{})
+	if err != nil {
+		return nil, fmt.Errorf("%w packages to %q: %w", ErrPushBundle, prefix, err)
+	}
+	return result, nil
+}
+
 // PushOptions holds configuration for pushing a bundle to an OCI registry.
 type PushOptions struct {
 	Config  *UDSBundleConfig
@@ -65,6 +92,19 @@ type PushResult struct {
 	OCIReference string `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
 }
 
+// PushPackagesResult represents the output of publishing bundle packages.
+type PushPackagesResult struct {
+	Packages []PackagePushResult `json:"packages" yaml:"packages" text:"Packages"`
+}
+
+// PackagePushResult describes one bundle package published as a standalone
+// Zarf package.
+type PackagePushResult struct {
+	Package      string `json:"package" yaml:"package" text:"Package"`
+	OCIReference string `json:"ociReference" yaml:"ociReference" text:"OCI Reference"`
+	Digest       string `json:"digest" yaml:"digest" text:"Digest"`
+}
+
 type pushHooks struct {
 	toOrasTarget       func(ctx context.Context, ociReference string, opts *PushOptions) (oras.Target, error)
 	modifyOrasSettings func(ctx context.Context, copyOptions *oras.CopyOptions) error
@@ -81,6 +121,18 @@ func pushBundle(ctx context.Context, bundleDir, ref string, opts PushOptions, ho
 	return &PushResult{OCIReference: result.OCIReference}, err
 }
 
+func pushBundlePackages(ctx context.Context, bundleDir, prefix string, opts PushOptions, hooks pushHooks) (*PushPackagesResult, error) {
+	pushed, err := udsoci.NewDefaultPusher().PushBundlePackages(ctx, bundleDir, prefix, toOCIPushOptions(opts, hooks))
+	if err != nil {
+		return nil, err
+	}
+	result := &PushPackagesResult{Packages: make([]PackagePushResult, 0, len(pushed))}
+	for _, pkg := range pushed {
+		result.Packages = append(result.Packages, PackagePushResult(pkg))
+	}
+	return result, nil
+}
+
 // toOCIPushOptions converts public push options and hooks to internal equivalents.
 func toOCIPushOptions(opts PushOptions, hooks pushHooks) udsoci.PushOptions {
 	internal := udsoci.PushOptions{Config: toInternalConfig(opts.Config), Streams: opts.Streams}
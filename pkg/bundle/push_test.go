@@ -0,0 +1,53 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+	"github.com/stretchr/testify/require"
+	"oras.land/oras-go/v2/registry/remote"
+)
+
+func TestPushPackages_PublishesStandalonePackages(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+	host := strings.TrimPrefix(server.URL, "http://")
+
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "split"
+  version = "1.0.0"
+}
+package "first" {
+  source = "localpkg"
+}
+package "second" {
+  source = "localpkg"
+}
+`, "")
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+
+	result, err := PushPackages(t.Context(), source, "oci://"+host+"/packages/", PushOptions{Config: config})
+	require.NoError(t, err)
+	require.Len(t, result.Packages, 2)
+	want := "oci://" + host + "/packages/test:0.0.1"
+	for _, pkg := range result.Packages {
+		require.Equal(t, want, pkg.OCIReference)
+	}
+	require.Equal(t, []string{"first", "second"}, []string{result.Packages[0].Package, result.Packages[1].Package})
+
+	repo, err := remote.NewRepository(host + "/packages/test")
+	require.NoError(t, err)
+	repo.PlainHTTP = true
+	desc, err := repo.Resolve(t.Context(), "0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, result.Packages[0].Digest, desc.Digest.String())
+}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/defenseunicorns/uds-cli/internal/artifact"
 	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
@@ -48,8 +49,9 @@ type ReconfigureOptions struct {
 
 // ReconfigureResult represents the output of a bundle reconfigure operation.
 type ReconfigureResult struct {
-	OutputPath   string `json:"outputPath,omitempty" yaml:"outputPath,omitempty" text:"Output Path,omitempty"`
-	OCIReference string `json:"ociReference,omitempty" yaml:"ociReference,omitempty" text:"OCI Reference,omitempty"`
+	OutputPath     string   `json:"outputPath,omitempty" yaml:"outputPath,omitempty" text:"Output Path,omitempty"`
+	OCIReference   string   `json:"ociReference,omitempty" yaml:"ociReference,omitempty" text:"OCI Reference,omitempty"`
+	SourceSignedBy []string `json:"sourceSignedBy,omitempty" yaml:"sourceSignedBy,omitempty" text:"Source Signed By,omitempty"`
 }
 
 // Reconfigure validates the defaults file and dispatches to the appropriate
@@ -76,6 +78,7 @@ func Reconfigure(ctx context.Context, source, defaultsFile string, opts Reconfig
 		return nil, fmt.Errorf("%w: reading defaults file: %w", ErrReconfigureBundle, err)
 	}
 	state := &reconfigureState{}
+	var sourceSignedBy []string
 	if !opts.SkipSignatureVerification {
 		policy := opts.Verification
 		if !policy.configured() && opts.Config.SignatureVerification != nil {
@@ -95,15 +98,17 @@ func Reconfigure(ctx context.Context, source, defaultsFile string, opts Reconfig
 			}
 			defer cleanup()
 		}
-		if err := Verify(ctx, VerifyOptions{
+		verified, err := VerifyWithResult(ctx, VerifyOptions{
 			Source:  state.inputSource,
 			Policy:  policy,
 			Config:  opts.Config,
 			TmpDir:  opts.Config.Options.TmpDir,
 			Streams: opts.Streams,
-		}); err != nil {
+		})
+		if err != nil {
 			return nil, fmt.Errorf("%w: verifying input bundle: %w", ErrReconfigureBundle, err)
 		}
+		sourceSignedBy = verified.SignedBy
 	} else {
 		warnSkippedSignatureVerification(opts.Streams)
 	}
@@ -117,6 +122,7 @@ func Reconfigure(ctx context.Context, source, defaultsFile string, opts Reconfig
 		if opts.Signing.Mode == "" || opts.Signing.Mode == SigningModeUnsigned {
 			s.Warn("reconfigured bundle is unsigned; its integrity and origin are not established")
 		}
+		result.SourceSignedBy = sourceSignedBy
 		return result, nil
 	}
 	inputSource := source
@@ -127,6 +133,7 @@ func Reconfigure(ctx context.Context, source, defaultsFile string, opts Reconfig
 	if err != nil {
 		return result, fmt.Errorf("%w %q with defaults %q: %w", ErrReconfigureBundle, source, defaultsFile, err)
 	}
+	result.SourceSignedBy = sourceSignedBy
 	if opts.Signing.Mode == "" || opts.Signing.Mode == SigningModeUnsigned {
 		s.Warn("reconfigured bundle is unsigned; its integrity and origin are not established")
 		return result, nil
@@ -231,9 +238,15 @@ func reconfigureLocalArtifact(ctx context.Context, streams iostreams.IOStreams,
 	if err := artifact.ExtractTarZst(ctx, streams, source, tmp); err != nil {
 		return nil, fmt.Errorf("extracting bundle: %w", err)
 	}
-	if err := os.Remove(filepath.Join(tmp, bundleSignatureFileName)); err != nil && !errors.Is(err, os.ErrNotExist) {
+	if err := udsoci.WriteBundleSignatures(tmp, nil); err != nil {
 		return nil, fmt.Errorf("removing inherited bundle signature evidence: %w", err)
 	}
+	// Inherited attestations describe the source bundle index, not the reconfigured one.
+	for _, name := range udsoci.BundleAttestationFileNames() {
+		if err := os.Remove(filepath.Join(tmp, name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("removing inherited bundle attestation %s: %w", name, err)
+		}
+	}
 
 	ociDir := filepath.Join(tmp, "oci")
 	// Parse index.json and find bundle definition manifest.
@@ -605,8 +618,13 @@ func rebuildDefinitionManifest(original ocispec.Manifest, newDefaultsDesc, newHC
 	// Copy existing annotations and add/overwrite reconfigure-specific ones.
 	annotations := make(map[string]string)
 	maps.Copy(annotations, original.Annotations)
-	// Pin to epoch for reproducible manifest digests, matching the create path.
-	annotations[ocispec.AnnotationCreated] = "1970-01-01T00:00:00Z"
+	// Pin to SOURCE_DATE_EPOCH or the Unix epoch for reproducible manifest
+	// digests, matching the create path.
+	created, err := bundleinternal.ArchiveTime()
+	if err != nil {
+		return nil, err
+	}
+	annotations[ocispec.AnnotationCreated] = created.Format(time.RFC3339)
 	annotations[udsoci.AnnotationReconfiguredFrom] = sourceArtifactDigest
 
 	manifest := ocispec.Manifest{
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
 	"github.com/defenseunicorns/uds-cli/internal/logger"
 	internalzarf "github.com/defenseunicorns/uds-cli/internal/zarf"
 	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
@@ -103,6 +104,48 @@ func Remove(ctx context.Context, source *DeploySource, opts RemoveOptions) (*Rem
 	return result, nil
 }
 
+// RemoveDeployed removes a deployed bundle by name using only cluster state.
+// The package set and dependency order are rebuilt from the annotations
+// recorded on each Zarf package when the bundle was deployed, so neither the
+// bundle definition nor the package sources need to be reachable. When
+// opts.Packages is non-empty, only the specified packages are removed.
+func RemoveDeployed(ctx context.Context, bundleName string, opts RemoveOptions) (*RemoveResult, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if bundleName == "" {
+		return nil, fmt.Errorf("deployed bundle name must not be empty: %w", ErrBundleNameRequired)
+	}
+
+	s := logger.Bind(opts.Streams, opts.Config.Options.LogLevel)
+	remover := newZarfRemover(s)
+	if !opts.Force {
+		b, err := remover.remover.DeployedBundle(ctx, bundleName)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrRemoveBundle, bundleName, err)
+		}
+		s.Debug("deployed bundle found", "name", b.Metadata.Name, "version", b.Metadata.Version, "packages", len(b.Packages))
+		if err := bundleinternal.ValidatePackageNames(opts.Packages, b.Packages); err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrRemoveBundle, bundleName, err)
+		}
+		if err := validateRemovalSafety(ctx, s, b, opts.Packages); err != nil {
+			return nil, fmt.Errorf("%w: unable to remove safely: %w", ErrRemoveBundle, err)
+		}
+	}
+
+	result, err := remover.removeDeployedBundle(ctx, bundleName, opts.Packages, removePackageOptions{
+		Config:        opts.Config,
+		Force:         opts.Force,
+		SafetyChecked: !opts.Force,
+	})
+	if err != nil {
+		return result, fmt.Errorf("%w %q: %w", ErrRemoveBundle, bundleName, err)
+	}
+	removed, skipped := countRemovalResults(result.Packages)
+	s.Info("bundle removal complete", "name", result.BundleName, "removed", removed, "skipped", skipped)
+	return result, nil
+}
+
 func countRemovalResults(packages []RemovePackageResult) (removed, skipped int) {
 	for _, pkg := range packages {
 		switch pkg.Status {
@@ -137,9 +180,35 @@ func (r *zarfRemover) removeBundle(ctx context.Context, b *spec.UDSBundle, packa
 	if result == nil {
 		return nil, err
 	}
+	return fromZarfRemoveResult(result), err
+}
+
+func (r *zarfRemover) removeDeployedBundle(ctx context.Context, bundleName string, packages []string, opts removePackageOptions) (*RemoveResult, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	result, err := r.remover.RemoveDeployedBundle(ctx, bundleName, packages, internalzarf.RemovePackageOptions{Config: toZarfConfig(opts.Config), Force: opts.Force})
+	if result == nil {
+		return nil, err
+	}
+	return fromZarfRemoveResult(result), err
+}
+
+func (r *zarfRemover) pruneDeployedBundle(ctx context.Context, b *spec.UDSBundle, opts removePackageOptions) (*RemoveResult, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+	result, err := r.remover.PruneDeployedBundle(ctx, b, internalzarf.RemovePackageOptions{Config: toZarfConfig(opts.Config), Force: opts.Force})
+	if result == nil {
+		return nil, err
+	}
+	return fromZarfRemoveResult(result), err
+}
+
+func fromZarfRemoveResult(result *internalzarf.RemoveResult) *RemoveResult {
 	packageResults := make([]RemovePackageResult, len(result.Packages))
 	for i, pkg := range result.Packages {
 		packageResults[i] = RemovePackageResult{Name: pkg.Name, Status: RemovePackageStatus(pkg.Status)}
 	}
-	return &RemoveResult{BundleName: result.BundleName, Packages: packageResults}, err
+	return &RemoveResult{BundleName: result.BundleName, Packages: packageResults}
 }
@@ -101,10 +101,32 @@ func Sign(ctx context.Context, opts SignOptions) error {
 		return fmt.Errorf("%w %q: verifying bundle content before signing in OCI layout %q: %w", ErrSignBundle, opts.Source, layoutPath, err)
 	}
 
-	evidencePath := filepath.Join(workspace, bundleSignatureFileName)
+	signatures, err := oci.ReadBundleSignatures(workspace)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrSignBundle, opts.Source, err)
+	}
+	if opts.Signing.Overwrite {
+		signatures = nil
+	}
+	evidenceDir, err := os.MkdirTemp(opts.TmpDir, "uds-bundle-signature-*")
+	if err != nil {
+		return fmt.Errorf("%w %q: creating signature workspace under %q: %w", ErrSignBundle, opts.Source, opts.TmpDir, err)
+	}
+	defer func() { _ = os.RemoveAll(evidenceDir) }()
+	evidencePath := filepath.Join(evidenceDir, bundleSignatureFileName)
 	if err := signBundleIndex(ctx, indexPath, evidencePath, opts.Signing); err != nil {
 		return fmt.Errorf("%w %q using %s mode: %w", ErrSignBundle, opts.Source, opts.Signing.Mode, err)
 	}
+	evidence, err := os.ReadFile(evidencePath)
+	if err != nil {
+		return fmt.Errorf("%w %q: reading signature evidence %q: %w", ErrSignBundle, opts.Source, evidencePath, err)
+	}
+	if err := oci.WriteBundleSignatures(workspace, append(signatures, evidence)); err != nil {
+		return fmt.Errorf("%w %q: %w", ErrSignBundle, opts.Source, err)
+	}
+	if err := signBundleAttestations(ctx, workspace, opts.Signing); err != nil {
+		return fmt.Errorf("%w %q using %s mode: %w", ErrSignBundle, opts.Source, opts.Signing.Mode, err)
+	}
 	if err := artifact.WriteTarZst(ctx, opts.Streams, opts.Source, workspace); err != nil {
 		return fmt.Errorf("%w %q: writing signed bundle from %q: %w", ErrSignBundle, opts.Source, workspace, err)
 	}
@@ -148,6 +170,66 @@ func signOCI(ctx context.Context, opts SignOptions) error {
 	if err := oci.PublishBundleSignature(ctx, repo, child, evidence, opts.Signing.Overwrite); err != nil {
 		return fmt.Errorf("publishing signature for %q child %s (overwrite=%t): %w", opts.Source, child.Digest, opts.Signing.Overwrite, err)
 	}
+
+	attestations, err := oci.FetchBundleAttestations(ctx, repo, child)
+	if err != nil {
+		return fmt.Errorf("fetching attestations for %q child %s: %w", opts.Source, child.Digest, err)
+	}
+	for _, attestation := range attestations {
+		if len(attestation.Signature) != 0 && !opts.Signing.Overwrite {
+			continue
+		}
+		fileName, _ := oci.BundleAttestationFileName(attestation.MediaType)
+		attestationPath := filepath.Join(workspace, fileName)
+		if err := os.WriteFile(attestationPath, attestation.Data, 0o600); err != nil {
+			return fmt.Errorf("writing %s attestation for child %s to %q for signing: %w", attestation.MediaType, child.Digest, attestationPath, err)
+		}
+		attestationEvidencePath := filepath.Join(workspace, oci.BundleAttestationSignatureFileName(fileName))
+		if err := signAttestationFile(ctx, attestationPath, attestationEvidencePath, opts.Signing); err != nil {
+			return err
+		}
+		attestation.Signature, err = os.ReadFile(attestationEvidencePath)
+		if err != nil {
+			return fmt.Errorf("reading attestation signature evidence %q for child %s: %w", attestationEvidencePath, child.Digest, err)
+		}
+		if err := oci.PublishBundleAttestation(ctx, repo, child, attestation); err != nil {
+			return fmt.Errorf("publishing %s attestation for %q child %s: %w", attestation.MediaType, opts.Source, child.Digest, err)
+		}
+	}
+	return nil
+}
+
+// signBundleAttestations signs each attestation present at the root of an
+// extracted bundle workspace, writing evidence beside it. Attestations that
+// are already signed keep their evidence unless options.Overwrite is set.
+func signBundleAttestations(ctx context.Context, workspace string, options SigningOptions) error {
+	for _, fileName := range []string{oci.BundleProvenanceFileName, oci.BundleSBOMFileName} {
+		attestationPath := filepath.Join(workspace, fileName)
+		if _, err := os.Stat(attestationPath); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return fmt.Errorf("accessing bundle attestation %q: %w", attestationPath, err)
+		}
+		evidencePath := filepath.Join(workspace, oci.BundleAttestationSignatureFileName(fileName))
+		if _, err := os.Stat(evidencePath); err == nil && !options.Overwrite {
+			continue
+		} else if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("accessing bundle attestation evidence %q: %w", evidencePath, err)
+		}
+		if err := signAttestationFile(ctx, attestationPath, evidencePath, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func signAttestationFile(ctx context.Context, attestationPath, evidencePath string, options SigningOptions) error {
+	signOpts := signingOptions(options)
+	signOpts.BundlePath = evidencePath
+	if _, err := signing.CosignSignBlobWithOptions(ctx, attestationPath, signOpts); err != nil {
+		return fmt.Errorf("signing bundle attestation %q with %s mode, evidence %q: %w", attestationPath, options.Mode, evidencePath, err)
+	}
 	return nil
 }
 
@@ -10,14 +10,18 @@ import (
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
 	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
 	"github.com/google/go-containerregistry/pkg/registry"
 	"github.com/mholt/archives"
+	godigest "github.com/opencontainers/go-digest"
 	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/cosign/v3/pkg/cosign"
 	"github.com/stretchr/testify/require"
 )
 
@@ -179,6 +183,70 @@ func TestInspectSkip_RejectsDuplicateSignatureEvidence(t *testing.T) {
 	require.ErrorContains(t, err, "expected exactly one bundle signature evidence entry, found 2")
 }
 
+func TestCreate_AttachesAttestationsThroughPushAndPull(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "attested"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	entries := readTarZstEntries(t, source)
+	require.Contains(t, entries, oci.BundleProvenanceFileName)
+	require.Contains(t, entries, oci.BundleSBOMFileName)
+
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	ref := strings.TrimPrefix(server.URL, "http://") + "/test/bundle:v1"
+	_, err := Push(t.Context(), source, ref, PushOptions{Config: config})
+	require.NoError(t, err)
+	pulled, err := Pull(t.Context(), ref, t.TempDir(), PullOptions{Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err)
+	pulledEntries := readTarZstEntries(t, pulled.OutputPath)
+	require.Equal(t, entries[oci.BundleProvenanceFileName], pulledEntries[oci.BundleProvenanceFileName])
+	require.Equal(t, entries[oci.BundleSBOMFileName], pulledEntries[oci.BundleSBOMFileName])
+
+	for _, src := range []string{source, "oci://" + ref} {
+		result, err := Inspect(t.Context(), InspectOptions{Source: src, Config: config, SkipSignatureVerification: true})
+		require.NoError(t, err)
+		require.Len(t, result.Attestations, 2, src)
+		for _, attestation := range result.Attestations {
+			require.Equal(t, result.ArtifactDigest, attestation.SubjectDigest, src)
+			require.Equal(t, AttestationSignatureStatusUnsigned, attestation.Signature, src)
+		}
+	}
+}
+
+func TestVerifyAttestation_RejectsSubjectMismatch(t *testing.T) {
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "stale-attestation"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	entries := readTarZstEntries(t, source)
+	attestation := oci.BundleAttestation{MediaType: oci.MediaTypeInTotoStatement, Data: entries[oci.BundleProvenanceFileName]}
+
+	summary, err := verifyAttestation(t.Context(), attestation, godigest.FromBytes(entries["oci/index.json"]).String(), "", VerificationPolicy{}, t.TempDir())
+	require.NoError(t, err)
+	require.Equal(t, AttestationSignatureStatusUnsigned, summary.Signature)
+
+	_, err = verifyAttestation(t.Context(), attestation, godigest.FromString("other").String(), "", VerificationPolicy{}, t.TempDir())
+	require.ErrorIs(t, err, ErrAttestationSubjectMismatch)
+}
+
 func writeDuplicateSignatureArchive(t *testing.T, dst string) {
 	t.Helper()
 
@@ -200,3 +268,283 @@ func writeDuplicateSignatureArchive(t *testing.T, dst string) {
 	require.NoError(t, err)
 	require.NoError(t, zstdWriter.Close())
 }
+
+func TestVerificationPolicy_Validate(t *testing.T) {
+	keyless := &KeylessVerification{CertificateIdentity: "release@example.com", CertificateOIDCIssuer: "https://issuer.example.com"}
+	signers := []TrustedSigner{{Name: "a", PublicKey: "key-a"}, {Name: "b", Keyless: keyless}}
+	tests := []struct {
+		name    string
+		policy  VerificationPolicy
+		wantErr string
+	}{
+		{name: "single public key", policy: VerificationPolicy{PublicKey: "key"}},
+		{name: "signers with any rule", policy: VerificationPolicy{Signers: signers, Rule: VerificationRuleAny}},
+		{name: "signers with implied threshold", policy: VerificationPolicy{Signers: signers, Threshold: 2}},
+		{name: "scoped signer", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-*", Signers: []string{"b"}}}}},
+		{name: "empty policy", wantErr: "exactly one of public key or keyless"},
+		{name: "threshold without signers", policy: VerificationPolicy{PublicKey: "key", Threshold: 1}, wantErr: "require trusted signers"},
+		{name: "signers with top-level key", policy: VerificationPolicy{PublicKey: "key", Signers: signers}, wantErr: "cannot be combined"},
+		{name: "duplicate signer", policy: VerificationPolicy{Signers: []TrustedSigner{signers[0], signers[0]}}, wantErr: `duplicate trusted signer "a"`},
+		{name: "signer without trust material", policy: VerificationPolicy{Signers: []TrustedSigner{{Name: "a"}}}, wantErr: `trusted signer "a"`},
+		{name: "threshold above signer count", policy: VerificationPolicy{Signers: signers, Threshold: 3}, wantErr: "between 1 and 2"},
+		{name: "any rule with threshold", policy: VerificationPolicy{Signers: signers, Rule: VerificationRuleAny, Threshold: 1}, wantErr: "cannot be combined"},
+		{name: "all rule", policy: VerificationPolicy{Signers: signers, Rule: VerificationRuleAll}},
+		{name: "all rule with threshold", policy: VerificationPolicy{Signers: signers, Rule: VerificationRuleAll, Threshold: 2}, wantErr: "cannot be combined"},
+		{name: "unknown rule", policy: VerificationPolicy{Signers: signers, Rule: "most"}, wantErr: `rule "most"`},
+		{name: "scope references unknown signer", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-*", Signers: []string{"c"}}}}, wantErr: `unknown trusted signer "c"`},
+		{name: "scope threshold above scope signers", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-*", Signers: []string{"b"}, Threshold: 2}}}, wantErr: "between 1 and 1"},
+		{name: "invalid scope pattern", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-["}}}, wantErr: "syntax error in pattern"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrInvalidVerificationPolicy)
+			require.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestVerify_MultiSignerPolicy(t *testing.T) {
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "core-slim"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	releaseKey, releasePublicKey := generateTestKeyPair(t)
+	_, rotatedPublicKey := generateTestKeyPair(t)
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  source,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: releaseKey},
+		TmpDir:  t.TempDir(),
+	}))
+	signers := []TrustedSigner{{Name: "rotated", PublicKey: rotatedPublicKey}, {Name: "release", PublicKey: releasePublicKey}}
+
+	tests := []struct {
+		name     string
+		policy   VerificationPolicy
+		signedBy []string
+		wantErr  string
+	}{
+		{name: "any trusted signer", policy: VerificationPolicy{Signers: signers}, signedBy: []string{"release"}},
+		{name: "threshold not met", policy: VerificationPolicy{Signers: signers, Threshold: 2}, wantErr: "1 of 2 required trusted signers matched"},
+		{name: "matching scope satisfied", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-*", Signers: []string{"release"}}}}, signedBy: []string{"release"}},
+		{name: "matching scope not satisfied", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "core-*", Signers: []string{"rotated"}}}}, wantErr: `bundle "core-slim" matches scope "core-*"`},
+		{name: "other scope ignored", policy: VerificationPolicy{Signers: signers, Scopes: []BundleVerificationScope{{BundleName: "edge-*", Signers: []string{"rotated"}}}}, signedBy: []string{"release"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := VerifyWithResult(t.Context(), VerifyOptions{Source: source, Policy: tt.policy, TmpDir: t.TempDir()})
+			if tt.wantErr != "" {
+				require.ErrorIs(t, err, ErrSignaturePolicyNotSatisfied)
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.signedBy, result.SignedBy)
+			for _, attestation := range result.Attestations {
+				require.Equal(t, AttestationSignatureStatusVerified, attestation.Signature)
+			}
+		})
+	}
+
+	inspected, err := Inspect(t.Context(), InspectOptions{Source: source, Config: newTestConfig(), Verification: VerificationPolicy{Signers: signers}})
+	require.NoError(t, err)
+	require.Equal(t, BundleSignatureStatusVerified, inspected.BundleSignature.Status)
+	require.Equal(t, []string{"release"}, inspected.BundleSignature.SignedBy)
+	require.Len(t, inspected.BundleSignature.Signatures, 1)
+	require.Equal(t, "release", inspected.BundleSignature.Signatures[0].Signer)
+}
+
+func TestSign_CountersignsLocalBundle(t *testing.T) {
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "countersigned"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	devKey, devPublicKey := generateTestKeyPair(t)
+	securityKey, securityPublicKey := generateTestKeyPair(t)
+	_, auditPublicKey := generateTestKeyPair(t)
+	for _, key := range []string{devKey, securityKey} {
+		require.NoError(t, Sign(t.Context(), SignOptions{
+			Source:  source,
+			Signing: SigningOptions{Mode: SigningModeKey, Key: key},
+			TmpDir:  t.TempDir(),
+		}))
+	}
+	entries := readTarZstEntries(t, source)
+	require.Contains(t, entries, bundleSignatureFileName)
+	require.Contains(t, entries, oci.BundleSignatureFileNameAt(1))
+
+	signers := []TrustedSigner{{Name: "dev", PublicKey: devPublicKey}, {Name: "security", PublicKey: securityPublicKey}}
+	result, err := VerifyWithResult(t.Context(), VerifyOptions{Source: source, Policy: VerificationPolicy{Signers: signers, Rule: VerificationRuleAll}, TmpDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev", "security"}, result.SignedBy)
+	require.Len(t, result.Signatures, 2)
+	for i, signer := range []string{"dev", "security"} {
+		require.Equal(t, signer, result.Signatures[i].Signer)
+		require.Equal(t, BundleSignatureStatusVerified, result.Signatures[i].Status)
+		require.True(t, strings.HasPrefix(result.Signatures[i].Identity, "public key "), result.Signatures[i].Identity)
+	}
+	for _, attestation := range result.Attestations {
+		require.Equal(t, AttestationSignatureStatusVerified, attestation.Signature)
+	}
+
+	withAudit := append(slices.Clone(signers), TrustedSigner{Name: "audit", PublicKey: auditPublicKey})
+	err = Verify(t.Context(), VerifyOptions{Source: source, Policy: VerificationPolicy{Signers: withAudit, Rule: VerificationRuleAll}, TmpDir: t.TempDir()})
+	require.ErrorIs(t, err, ErrSignaturePolicyNotSatisfied)
+	require.ErrorContains(t, err, "2 of 3 required trusted signers matched")
+
+	result, err = VerifyWithResult(t.Context(), VerifyOptions{Source: source, Policy: VerificationPolicy{Signers: withAudit}, TmpDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev", "security"}, result.SignedBy)
+
+	inspected, err := Inspect(t.Context(), InspectOptions{Source: source, Config: newTestConfig()})
+	require.NoError(t, err)
+	require.Len(t, inspected.BundleSignature.Signatures, 2)
+	for _, signature := range inspected.BundleSignature.Signatures {
+		require.Empty(t, signature.Signer)
+		require.Equal(t, BundleSignatureStatusNotChecked, signature.Status)
+	}
+
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  source,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: securityKey, Overwrite: true},
+		TmpDir:  t.TempDir(),
+	}))
+	entries = readTarZstEntries(t, source)
+	require.Contains(t, entries, bundleSignatureFileName)
+	require.NotContains(t, entries, oci.BundleSignatureFileNameAt(1))
+	result, err = VerifyWithResult(t.Context(), VerifyOptions{Source: source, Policy: VerificationPolicy{Signers: signers}, TmpDir: t.TempDir()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"security"}, result.SignedBy)
+}
+
+func TestSign_CountersignsOCIBundle(t *testing.T) {
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	source := createTestBundle(t, `uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name    = "countersigned-oci"
+  version = "1.0.0"
+}
+package "pkg1" {
+  source = "localpkg"
+}
+`, "")
+	devKey, devPublicKey := generateTestKeyPair(t)
+	securityKey, securityPublicKey := generateTestKeyPair(t)
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  source,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: devKey},
+		TmpDir:  t.TempDir(),
+	}))
+	config := newTestConfig()
+	config.Options.PlainHTTP = true
+	ref := strings.TrimPrefix(server.URL, "http://") + "/test/bundle:v1"
+	_, err := Push(t.Context(), source, ref, PushOptions{Config: config})
+	require.NoError(t, err)
+	require.NoError(t, Sign(t.Context(), SignOptions{
+		Source:  "oci://" + ref,
+		Signing: SigningOptions{Mode: SigningModeKey, Key: securityKey},
+		Config:  config,
+		TmpDir:  t.TempDir(),
+	}))
+
+	policy := VerificationPolicy{
+		Signers: []TrustedSigner{{Name: "dev", PublicKey: devPublicKey}, {Name: "security", PublicKey: securityPublicKey}},
+		Rule:    VerificationRuleAll,
+	}
+	pulled, err := Pull(t.Context(), "oci://"+ref, t.TempDir(), PullOptions{Config: config, Verification: policy})
+	require.NoError(t, err)
+	require.Equal(t, []string{"dev", "security"}, pulled.SignedBy)
+	entries := readTarZstEntries(t, pulled.OutputPath)
+	require.Contains(t, entries, bundleSignatureFileName)
+	require.Contains(t, entries, oci.BundleSignatureFileNameAt(1))
+
+	inspected, err := Inspect(t.Context(), InspectOptions{Source: "oci://" + ref, Config: config, SkipSignatureVerification: true})
+	require.NoError(t, err)
+	require.Len(t, inspected.BundleSignature.Signatures, 2)
+	require.Equal(t, BundleSignatureStatusSkipped, inspected.BundleSignature.Signatures[0].Status)
+}
+
+func generateTestKeyPair(t *testing.T) (string, string) {
+	t.Helper()
+	keys, err := cosign.GenerateKeyPair(nil)
+	require.NoError(t, err)
+	privateKey := filepath.Join(t.TempDir(), "cosign.key")
+	require.NoError(t, os.WriteFile(privateKey, keys.PrivateBytes, 0o600))
+	return privateKey, string(keys.PublicBytes)
+}
+
+func TestMatchSignatures(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifies [][]bool
+		order    []int
+		expected []int
+	}{
+		{
+			// corp accepts any identity at corp and alice only alice, so the
+			// first evidence must go to alice for both signers to match.
+			name:     "overlapping identities",
+			verifies: [][]bool{{true, true}, {true, false}},
+			order:    []int{0, 1},
+			expected: []int{1, 0},
+		},
+		{
+			name:     "one evidence per signer",
+			verifies: [][]bool{{true}, {true}},
+			order:    []int{0, 1},
+			expected: []int{0},
+		},
+		{
+			name:     "order prefers signers",
+			verifies: [][]bool{{true}, {true}},
+			order:    []int{1, 0},
+			expected: []int{1},
+		},
+		{
+			name:     "unverified evidence",
+			verifies: [][]bool{{false, true}},
+			order:    []int{0},
+			expected: []int{-1, 0},
+		},
+		{name: "no signers", expected: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, matchSignatures(tt.verifies, len(tt.expected), tt.order))
+		})
+	}
+}
+
+func TestVerificationPolicy_MatchOrder(t *testing.T) {
+	policy := VerificationPolicy{
+		Signers: []TrustedSigner{{Name: "dev"}, {Name: "security"}, {Name: "release"}},
+		Scopes: []BundleVerificationScope{
+			{BundleName: "prod-*", Signers: []string{"release"}},
+			{BundleName: "other", Signers: []string{"security"}},
+		},
+	}
+	require.Equal(t, []int{2, 0, 1}, policy.matchOrder("prod-core", policy.Signers))
+	require.Equal(t, []int{0, 1, 2}, policy.matchOrder("dev-core", policy.Signers))
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/defenseunicorns/uds-cli/internal/artifact"
+	"github.com/defenseunicorns/uds-cli/internal/oci"
+)
+
+// SignatureSummary reports one signature over a bundle and the identity that produced it.
+type SignatureSummary struct {
+	Signer   string `json:"signer,omitempty" yaml:"signer,omitempty" text:"Signer,omitempty"`
+	Identity string `json:"identity" yaml:"identity" text:"Identity"`
+	Issuer   string `json:"issuer,omitempty" yaml:"issuer,omitempty" text:"Issuer,omitempty"`
+	Status   string `json:"status" yaml:"status" text:"Status"`
+}
+
+// Fulcio certificate extensions carrying the OIDC issuer of a keyless signer.
+var (
+	fulcioIssuerV2OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+	fulcioIssuerV1OID = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+)
+
+// sigstoreVerificationMaterial is the subset of a Sigstore bundle that
+// identifies its signer.
+type sigstoreVerificationMaterial struct {
+	VerificationMaterial struct {
+		PublicKey *struct {
+			Hint string `json:"hint"`
+		} `json:"publicKey"`
+		Certificate *struct {
+			RawBytes []byte `json:"rawBytes"`
+		} `json:"certificate"`
+		X509CertificateChain *struct {
+			Certificates []struct {
+				RawBytes []byte `json:"rawBytes"`
+			} `json:"certificates"`
+		} `json:"x509CertificateChain"`
+	} `json:"verificationMaterial"`
+}
+
+// signatureSummary reports the signer identity recorded in evidence. The
+// identity is informational: it is read without verifying the signature.
+func signatureSummary(evidence []byte, status string) SignatureSummary {
+	summary := SignatureSummary{Identity: "unknown", Status: status}
+	var material sigstoreVerificationMaterial
+	if err := json.Unmarshal(evidence, &material); err != nil {
+		return summary
+	}
+	vm := material.VerificationMaterial
+	var rawCert []byte
+	switch {
+	case vm.Certificate != nil:
+		rawCert = vm.Certificate.RawBytes
+	case vm.X509CertificateChain != nil && len(vm.X509CertificateChain.Certificates) != 0:
+		rawCert = vm.X509CertificateChain.Certificates[0].RawBytes
+	case vm.PublicKey != nil:
+		summary.Identity = "public key"
+		if vm.PublicKey.Hint != "" {
+			summary.Identity = "public key " + vm.PublicKey.Hint
+		}
+		return summary
+	}
+	cert, err := x509.ParseCertificate(rawCert)
+	if err != nil {
+		return summary
+	}
+	switch {
+	case len(cert.EmailAddresses) != 0:
+		summary.Identity = cert.EmailAddresses[0]
+	case len(cert.URIs) != 0:
+		summary.Identity = cert.URIs[0].String()
+	case cert.Subject.CommonName != "":
+		summary.Identity = cert.Subject.CommonName
+	}
+	summary.Issuer = certificateIssuer(cert)
+	return summary
+}
+
+// certificateIssuer returns the OIDC issuer recorded in a Fulcio certificate.
+func certificateIssuer(cert *x509.Certificate) string {
+	for _, oid := range []asn1.ObjectIdentifier{fulcioIssuerV2OID, fulcioIssuerV1OID} {
+		i := slices.IndexFunc(cert.Extensions, func(ext pkix.Extension) bool { return ext.Id.Equal(oid) })
+		if i < 0 {
+			continue
+		}
+		value := cert.Extensions[i].Value
+		if oid.Equal(fulcioIssuerV2OID) {
+			var issuer string
+			if _, err := asn1.UnmarshalWithParams(value, &issuer, "utf8"); err == nil {
+				return issuer
+			}
+			continue
+		}
+		return string(value)
+	}
+	return ""
+}
+
+// signatureSummaries reports every signature in signatures with status.
+func signatureSummaries(signatures [][]byte, status string) []SignatureSummary {
+	result := make([]SignatureSummary, len(signatures))
+	for i, evidence := range signatures {
+		result[i] = signatureSummary(evidence, status)
+	}
+	return result
+}
+
+// checkSignatureEntries rejects a bundle archive with more than one entry for
+// any signature filename, since extraction would keep only the last.
+func checkSignatureEntries(ctx context.Context, source string) error {
+	counts, err := artifact.CountTarZstEntriesFunc(ctx, source, oci.IsBundleSignatureFileName)
+	if err != nil {
+		return fmt.Errorf("checking bundle signature evidence: %w", err)
+	}
+	for name, count := range counts {
+		if count > 1 {
+			return fmt.Errorf("expected exactly one bundle signature evidence entry, found %d for %s", count, name)
+		}
+	}
+	return nil
+}
@@ -31,6 +31,9 @@ type Package struct {
 	ValuesFiles           []string
 	OptionalComponents    []string
 	SignatureVerification *PackageSignatureVerification
+	// Bundle names the bundle block the package was included from. It is
+	// empty for packages declared in the bundle itself.
+	Bundle string
 }
 
 // PackageSignatureVerification declares how a package signature is verified
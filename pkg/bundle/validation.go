@@ -37,6 +37,9 @@ func (o DeployOptions) Validate() error {
 	if err := validateConfig(o.Config); err != nil {
 		return err
 	}
+	if o.Prune && o.PruneDryRun {
+		return ErrPruneOptionsConflict
+	}
 	return nil
 }
 
@@ -81,6 +84,79 @@ func (o PushOptions) Validate() error {
 	return validateConfig(o.Config)
 }
 
+// Validate checks that CopyOptions is valid. A verification policy is
+// optional; when one is configured it must be valid.
+func (o CopyOptions) Validate() error {
+	if err := validateConfig(o.Config); err != nil {
+		return err
+	}
+	if o.Verification.configured() {
+		return o.Verification.Validate()
+	}
+	return nil
+}
+
+// Validate checks that UpdateOptions is valid.
+func (o UpdateOptions) Validate() error {
+	return validateConfig(o.Config)
+}
+
+// Validate checks that LintOptions is valid.
+func (o LintOptions) Validate() error {
+	return validateConfig(o.Config)
+}
+
+// Validate checks that MigrateOptions is valid.
+func (o MigrateOptions) Validate() error {
+	return validateConfig(o.Config)
+}
+
+// Validate checks that GraphOptions is valid.
+func (o GraphOptions) Validate() error {
+	return validateConfig(o.Config)
+}
+
+// Validate checks that LSPOptions is valid.
+func (o LSPOptions) Validate() error {
+	return validateConfig(o.Config)
+}
+
+// Validate checks that GCOptions is valid.
+func (o GCOptions) Validate() error {
+	if err := validateConfig(o.Config); err != nil {
+		return err
+	}
+	if o.KeepLast < 0 {
+		return fmt.Errorf("keep-last must not be negative, got %d: %w", o.KeepLast, ErrInvalidConfig)
+	}
+	return nil
+}
+
+// Validate checks that ExportOptions is valid.
+func (o ExportOptions) Validate() error {
+	if err := validateConfig(o.Config); err != nil {
+		return err
+	}
+	if o.VolumeSize < 0 {
+		return fmt.Errorf("volume size must not be negative, got %d: %w", o.VolumeSize, ErrInvalidConfig)
+	}
+	return nil
+}
+
+// Validate checks that ImportOptions is valid.
+func (o ImportOptions) Validate() error {
+	if err := validateConfig(o.Config); err != nil {
+		return err
+	}
+	if o.OutputDir != "" && o.OCIReference != "" {
+		return ErrImportDestinationConflict
+	}
+	if o.OCIReference != "" {
+		return validateOCIReference(o.OCIReference)
+	}
+	return nil
+}
+
 // Validate checks that ReconfigureOptions is valid.
 func (o ReconfigureOptions) Validate() error {
 	if err := validateConfig(o.Config); err != nil {
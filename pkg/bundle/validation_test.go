@@ -26,6 +26,9 @@ func TestValidateOperationOptions(t *testing.T) {
 	}{
 		{name: "deploy requires config", validate: func() error { return (DeployOptions{}).Validate() }, wantErr: "config is required"},
 		{name: "deploy accepts config", validate: func() error { return (DeployOptions{Config: validValidationConfig()}).Validate() }},
+		{name: "deploy rejects prune with prune dry run", validate: func() error {
+			return (DeployOptions{Config: validValidationConfig(), Prune: true, PruneDryRun: true}).Validate()
+		}, wantErr: "mutually exclusive"},
 		{name: "deploy package requires config", validate: func() error { return (DeployPackageOptions{}).Validate() }, wantErr: "config is required"},
 		{name: "deploy package requires bundle directory", validate: func() error {
 			return (DeployPackageOptions{Config: validValidationConfig()}).Validate()
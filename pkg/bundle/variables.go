@@ -0,0 +1,173 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	bundleinternal "github.com/defenseunicorns/uds-cli/internal/bundle"
+	internalzarf "github.com/defenseunicorns/uds-cli/internal/zarf"
+	"github.com/defenseunicorns/uds-cli/pkg/bundle/spec"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// UnsetVariable is a variable a deploy reads that neither the bundle's
+// defaults nor the config sets.
+type UnsetVariable struct {
+	// Name is the dotted path of the variable below vars.
+	Name        string
+	Description string
+	// Type is the declared HCL type, such as string or list(string).
+	Type      string
+	Sensitive bool
+}
+
+// ParseValue converts an answer to the variable's type. String variables
+// take the answer verbatim; other types parse it as an HCL expression.
+func (v UnsetVariable) ParseValue(input string) (any, error) {
+	ty, err := bundleinternal.ParseVariableType(v.Type)
+	if err != nil {
+		return nil, err
+	}
+	value, err := bundleinternal.VariableDeclaration{Name: v.Name, Type: ty}.ParseValue(input)
+	if err != nil {
+		return nil, err
+	}
+	return fromInternalVariableValue(value), nil
+}
+
+// UnsetVariables returns the variables a deploy of source with opts would
+// read without a value: those declared by variable blocks in the bundle's
+// defaults, in declaration order, followed by those the values files of the
+// selected packages read, by name. Values file reads through lookup tolerate
+// unset variables and are skipped. Variables found only in values files are
+// strings without a description.
+func UnsetVariables(ctx context.Context, source *DeploySource, opts DeployOptions) ([]UnsetVariable, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if source == nil {
+		return nil, fmt.Errorf("source is required: %w", ErrSourceRequired)
+	}
+	config := opts.Config
+	var declarations []bundleinternal.VariableDeclaration
+	if source.DefaultsPath != "" {
+		var err error
+		if config, err = applyEmbeddedDefaults(ctx, config, source.DefaultsPath, source.Loader != nil); err != nil {
+			return nil, err
+		}
+		if declarations, err = loadVariableDeclarations(ctx, source.DefaultsPath, source.Loader != nil); err != nil {
+			return nil, err
+		}
+	}
+	defined := toInternalVariables(config.Variables)
+
+	var unset []UnsetVariable
+	seen := map[string]bool{}
+	for _, declaration := range declarations {
+		seen[declaration.Name] = true
+		if !defined.Defined(declaration.Name) {
+			unset = append(unset, newUnsetVariable(declaration))
+		}
+	}
+
+	b := source.Bundle
+	if b == nil {
+		var err error
+		if b, err = parseBundleFile(ctx, config.Options.Architecture, opts.Streams, source.BundlePath); err != nil {
+			return nil, err
+		}
+	}
+	read, err := valuesFileReads(b, filepath.Dir(source.BundlePath), opts.Packages)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range read {
+		if seen[name] || defined.Defined(name) {
+			continue
+		}
+		seen[name] = true
+		unset = append(unset, newUnsetVariable(bundleinternal.VariableDeclaration{Name: name, Type: cty.String}))
+	}
+	return unset, nil
+}
+
+func newUnsetVariable(declaration bundleinternal.VariableDeclaration) UnsetVariable {
+	return UnsetVariable{
+		Name: declaration.Name, Description: declaration.Description, Type: declaration.TypeString(),
+		Sensitive: declaration.Sensitive,
+	}
+}
+
+// loadVariableDeclarations reads the variable blocks of a defaults file.
+// Artifact defaults are already materialized and parsed without file().
+func loadVariableDeclarations(ctx context.Context, defaultsPath string, artifactSource bool) ([]bundleinternal.VariableDeclaration, error) {
+	if !artifactSource {
+		return bundleinternal.ParseVariableDeclarations(ctx, defaultsPath)
+	}
+	src, err := os.ReadFile(defaultsPath)
+	if err != nil {
+		return nil, fmt.Errorf("loading embedded defaults: %w", err)
+	}
+	return bundleinternal.ParseVariableDeclarationsBytes(ctx, src)
+}
+
+// valuesFileReads returns the sorted variable paths the values files of the
+// named packages, or of every package when names is empty, read outside of
+// lookup. Relative values file paths are resolved against bundleDir.
+func valuesFileReads(b *spec.UDSBundle, bundleDir string, names []string) ([]string, error) {
+	var read []string
+	for _, pkg := range b.Packages {
+		if len(names) > 0 && !slices.Contains(names, pkg.Name) {
+			continue
+		}
+		for _, path := range pkg.ValuesFiles {
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(bundleDir, path)
+			}
+			src, err := os.ReadFile(path)
+			if err != nil {
+				return nil, fmt.Errorf("reading values file for package %q: %w", pkg.Name, err)
+			}
+			refs, err := internalzarf.ValuesTemplateReferences(filepath.Base(path), src)
+			if err != nil {
+				return nil, fmt.Errorf("parsing values file for package %q: %w", pkg.Name, err)
+			}
+			for _, ref := range refs {
+				if !ref.Lookup {
+					read = append(read, ref.Path)
+				}
+			}
+		}
+	}
+	slices.Sort(read)
+	return slices.Compact(read), nil
+}
+
+// Set stores value at a dotted path, creating the objects along it.
+func (v Variables) Set(path string, value any) {
+	parts := strings.Split(path, ".")
+	current := v
+	for _, part := range parts[:len(parts)-1] {
+		nested, ok := current[part].(Variables)
+		if !ok {
+			nested = Variables{}
+			current[part] = nested
+		}
+		current = nested
+	}
+	current[parts[len(parts)-1]] = value
+}
+
+// WriteConfigVariables adds vars to the variables of the config.uds.hcl at
+// path, creating it when it does not exist. The expressions written for other
+// variables are kept. The file is readable only by the current user.
+func WriteConfigVariables(ctx context.Context, path string, vars Variables) error {
+	return bundleinternal.WriteConfigVariables(ctx, path, toInternalVariables(vars))
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Defense Unicorns
+// SPDX-License-Identifier: AGPL-3.0-or-later OR LicenseRef-Defense-Unicorns-Commercial
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnsetVariables(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleFileName), []byte(`uds {
+  bundle_api_version = "uds.dev/v1alpha1"
+}
+metadata {
+  name = "app"
+}
+package "app" {
+  source       = "oci://ghcr.io/uds/app:1.0.0"
+  values_files = ["app.yaml"]
+}
+package "other" {
+  source       = "oci://ghcr.io/uds/other:1.0.0"
+  values_files = ["other.yaml"]
+}
+`), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "app.yaml"), []byte("host: {{ .vars.domain }}\nreplicas: {{ .vars.replicas }}\nlevel: {{ .vars.log.level }}\nextra: {{ lookup \"extra\" }}\n"), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.yaml"), []byte("region: {{ .vars.region }}\n"), tmpFilePerm))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, bundleDefaultsFileName), []byte(`variables = {
+  domain = "uds.dev"
+}
+variable "sso.client_secret" {
+  description = "SSO client secret"
+  sensitive   = true
+}
+variable "replicas" {
+  description = "App replicas"
+  type        = number
+}
+variable "domain" {}
+`), tmpFilePerm))
+
+	config := newTestConfig()
+	config.Variables = Variables{"log": Variables{"level": "debug"}}
+	source := &DeploySource{BundlePath: filepath.Join(dir, bundleFileName), DefaultsPath: filepath.Join(dir, bundleDefaultsFileName)}
+	unset, err := UnsetVariables(t.Context(), source, DeployOptions{Config: config, Packages: []string{"app"}, Streams: iostreams.IOStreams{}})
+	require.NoError(t, err)
+	assert.Equal(t, []UnsetVariable{
+		{Name: "sso.client_secret", Description: "SSO client secret", Type: "string", Sensitive: true},
+		{Name: "replicas", Description: "App replicas", Type: "number"},
+	}, unset)
+
+	unset, err = UnsetVariables(t.Context(), source, DeployOptions{Config: config, Streams: iostreams.IOStreams{}})
+	require.NoError(t, err)
+	require.Len(t, unset, 3)
+	assert.Equal(t, UnsetVariable{Name: "region", Type: "string"}, unset[2])
+
+	value, err := unset[1].ParseValue("3")
+	require.NoError(t, err)
+	assert.InDelta(t, float64(3), value, 0.001)
+}
+
+func TestVariablesSet(t *testing.T) {
+	vars := Variables{"sso": Variables{"enabled": true}}
+	vars.Set("sso.client_secret", "secret")
+	vars.Set("domain", "uds.dev")
+	assert.Equal(t, Variables{"sso": Variables{"enabled": true, "client_secret": "secret"}, "domain": "uds.dev"}, vars)
+}
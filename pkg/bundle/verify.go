@@ -8,14 +8,19 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/defenseunicorns/uds-cli/internal/artifact"
 	"github.com/defenseunicorns/uds-cli/internal/oci"
 	"github.com/defenseunicorns/uds-cli/pkg/iostreams"
+	godigest "github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/zarf-dev/zarf/src/pkg/signing"
+	"oras.land/oras-go/v2/content"
 )
 
 // KeylessVerification constrains the certificate identity trusted for a keyless signature.
@@ -27,10 +32,47 @@ type KeylessVerification struct {
 	TrustedRoot                 string
 }
 
+// TrustedSigner is a named public key or keyless identity trusted to sign bundles.
+type TrustedSigner struct {
+	Name      string
+	PublicKey string
+	Keyless   *KeylessVerification
+}
+
+// VerificationRule selects how many trusted signers must match a bundle signature.
+type VerificationRule string
+
+const (
+	// VerificationRuleAny accepts a signature from any one trusted signer.
+	VerificationRuleAny VerificationRule = "any"
+	// VerificationRuleThreshold requires signatures from at least Threshold distinct trusted signers.
+	VerificationRuleThreshold VerificationRule = "threshold"
+	// VerificationRuleAll requires a signature from every trusted signer.
+	VerificationRuleAll VerificationRule = "all"
+)
+
+// BundleVerificationScope adds a signer requirement for bundles whose
+// metadata name matches BundleName, a path.Match pattern such as "core-*".
+// Signers names the trusted signers that count toward the scope; when empty,
+// every trusted signer counts. A bundle must satisfy every matching scope in
+// addition to the policy-wide rule.
+type BundleVerificationScope struct {
+	BundleName string
+	Signers    []string
+	Rule       VerificationRule
+	Threshold  int
+}
+
 // VerificationPolicy is consumer-controlled trust material for a bundle signature.
+// PublicKey or Keyless trusts a single signer. Signers trusts several, combined
+// by Rule and Threshold and narrowed per bundle name by Scopes.
 type VerificationPolicy struct {
 	PublicKey string
 	Keyless   *KeylessVerification
+	Signers   []TrustedSigner
+	Rule      VerificationRule
+	Threshold int
+	Scopes    []BundleVerificationScope
 }
 
 // VerifyOptions configures verification of a bundle artifact.
@@ -42,21 +84,57 @@ type VerifyOptions struct {
 	Streams iostreams.IOStreams
 }
 
+// VerifyResult reports a verified bundle and its attestations.
+type VerifyResult struct {
+	Source          string               `json:"source" yaml:"source" text:"Source"`
+	ArtifactDigest  string               `json:"artifactDigest" yaml:"artifactDigest" text:"Artifact Digest"`
+	BundleSignature string               `json:"bundleSignature" yaml:"bundleSignature" text:"Bundle Signature"`
+	SignedBy        []string             `json:"signedBy,omitempty" yaml:"signedBy,omitempty" text:"Signed By,omitempty"`
+	Signatures      []SignatureSummary   `json:"signatures,omitempty" yaml:"signatures,omitempty" text:"Signatures,omitempty"`
+	Attestations    []AttestationSummary `json:"attestations,omitempty" yaml:"attestations,omitempty" text:"Attestations,omitempty"`
+}
+
+// AttestationSummary reports a bundle provenance or SBOM attestation.
+type AttestationSummary struct {
+	MediaType     string `json:"mediaType" yaml:"mediaType" text:"Media Type"`
+	Format        string `json:"format" yaml:"format" text:"Format"`
+	SubjectDigest string `json:"subjectDigest" yaml:"subjectDigest" text:"Subject Digest"`
+	Signature     string `json:"signature" yaml:"signature" text:"Signature"`
+}
+
+const (
+	// AttestationSignatureStatusVerified means the attestation signature matched the configured policy.
+	AttestationSignatureStatusVerified = "verified"
+	// AttestationSignatureStatusUnsigned means the attestation has no signature evidence.
+	AttestationSignatureStatusUnsigned = "unsigned"
+	// AttestationSignatureStatusSigned means the attestation has signature evidence that was not checked.
+	AttestationSignatureStatusSigned = "signed"
+)
+
 // Verify verifies local bundle signature evidence and the complete OCI graph.
+// Attestations must describe the verified bundle index, and signed
+// attestations must satisfy the same policy as the bundle signature.
 func Verify(ctx context.Context, opts VerifyOptions) error {
+	_, err := VerifyWithResult(ctx, opts)
+	return err
+}
+
+// VerifyWithResult verifies a bundle as Verify does and reports the signers
+// that satisfied the policy and the bundle's attestations.
+func VerifyWithResult(ctx context.Context, opts VerifyOptions) (*VerifyResult, error) {
 	if err := opts.Validate(); err != nil {
-		return err
+		return nil, err
 	}
 	if oci.IsOCIReference(opts.Source) {
 		if err := validateOCIReference(opts.Source); err != nil {
-			return fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+			return nil, fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
 		}
 		if opts.Config == nil {
-			return fmt.Errorf("%w: config is required for OCI bundle verification", ErrVerifyBundle)
+			return nil, fmt.Errorf("%w: config is required for OCI bundle verification", ErrVerifyBundle)
 		}
 		workspace, err := os.MkdirTemp(opts.TmpDir, "uds-bundle-oci-verify-*")
 		if err != nil {
-			return fmt.Errorf("%w: creating OCI verification workspace: %w", ErrVerifyBundle, err)
+			return nil, fmt.Errorf("%w: creating OCI verification workspace: %w", ErrVerifyBundle, err)
 		}
 		defer func() { _ = os.RemoveAll(workspace) }()
 		pulled, err := Pull(ctx, opts.Source, workspace, PullOptions{
@@ -67,78 +145,268 @@ func Verify(ctx context.Context, opts VerifyOptions) error {
 		})
 		if err != nil {
 			if errors.Is(err, oci.ErrBundleSignatureNotFound) && !errors.Is(err, ErrBundleNotSigned) {
-				return fmt.Errorf("%w: pulling bundle for verification: %w: %w", ErrVerifyBundle, ErrBundleNotSigned, err)
+				return nil, fmt.Errorf("%w: pulling bundle for verification: %w: %w", ErrVerifyBundle, ErrBundleNotSigned, err)
 			}
-			return fmt.Errorf("%w: pulling bundle for verification: %w", ErrVerifyBundle, err)
+			return nil, fmt.Errorf("%w: pulling bundle for verification: %w", ErrVerifyBundle, err)
 		}
+		source := opts.Source
 		opts.Source = pulled.OutputPath
-		return Verify(ctx, opts)
+		result, err := VerifyWithResult(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		result.Source = source
+		return result, nil
 	}
 
 	workspace, err := os.MkdirTemp(opts.TmpDir, "uds-bundle-verify-*")
 	if err != nil {
-		return fmt.Errorf("%w: creating verification workspace: %w", ErrVerifyBundle, err)
+		return nil, fmt.Errorf("%w: creating verification workspace: %w", ErrVerifyBundle, err)
 	}
 	defer func() { _ = os.RemoveAll(workspace) }()
-	signatureEntries, err := artifact.CountTarZstEntries(ctx, opts.Source, bundleSignatureFileName)
-	if err != nil {
-		return fmt.Errorf("%w: checking bundle signature evidence: %w", ErrVerifyBundle, err)
-	}
-	if signatureEntries > 1 {
-		return fmt.Errorf("%w: expected exactly one bundle signature evidence entry, found %d", ErrVerifyBundle, signatureEntries)
+	if err := checkSignatureEntries(ctx, opts.Source); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVerifyBundle, err)
 	}
 	if err := artifact.ExtractTarZst(ctx, opts.Streams, opts.Source, workspace); err != nil {
-		return fmt.Errorf("%w: extracting bundle: %w", ErrVerifyBundle, err)
+		return nil, fmt.Errorf("%w: extracting bundle: %w", ErrVerifyBundle, err)
 	}
 	indexPath := filepath.Join(workspace, "oci", "index.json")
 	index, err := os.ReadFile(indexPath)
 	if err != nil {
-		return fmt.Errorf("%w: reading bundle index: %w", ErrVerifyBundle, err)
+		return nil, fmt.Errorf("%w: reading bundle index: %w", ErrVerifyBundle, err)
 	}
 	if err := validateBundleIndex(index); err != nil {
-		return fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+		return nil, fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
 	}
-	evidencePath := filepath.Join(workspace, bundleSignatureFileName)
-	evidenceInfo, err := os.Stat(evidencePath)
+	signatures, err := oci.ReadBundleSignatures(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVerifyBundle, err)
+	}
+	if len(signatures) == 0 {
+		return nil, fmt.Errorf("%w: %w: %s: %w", ErrVerifyBundle, ErrBundleNotSigned, bundleSignatureFileName, os.ErrNotExist)
+	}
+	store, err := oci.OpenReadOnlyStore(filepath.Join(workspace, "oci"))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVerifyBundle, err)
+	}
+	bundleName, err := policyBundleName(ctx, opts.Streams, opts.Policy, index, store)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("%w: %w: %w", ErrVerifyBundle, ErrBundleNotSigned, err)
+		return nil, fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+	}
+	verified, err := verifySignature(ctx, index, signatures, bundleName, opts.Policy, opts.TmpDir)
+	if err != nil {
+		return nil, fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+	}
+	if err := oci.VerifyLocalLayoutGraph(ctx, filepath.Join(workspace, "oci"), index); err != nil {
+		return nil, fmt.Errorf("%w: verifying bundle content: %w", ErrVerifyBundle, err)
+	}
+
+	result := &VerifyResult{
+		Source:          opts.Source,
+		ArtifactDigest:  godigest.FromBytes(index).String(),
+		BundleSignature: BundleSignatureStatusVerified,
+		SignedBy:        verified.signedBy,
+		Signatures:      verified.summaries(signatures),
+	}
+	attestations, err := oci.ReadBundleAttestations(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVerifyBundle, err)
+	}
+	for _, attestation := range attestations {
+		summary, err := verifyAttestation(ctx, attestation, result.ArtifactDigest, bundleName, opts.Policy, opts.TmpDir)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+		}
+		if summary.Signature == AttestationSignatureStatusUnsigned {
+			opts.Streams.Warn("bundle attestation is unsigned; its origin is not established", "mediaType", summary.MediaType)
 		}
-		return fmt.Errorf("%w: accessing bundle signature evidence: %w", ErrVerifyBundle, err)
+		result.Attestations = append(result.Attestations, *summary)
+	}
+	return result, nil
+}
+
+// verifyAttestation checks that attestation describes artifactDigest and that
+// any signature evidence over it comes from a signer trusted by policy for
+// bundleName. One trusted signer suffices because the subject digest binds the
+// attestation to a bundle index that has already satisfied the full policy.
+func verifyAttestation(ctx context.Context, attestation oci.BundleAttestation, artifactDigest, bundleName string, policy VerificationPolicy, tmpDir string) (*AttestationSummary, error) {
+	summary, err := attestationSummary(attestation)
+	if err != nil {
+		return nil, err
+	}
+	if summary.SubjectDigest != artifactDigest {
+		return nil, fmt.Errorf("%w: %s attestation describes %s, bundle is %s", ErrAttestationSubjectMismatch, attestation.MediaType, summary.SubjectDigest, artifactDigest)
+	}
+	if len(attestation.Signature) == 0 {
+		return summary, nil
 	}
-	if evidenceInfo.Size() > oci.MaxFetchBytesSize {
-		return fmt.Errorf("%w: bundle signature evidence is %d bytes, larger than the %d byte buffered read limit", ErrVerifyBundle, evidenceInfo.Size(), oci.MaxFetchBytesSize)
+	if _, err := verifyPolicySignature(ctx, attestation.Data, [][]byte{attestation.Signature}, bundleName, policy.anySigner(bundleName), tmpDir); err != nil {
+		return nil, fmt.Errorf("verifying %s attestation signature: %w", attestation.MediaType, err)
 	}
-	evidence, err := os.ReadFile(evidencePath)
+	summary.Signature = AttestationSignatureStatusVerified
+	return summary, nil
+}
+
+func attestationSummary(attestation oci.BundleAttestation) (*AttestationSummary, error) {
+	internal, err := artifact.SummarizeAttestation(attestation)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("%w: %w: %w", ErrVerifyBundle, ErrBundleNotSigned, err)
+		return nil, err
+	}
+	summary := &AttestationSummary{
+		MediaType:     internal.MediaType,
+		Format:        internal.Format,
+		SubjectDigest: internal.SubjectDigest,
+		Signature:     AttestationSignatureStatusUnsigned,
+	}
+	if internal.Signed {
+		summary.Signature = AttestationSignatureStatusSigned
+	}
+	return summary, nil
+}
+
+// signatureVerification records which trusted signers matched a set of
+// signature evidence.
+type signatureVerification struct {
+	// signedBy lists the matched signer names in policy order.
+	signedBy []string
+	// evidenceSigners holds the signer matched by each evidence, or "".
+	evidenceSigners []string
+}
+
+// summaries reports each evidence with the trusted signer that matched it.
+func (v *signatureVerification) summaries(signatures [][]byte) []SignatureSummary {
+	result := make([]SignatureSummary, len(signatures))
+	for i, evidence := range signatures {
+		result[i] = signatureSummary(evidence, BundleSignatureStatusUntrusted)
+		if v.evidenceSigners[i] != "" {
+			result[i].Signer = v.evidenceSigners[i]
+			result[i].Status = BundleSignatureStatusVerified
 		}
-		return fmt.Errorf("%w: accessing bundle signature evidence: %w", ErrVerifyBundle, err)
 	}
-	if err := verifySignature(ctx, index, evidence, opts.Policy, opts.TmpDir); err != nil {
-		return fmt.Errorf("%w %q: %w", ErrVerifyBundle, opts.Source, err)
+	return result
+}
+
+// verifySignature checks bundle signature evidence over index against policy.
+func verifySignature(ctx context.Context, index []byte, signatures [][]byte, bundleName string, policy VerificationPolicy, tmpDir string) (*signatureVerification, error) {
+	verified, err := verifyPolicySignature(ctx, index, signatures, bundleName, policy, tmpDir)
+	if err != nil {
+		return nil, fmt.Errorf("verifying bundle signature: %w", err)
 	}
-	if err := oci.VerifyLocalLayoutGraph(ctx, filepath.Join(workspace, "oci"), index); err != nil {
-		return fmt.Errorf("%w: verifying bundle content: %w", ErrVerifyBundle, err)
+	return verified, nil
+}
+
+// verifyPolicySignature checks each evidence over data against every trusted
+// signer in policy, then applies the policy rule and any scopes matching
+// bundleName. Each evidence counts for at most one signer; signers are
+// assigned to evidence by matchSignatures so that as many signers as possible
+// are matched.
+func verifyPolicySignature(ctx context.Context, data []byte, signatures [][]byte, bundleName string, policy VerificationPolicy, tmpDir string) (*signatureVerification, error) {
+	signers := policy.trustedSigners()
+	verifies := make([][]bool, len(signers))
+	failures := make([][]error, len(signers))
+	for s, signer := range signers {
+		single := VerificationPolicy{PublicKey: signer.PublicKey, Keyless: signer.Keyless}
+		verifies[s] = make([]bool, len(signatures))
+		for i, evidence := range signatures {
+			err := verifyBlobSignature(ctx, data, evidence, single, tmpDir)
+			if err == nil {
+				verifies[s][i] = true
+				continue
+			}
+			if len(signers) == 1 && len(signatures) == 1 {
+				return nil, err
+			}
+			if len(signatures) > 1 {
+				err = fmt.Errorf("signature %d: %w", i+1, err)
+			}
+			failures[s] = append(failures[s], fmt.Errorf("signer %q: %w", signer.Name, err))
+		}
 	}
-	return nil
+
+	evidenceSigners := matchSignatures(verifies, len(signatures), policy.matchOrder(bundleName, signers))
+	verified := &signatureVerification{evidenceSigners: make([]string, len(signatures))}
+	matched := make([]bool, len(signers))
+	for i, s := range evidenceSigners {
+		if s >= 0 {
+			verified.evidenceSigners[i] = signers[s].Name
+			matched[s] = true
+		}
+	}
+	var mismatches []error
+	for s, signer := range signers {
+		if matched[s] {
+			verified.signedBy = append(verified.signedBy, signer.Name)
+		} else {
+			mismatches = append(mismatches, failures[s]...)
+		}
+	}
+	if err := policy.satisfiedBy(bundleName, verified.signedBy); err != nil {
+		return nil, errors.Join(append([]error{err}, mismatches...)...)
+	}
+	return verified, nil
 }
 
-func verifySignature(ctx context.Context, index, evidence []byte, policy VerificationPolicy, tmpDir string) error {
+// matchSignatures assigns count evidence to signers, where verifies[s][i] reports
+// whether evidence i verifies against signer s. It returns the signer
+// assigned to each evidence, or -1. The assignment is a maximum bipartite
+// matching found with augmenting paths, trying signers in order; a signer
+// matched earlier stays matched, so signers earlier in order are preferred
+// when not every signer can be matched.
+func matchSignatures(verifies [][]bool, count int, order []int) []int {
+	evidenceSigner := make([]int, count)
+	for i := range evidenceSigner {
+		evidenceSigner[i] = -1
+	}
+	var augment func(s int, seen []bool) bool
+	augment = func(s int, seen []bool) bool {
+		for i, ok := range verifies[s] {
+			if !ok || seen[i] {
+				continue
+			}
+			seen[i] = true
+			if evidenceSigner[i] < 0 || augment(evidenceSigner[i], seen) {
+				evidenceSigner[i] = s
+				return true
+			}
+		}
+		return false
+	}
+	for _, s := range order {
+		augment(s, make([]bool, len(evidenceSigner)))
+	}
+	return evidenceSigner
+}
+
+// policyBundleName reads the bundle name that policy scopes match against.
+// It fetches nothing when the policy has no scopes. The name is bound to the
+// signed index through the definition manifest digest.
+func policyBundleName(ctx context.Context, streams iostreams.IOStreams, policy VerificationPolicy, index []byte, fetcher content.Fetcher) (string, error) {
+	if len(policy.Scopes) == 0 {
+		return "", nil
+	}
+	name, err := artifact.ReadBundleName(ctx, streams, index, func(ctx context.Context, desc ocispec.Descriptor) ([]byte, error) {
+		return oci.FetchBytes(ctx, fetcher, desc)
+	})
+	if err != nil {
+		return "", fmt.Errorf("reading bundle name for scoped verification policy: %w", err)
+	}
+	return name, nil
+}
+
+func verifyBlobSignature(ctx context.Context, data, evidence []byte, policy VerificationPolicy, tmpDir string) error {
 	workspace, err := os.MkdirTemp(tmpDir, "uds-bundle-signature-verify-*")
 	if err != nil {
 		return fmt.Errorf("creating signature verification workspace: %w", err)
 	}
 	defer func() { _ = os.RemoveAll(workspace) }()
 
-	indexPath := filepath.Join(workspace, "index.json")
-	if err := os.WriteFile(indexPath, index, 0o600); err != nil { //nolint:gosec // path is inside a freshly created verification workspace
-		return fmt.Errorf("writing bundle index for signature verification: %w", err)
+	dataPath := filepath.Join(workspace, "signed-content")
+	if err := os.WriteFile(dataPath, data, 0o600); err != nil { //nolint:gosec // path is inside a freshly created verification workspace
+		return fmt.Errorf("writing signed content for signature verification: %w", err)
 	}
 	evidencePath := filepath.Join(workspace, bundleSignatureFileName)
 	if err := os.WriteFile(evidencePath, evidence, 0o600); err != nil { //nolint:gosec // path is inside a freshly created verification workspace
-		return fmt.Errorf("writing bundle signature evidence: %w", err)
+		return fmt.Errorf("writing signature evidence: %w", err)
 	}
 
 	verifyOpts, err := validationOptions(policy, workspace)
@@ -146,23 +414,65 @@ func verifySignature(ctx context.Context, index, evidence []byte, policy Verific
 		return err
 	}
 	verifyOpts.BundlePath = evidencePath
-	if err := signing.CosignVerifyBlobWithOptions(ctx, indexPath, verifyOpts); err != nil {
-		return fmt.Errorf("verifying bundle signature: %w", err)
-	}
-	return nil
+	return signing.CosignVerifyBlobWithOptions(ctx, dataPath, verifyOpts)
 }
 
 // Validate validates verification policy.
 func (p VerificationPolicy) Validate() error {
-	hasKey := strings.TrimSpace(p.PublicKey) != ""
-	hasKeyless := p.Keyless != nil
+	if len(p.Signers) == 0 {
+		if p.Rule != "" || p.Threshold != 0 || len(p.Scopes) != 0 {
+			return fmt.Errorf("%w: rule, threshold, and scopes require trusted signers", ErrInvalidVerificationPolicy)
+		}
+		return validateTrustedSigner(p.PublicKey, p.Keyless)
+	}
+	if strings.TrimSpace(p.PublicKey) != "" || p.Keyless != nil {
+		return fmt.Errorf("%w: trusted signers cannot be combined with a top-level public key or keyless identity", ErrInvalidVerificationPolicy)
+	}
+	names := make(map[string]bool, len(p.Signers))
+	for _, signer := range p.Signers {
+		if strings.TrimSpace(signer.Name) == "" {
+			return fmt.Errorf("%w: trusted signer name is required", ErrInvalidVerificationPolicy)
+		}
+		if names[signer.Name] {
+			return fmt.Errorf("%w: duplicate trusted signer %q", ErrInvalidVerificationPolicy, signer.Name)
+		}
+		names[signer.Name] = true
+		if err := validateTrustedSigner(signer.PublicKey, signer.Keyless); err != nil {
+			return fmt.Errorf("trusted signer %q: %w", signer.Name, err)
+		}
+	}
+	if _, err := requiredSigners(p.Rule, p.Threshold, len(p.Signers)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidVerificationPolicy, err)
+	}
+	for _, scope := range p.Scopes {
+		if strings.TrimSpace(scope.BundleName) == "" {
+			return fmt.Errorf("%w: scope bundle name pattern is required", ErrInvalidVerificationPolicy)
+		}
+		if _, err := path.Match(scope.BundleName, ""); err != nil {
+			return fmt.Errorf("%w: scope %q: %w", ErrInvalidVerificationPolicy, scope.BundleName, err)
+		}
+		for _, name := range scope.Signers {
+			if !names[name] {
+				return fmt.Errorf("%w: scope %q references unknown trusted signer %q", ErrInvalidVerificationPolicy, scope.BundleName, name)
+			}
+		}
+		if _, err := requiredSigners(scope.Rule, scope.Threshold, len(p.scopeSigners(scope))); err != nil {
+			return fmt.Errorf("%w: scope %q: %w", ErrInvalidVerificationPolicy, scope.BundleName, err)
+		}
+	}
+	return nil
+}
+
+// validateTrustedSigner validates a single public key or keyless identity.
+func validateTrustedSigner(publicKey string, keyless *KeylessVerification) error {
+	hasKey := strings.TrimSpace(publicKey) != ""
+	hasKeyless := keyless != nil
 	if hasKey == hasKeyless {
 		return fmt.Errorf("signature verification must configure exactly one of public key or keyless: %w", ErrInvalidVerificationPolicy)
 	}
 	if !hasKeyless {
 		return nil
 	}
-	keyless := p.Keyless
 	if err := exactlyOne(keyless.CertificateIdentity, keyless.CertificateIdentityRegexp, "certificate identity"); err != nil {
 		return fmt.Errorf("%w for keyless verification: %w", ErrInvalidVerificationPolicy, err)
 	}
@@ -183,7 +493,139 @@ func (p VerificationPolicy) Validate() error {
 }
 
 func (p VerificationPolicy) configured() bool {
-	return strings.TrimSpace(p.PublicKey) != "" || p.Keyless != nil
+	return strings.TrimSpace(p.PublicKey) != "" || p.Keyless != nil || len(p.Signers) != 0
+}
+
+// trustedSigners returns the signers a policy trusts. A single public key or
+// keyless identity is reported as "public-key" or by its certificate identity.
+func (p VerificationPolicy) trustedSigners() []TrustedSigner {
+	if len(p.Signers) != 0 {
+		return p.Signers
+	}
+	if p.Keyless != nil {
+		name := p.Keyless.CertificateIdentity
+		if name == "" {
+			name = p.Keyless.CertificateIdentityRegexp
+		}
+		return []TrustedSigner{{Name: name, Keyless: p.Keyless}}
+	}
+	return []TrustedSigner{{Name: "public-key", PublicKey: p.PublicKey}}
+}
+
+// anySigner returns a policy accepting one signature from any signer that
+// policy trusts for bundleName.
+func (p VerificationPolicy) anySigner(bundleName string) VerificationPolicy {
+	if len(p.Signers) == 0 {
+		return p
+	}
+	eligible := p.Signers
+	for _, scope := range p.Scopes {
+		if matched, _ := path.Match(scope.BundleName, bundleName); matched && len(scope.Signers) != 0 {
+			eligible = slices.DeleteFunc(slices.Clone(eligible), func(signer TrustedSigner) bool {
+				return !slices.Contains(scope.Signers, signer.Name)
+			})
+		}
+	}
+	return VerificationPolicy{Signers: eligible, Rule: VerificationRuleAny}
+}
+
+// matchOrder returns the indices of signers, those counted by the most scopes
+// matching bundleName first, so the scopes a bundle must satisfy are favoured
+// when not every signer can be matched.
+func (p VerificationPolicy) matchOrder(bundleName string, signers []TrustedSigner) []int {
+	weight := make([]int, len(signers))
+	for _, scope := range p.Scopes {
+		if matched, _ := path.Match(scope.BundleName, bundleName); !matched {
+			continue
+		}
+		eligible := p.scopeSigners(scope)
+		for s, signer := range signers {
+			if slices.Contains(eligible, signer.Name) {
+				weight[s]++
+			}
+		}
+	}
+	order := make([]int, len(signers))
+	for s := range order {
+		order[s] = s
+	}
+	slices.SortStableFunc(order, func(a, b int) int { return weight[b] - weight[a] })
+	return order
+}
+
+// scopeSigners returns the signer names that count toward scope.
+func (p VerificationPolicy) scopeSigners(scope BundleVerificationScope) []string {
+	if len(scope.Signers) != 0 {
+		return scope.Signers
+	}
+	names := make([]string, len(p.Signers))
+	for i, signer := range p.Signers {
+		names[i] = signer.Name
+	}
+	return names
+}
+
+// satisfiedBy reports whether signedBy meets the policy rule and every scope
+// matching bundleName.
+func (p VerificationPolicy) satisfiedBy(bundleName string, signedBy []string) error {
+	if len(p.Signers) == 0 {
+		if len(signedBy) == 0 {
+			return ErrSignaturePolicyNotSatisfied
+		}
+		return nil
+	}
+	required, err := requiredSigners(p.Rule, p.Threshold, len(p.Signers))
+	if err != nil {
+		return err
+	}
+	if len(signedBy) < required {
+		return fmt.Errorf("%w: %d of %d required trusted signers matched", ErrSignaturePolicyNotSatisfied, len(signedBy), required)
+	}
+	for _, scope := range p.Scopes {
+		if matched, _ := path.Match(scope.BundleName, bundleName); !matched {
+			continue
+		}
+		eligible := p.scopeSigners(scope)
+		required, err := requiredSigners(scope.Rule, scope.Threshold, len(eligible))
+		if err != nil {
+			return err
+		}
+		count := 0
+		for _, name := range signedBy {
+			if slices.Contains(eligible, name) {
+				count++
+			}
+		}
+		if count < required {
+			return fmt.Errorf("%w: bundle %q matches scope %q, which requires %d of %s; %d matched",
+				ErrSignaturePolicyNotSatisfied, bundleName, scope.BundleName, required, strings.Join(eligible, ", "), count)
+		}
+	}
+	return nil
+}
+
+// requiredSigners returns how many of available signers rule requires. An
+// empty rule means any, or threshold when threshold is set.
+func requiredSigners(rule VerificationRule, threshold, available int) (int, error) {
+	switch {
+	case rule == VerificationRuleAny || (rule == "" && threshold == 0):
+		if threshold != 0 {
+			return 0, fmt.Errorf("threshold cannot be combined with the %q rule", VerificationRuleAny)
+		}
+		return 1, nil
+	case rule == VerificationRuleThreshold || rule == "":
+		if threshold < 1 || threshold > available {
+			return 0, fmt.Errorf("threshold must be between 1 and %d trusted signers, got %d", available, threshold)
+		}
+		return threshold, nil
+	case rule == VerificationRuleAll:
+		if threshold != 0 {
+			return 0, fmt.Errorf("threshold cannot be combined with the %q rule", VerificationRuleAll)
+		}
+		return available, nil
+	default:
+		return 0, fmt.Errorf("rule %q must be %q, %q, or %q", rule, VerificationRuleAny, VerificationRuleThreshold, VerificationRuleAll)
+	}
 }
 
 // Validate validates VerifyOptions.